@@ -0,0 +1,156 @@
+package junos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	lockBackoffBase     = 500 * time.Millisecond
+	lockBackoffMax      = 10 * time.Second
+	connectBackoffBase  = 1 * time.Second
+	connectBackoffMax   = 10 * time.Second
+	throttleBackoffBase = 1 * time.Second
+	throttleBackoffMax  = 30 * time.Second
+)
+
+// backoff generates exponential delays with jitter between attempts.
+type backoff struct {
+	base    time.Duration
+	max     time.Duration
+	attempt int
+}
+
+func newBackoff(base, maxDelay time.Duration) *backoff {
+	return &backoff{
+		base: base,
+		max:  maxDelay,
+	}
+}
+
+// next returns the delay before the next attempt:
+// base * 2^attempt (capped to max) with jitter.
+func (b *backoff) next() time.Duration {
+	delay := b.max
+	if b.attempt < 32 {
+		if d := b.base << b.attempt; d > 0 && d < b.max {
+			delay = d
+		}
+	}
+	b.attempt++
+
+	return jitter(delay)
+}
+
+// jitter returns a random duration between the half and the whole of delay.
+func jitter(delay time.Duration) time.Duration {
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// sleepContext waits for the delay or the end of ctx.
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return nil
+	}
+	if ctx == nil {
+		time.Sleep(delay)
+
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// connectThrottle delays new connections to a device after the device limited them
+// (connection-limit or rate-limit on netconf ssh service) and shares this delay between sessions.
+type connectThrottle struct {
+	mutex *sync.Mutex
+	delay time.Duration
+}
+
+func newConnectThrottle() *connectThrottle {
+	return &connectThrottle{
+		mutex: &sync.Mutex{},
+	}
+}
+
+// wait waits for the current delay before opening a new connection.
+func (t *connectThrottle) wait(ctx context.Context) error {
+	if t == nil {
+		return nil
+	}
+	t.mutex.Lock()
+	delay := t.delay
+	t.mutex.Unlock()
+	if delay == 0 {
+		return nil
+	}
+	// add jitter to not open all connections at the same time
+	if err := sleepContext(ctx, jitter(delay)); err != nil {
+		return fmt.Errorf("waiting before connection after device limited connections: %w", err)
+	}
+
+	return nil
+}
+
+// limited doubles the delay (up to throttleBackoffMax) when the device limited a connection.
+func (t *connectThrottle) limited() time.Duration {
+	if t == nil {
+		return 0
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	switch {
+	case t.delay < throttleBackoffBase:
+		t.delay = throttleBackoffBase
+	case t.delay*2 > throttleBackoffMax:
+		t.delay = throttleBackoffMax
+	default:
+		t.delay *= 2
+	}
+
+	return t.delay
+}
+
+// succeeded halves the delay when a connection is established.
+func (t *connectThrottle) succeeded() {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.delay /= 2
+	if t.delay < throttleBackoffBase {
+		t.delay = 0
+	}
+}
+
+// isConnectionLimited checks if the connection has been closed by the device
+// during the SSH handshake, which happens when the connection-limit or the rate-limit
+// of netconf ssh service is exceeded.
+func isConnectionLimited(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+	// ssh handshake errors are not wrapped
+	message := err.Error()
+
+	return strings.Contains(message, "handshake failed") &&
+		(strings.HasSuffix(message, io.EOF.Error()) || strings.Contains(message, "connection reset by peer"))
+}
@@ -0,0 +1,111 @@
+package junos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestBackoffNext(t *testing.T) {
+	t.Parallel()
+
+	b := newBackoff(time.Second, 10*time.Second)
+	expected := []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second,
+	}
+	for i, maxDelay := range expected {
+		delay := b.next()
+		if delay < maxDelay/2 || delay > maxDelay {
+			t.Errorf("attempt %d: delay %s not between %s and %s", i, delay, maxDelay/2, maxDelay)
+		}
+	}
+	b.attempt = 100
+	if delay := b.next(); delay < 5*time.Second || delay > 10*time.Second {
+		t.Errorf("delay %s not capped after many attempts", delay)
+	}
+}
+
+func TestSleepContext(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := sleepContext(ctx, time.Hour); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected canceled error, got %v", err)
+	}
+	if err := sleepContext(nil, time.Millisecond); err != nil { //nolint:staticcheck
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestConnectThrottle(t *testing.T) {
+	t.Parallel()
+
+	throttle := newConnectThrottle()
+	expected := []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second,
+	}
+	for i, v := range expected {
+		if delay := throttle.limited(); delay != v {
+			t.Errorf("limited %d: got delay %s, expected %s", i, delay, v)
+		}
+	}
+	for range expected {
+		throttle.succeeded()
+	}
+	if throttle.delay != 0 {
+		t.Errorf("delay not reset after successful connections: %s", throttle.delay)
+	}
+	if err := throttle.wait(context.Background()); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	var nilThrottle *connectThrottle
+	nilThrottle.limited()
+	nilThrottle.succeeded()
+	if err := nilThrottle.wait(context.Background()); err != nil {
+		t.Errorf("unexpected error with nil throttle: %s", err)
+	}
+}
+
+func TestIsConnectionLimited(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err     error
+		limited bool
+	}{
+		"nil": {
+			err: nil,
+		},
+		"eof": {
+			err:     io.EOF,
+			limited: true,
+		},
+		"handshake_eof": {
+			err:     fmt.Errorf("ssh: handshake failed: %v", io.EOF), //nolint:errorlint
+			limited: true,
+		},
+		"handshake_reset": {
+			err:     errors.New("ssh: handshake failed: read tcp 127.0.0.1:1->127.0.0.1:830: read: connection reset by peer"),
+			limited: true,
+		},
+		"handshake_auth": {
+			err: errors.New("ssh: handshake failed: ssh: unable to authenticate, attempted methods [none password]"),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := isConnectionLimited(test.err); got != test.limited {
+				t.Errorf("got %t, expected %t", got, test.limited)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package junos
 
-import "fmt"
+import (
+	"fmt"
+	"os"
+	"sync"
+)
 
 const directoryPermission = 0o755
 
@@ -14,6 +18,7 @@ type Client struct {
 	sleepLock              int
 	sleepShort             int
 	sleepSSHClosed         int
+	commitTimeout          int
 	filePermission         int64
 	junosIP                string
 	junosUserName          string
@@ -21,10 +26,23 @@ type Client struct {
 	junosSSHKeyPEM         string
 	junosSSHKeyFile        string
 	junosSSHKeyPass        string
+	junosSSHCertPEM        string
+	junosSSHCertFile       string
+	junosSSHProxy          string
 	groupIntDel            string
+	interfaceRelease       string
 	logFileDst             string
 	fakeCreateSetFile      string
+	auditJournalFile       string
+	credentialHelper       string
+	credentialCache        *credentialHelperReply
+	credentialMutex        *sync.Mutex
+	connectThrottle        *connectThrottle
+	metrics                *metricsCollector
 	junosSSHCiphers        []string
+	junosSSHAuthMethods    []string
+	interfaceReleaseTmpl   []string
+	junosSSHJumpHosts      []SSHJumpHost
 }
 
 func NewClient(ip string) *Client {
@@ -37,10 +55,13 @@ func NewClient(ip string) *Client {
 		junosSSHKeyFile:        "",
 		junosSSHKeyPass:        "",
 		groupIntDel:            "",
-		sleepShort:             100,
-		sleepLock:              10,
+		interfaceRelease:       InterfaceReleaseDisable,
+		sleepShort:             0,
+		sleepLock:              -1, // exponential backoff
 		sleepSSHClosed:         0,
+		commitTimeout:          0,
 		junosSSHCiphers:        DefaultSSHCiphers(),
+		junosSSHAuthMethods:    DefaultSSHAuthMethods(),
 		junosSSHTimeoutToEstab: 0,
 		junosSSHRetryToEstab:   1,
 		filePermission:         0o644,
@@ -48,6 +69,8 @@ func NewClient(ip string) *Client {
 		fakeCreateSetFile:      "",
 		fakeUpdateAlso:         false,
 		fakeDeleteAlso:         false,
+		credentialMutex:        &sync.Mutex{},
+		connectThrottle:        newConnectThrottle(),
 	}
 }
 
@@ -87,12 +110,55 @@ func (clt *Client) WithSSHKeyPassphrase(sshKeyPass string) *Client {
 	return clt
 }
 
+func (clt *Client) WithSSHCertificatePEM(sshCertPEM string) *Client {
+	clt.junosSSHCertPEM = sshCertPEM
+
+	return clt
+}
+
+func (clt *Client) WithSSHCertificateFile(sshCertFile string) *Client {
+	clt.junosSSHCertFile = sshCertFile
+
+	return clt
+}
+
+func (clt *Client) WithCredentialHelper(helper string) *Client {
+	clt.credentialHelper = helper
+
+	return clt
+}
+
 func (clt *Client) WithGroupInterfaceDelete(groupIntDel string) *Client {
 	clt.groupIntDel = groupIntDel
 
 	return clt
 }
 
+func (clt *Client) WithInterfaceReleasePolicy(policy string) (*Client, error) {
+	switch policy {
+	case InterfaceReleaseDisable,
+		InterfaceReleaseDelete,
+		InterfaceReleaseGroup,
+		InterfaceReleaseSnapshot,
+		InterfaceReleaseTemplate:
+	default:
+		return clt, fmt.Errorf("unknown interface release policy %q", policy)
+	}
+	clt.interfaceRelease = policy
+
+	return clt, nil
+}
+
+func (clt *Client) WithInterfaceReleaseTemplate(template string) (*Client, error) {
+	lines, err := parseInterfaceReleaseTemplate(template)
+	if err != nil {
+		return clt, err
+	}
+	clt.interfaceReleaseTmpl = lines
+
+	return clt, nil
+}
+
 func (clt *Client) WithSleepShort(sleep int) *Client {
 	clt.sleepShort = sleep
 
@@ -111,18 +177,52 @@ func (clt *Client) WithSleepSSHClosed(sleep int) *Client {
 	return clt
 }
 
+func (clt *Client) WithCommitTimeout(timeout int) *Client {
+	clt.commitTimeout = timeout
+
+	return clt
+}
+
 func (clt *Client) WithSSHCiphers(ciphers []string) *Client {
 	clt.junosSSHCiphers = ciphers
 
 	return clt
 }
 
+func (clt *Client) WithSSHAuthMethods(methods []string) (*Client, error) {
+	for _, method := range methods {
+		switch method {
+		case SSHAuthMethodPublicKey, SSHAuthMethodPassword, SSHAuthMethodKeyboardInteractive:
+		default:
+			return clt, fmt.Errorf("unknown SSH authentication method %q", method)
+		}
+	}
+	clt.junosSSHAuthMethods = methods
+
+	return clt, nil
+}
+
 func (clt *Client) WithSSHTimeoutToEstablish(timeout int) *Client {
 	clt.junosSSHTimeoutToEstab = timeout
 
 	return clt
 }
 
+func (clt *Client) WithSSHJumpHost(jumpHost SSHJumpHost) *Client {
+	clt.junosSSHJumpHosts = append(clt.junosSSHJumpHosts, jumpHost)
+
+	return clt
+}
+
+func (clt *Client) WithSSHProxy(proxyURL string) (*Client, error) {
+	if _, err := validateSSHProxy(proxyURL); err != nil {
+		return clt, err
+	}
+	clt.junosSSHProxy = proxyURL
+
+	return clt, nil
+}
+
 func (clt *Client) WithSSHRetryToEstablish(retry int) (*Client, error) {
 	if retry < 1 || retry > 10 {
 		return clt, fmt.Errorf("bad value to number of retry to establishing SSH connection")
@@ -147,6 +247,32 @@ func (clt *Client) WithDebugLogFile(file string) *Client {
 	return clt
 }
 
+// WithMetricsFile collects metrics of netconf exchanges to write them in file
+// when the provider shuts down (with WriteMetricsFiles).
+func (clt *Client) WithMetricsFile(file string) *Client {
+	clt.metrics = registerMetricsFile(file, os.FileMode(clt.filePermission))
+
+	return clt
+}
+
+// deviceMetrics returns the metrics recorder for the device (nil if metrics are not collected).
+func (clt *Client) deviceMetrics() *deviceMetrics {
+	if clt.metrics == nil {
+		return nil
+	}
+
+	return &deviceMetrics{
+		collector: clt.metrics,
+		device:    clt.junosIP,
+	}
+}
+
+func (clt *Client) WithAuditJournalFile(file string) *Client {
+	clt.auditJournalFile = file
+
+	return clt
+}
+
 func (clt *Client) WithFakeCreateSetFile(file string) *Client {
 	clt.fakeCreateSetFile = file
 
@@ -181,6 +307,48 @@ func (clt *Client) GroupInterfaceDelete() string {
 	return clt.groupIntDel
 }
 
+// CheckInterfaceRelease checks that the interface release policy has what it needs.
+func (clt *Client) CheckInterfaceRelease() error {
+	switch {
+	case clt.interfaceRelease == InterfaceReleaseGroup && clt.groupIntDel == "":
+		return fmt.Errorf("interface release policy %q need a group to delete interfaces",
+			InterfaceReleaseGroup)
+	case clt.interfaceRelease == InterfaceReleaseTemplate && len(clt.interfaceReleaseTmpl) == 0:
+		return fmt.Errorf("interface release policy %q need a template of set lines",
+			InterfaceReleaseTemplate)
+	}
+
+	return nil
+}
+
+// InterfaceRelease returns how to release interfaces with the policy configured on client.
+func (clt *Client) InterfaceRelease() InterfaceRelease {
+	release := InterfaceRelease{
+		policy:  clt.interfaceRelease,
+		disable: []string{"set description NC", "set disable"},
+	}
+	if clt.groupIntDel != "" {
+		release.disable = []string{"set apply-groups " + clt.groupIntDel}
+	}
+	switch clt.interfaceRelease {
+	case InterfaceReleaseDisable, InterfaceReleaseGroup:
+		release.lines = release.disable
+	case InterfaceReleaseTemplate:
+		release.lines = clt.interfaceReleaseTmpl
+		release.disable = clt.interfaceReleaseTmpl
+	}
+
+	return release
+}
+
+func DefaultSSHAuthMethods() []string {
+	return []string{
+		SSHAuthMethodPublicKey,
+		SSHAuthMethodPassword,
+		SSHAuthMethodKeyboardInteractive,
+	}
+}
+
 func DefaultSSHCiphers() []string {
 	return []string{
 		"aes128-gcm@openssh.com", "aes256-gcm@openssh.com",
@@ -0,0 +1,124 @@
+package junos
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const credentialHelperTimeout = 60 * time.Second
+
+// credentialHelperReply is the credentials returned by a credential helper
+// (executable output or entry in JSON file).
+type credentialHelperReply struct {
+	Username    string `json:"username"`
+	Password    string `json:"password"`
+	SSHKeyPEM   string `json:"sshkey_pem"`
+	SSHKeyFile  string `json:"sshkeyfile"`
+	SSHKeyPass  string `json:"keypass"`
+	SSHCertPEM  string `json:"sshcert_pem"`
+	SSHCertFile string `json:"sshcertfile"`
+}
+
+// readCredentialHelper returns credentials for the Junos device from the credential helper.
+// Credentials are cached in the client after the first successful read.
+func (clt *Client) readCredentialHelper(ctx context.Context) (*credentialHelperReply, error) {
+	if clt.credentialHelper == "" {
+		return nil, nil //nolint:nilnil
+	}
+	clt.credentialMutex.Lock()
+	defer clt.credentialMutex.Unlock()
+	if clt.credentialCache != nil {
+		return clt.credentialCache, nil
+	}
+
+	var cred *credentialHelperReply
+	var err error
+	if strings.EqualFold(filepath.Ext(clt.credentialHelper), ".json") {
+		cred, err = readCredentialHelperFile(clt.credentialHelper, clt.junosIP)
+	} else {
+		cred, err = execCredentialHelper(ctx, clt.credentialHelper, clt.junosIP)
+	}
+	if err != nil {
+		return nil, err
+	}
+	clt.credentialCache = cred
+
+	return cred, nil
+}
+
+// readCredentialHelperFile reads credentials of host in JSON file
+// which contains an object for each host and optionally a "*" object as fallback.
+func readCredentialHelperFile(file, host string) (*credentialHelperReply, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading credential helper file: %w", err)
+	}
+	var hosts map[string]credentialHelperReply
+	if err := json.Unmarshal(content, &hosts); err != nil {
+		return nil, fmt.Errorf("decoding JSON in credential helper file %s: %w", file, err)
+	}
+	if cred, ok := hosts[host]; ok {
+		return &cred, nil
+	}
+	if cred, ok := hosts["*"]; ok {
+		return &cred, nil
+	}
+
+	return nil, fmt.Errorf("host %q (and \"*\") not found in credential helper file %s", host, file)
+}
+
+// execCredentialHelper runs the credential helper executable with host as argument
+// and decodes the JSON object on its standard output.
+func execCredentialHelper(ctx context.Context, helper, host string) (*credentialHelperReply, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialHelperTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, helper, host)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && stderr.Len() > 0 {
+			return nil, fmt.Errorf("running credential helper %s: %w: %s",
+				helper, err, strings.TrimSpace(stderr.String()))
+		}
+
+		return nil, fmt.Errorf("running credential helper %s: %w", helper, err)
+	}
+	var cred credentialHelperReply
+	if err := json.Unmarshal(stdout.Bytes(), &cred); err != nil {
+		return nil, fmt.Errorf("decoding JSON output of credential helper %s: %w", helper, err)
+	}
+
+	return &cred, nil
+}
+
+// apply overrides authentication with credentials returned by the helper.
+// Key (with its passphrase and certificate) and password are replaced only if the helper returns one.
+func (cred *credentialHelperReply) apply(auth *sshAuthMethod) {
+	if cred == nil {
+		return
+	}
+	if cred.Username != "" {
+		auth.Username = cred.Username
+	}
+	if cred.Password != "" {
+		auth.Password = cred.Password
+	}
+	if cred.SSHKeyPEM != "" || cred.SSHKeyFile != "" {
+		auth.PrivateKeyPEM = cred.SSHKeyPEM
+		auth.PrivateKeyFile = cred.SSHKeyFile
+		auth.Passphrase = cred.SSHKeyPass
+		auth.CertificatePEM = cred.SSHCertPEM
+		auth.CertificateFile = cred.SSHCertFile
+	}
+}
@@ -0,0 +1,128 @@
+package junos
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestClientReadCredentialHelper(t *testing.T) {
+	dir := t.TempDir()
+	jsonFile := filepath.Join(dir, "credentials.json")
+	if err := os.WriteFile(jsonFile, []byte(`{
+  "192.0.2.1": {"username": "user1", "password": "pass1"},
+  "*": {"username": "default", "sshkeyfile": "/tmp/key", "keypass": "keypass"}
+}`), 0o600); err != nil {
+		t.Fatalf("writing json file: %s", err)
+	}
+	badJSONFile := filepath.Join(dir, "bad.json")
+	if err := os.WriteFile(badJSONFile, []byte(`{"192.0.2.1": {"username": "user1"}}`), 0o600); err != nil {
+		t.Fatalf("writing json file: %s", err)
+	}
+
+	type testCase struct {
+		helper      string
+		host        string
+		expectCred  credentialHelperReply
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"json_host": {
+			helper:     jsonFile,
+			host:       "192.0.2.1",
+			expectCred: credentialHelperReply{Username: "user1", Password: "pass1"},
+		},
+		"json_fallback": {
+			helper:     jsonFile,
+			host:       "192.0.2.2",
+			expectCred: credentialHelperReply{Username: "default", SSHKeyFile: "/tmp/key", SSHKeyPass: "keypass"},
+		},
+		"json_not_found": {
+			helper:      badJSONFile,
+			host:        "192.0.2.2",
+			expectError: true,
+		},
+		"json_missing_file": {
+			helper:      filepath.Join(dir, "missing.json"),
+			host:        "192.0.2.1",
+			expectError: true,
+		},
+	}
+	if runtime.GOOS != "windows" {
+		script := filepath.Join(dir, "helper.sh")
+		scriptContent := "#!/bin/sh\n" +
+			`echo "{\"username\": \"user-$1\", \"sshkey_pem\": \"pem\"}"` + "\n"
+		if err := os.WriteFile(script, []byte(scriptContent), 0o700); err != nil { //nolint:gosec
+			t.Fatalf("writing script: %s", err)
+		}
+		failScript := filepath.Join(dir, "fail.sh")
+		if err := os.WriteFile(failScript, []byte("#!/bin/sh\necho failed >&2\nexit 1\n"), 0o700); err != nil { //nolint:gosec
+			t.Fatalf("writing script: %s", err)
+		}
+		tests["exec"] = testCase{
+			helper:     script,
+			host:       "192.0.2.1",
+			expectCred: credentialHelperReply{Username: "user-192.0.2.1", SSHKeyPEM: "pem"},
+		}
+		tests["exec_fail"] = testCase{
+			helper:      failScript,
+			host:        "192.0.2.1",
+			expectError: true,
+		}
+	}
+
+	for name, test := range tests {
+		name := name
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			clt := NewClient(test.host).WithCredentialHelper(test.helper)
+			cred, err := clt.readCredentialHelper(context.Background())
+			if test.expectError {
+				if err == nil {
+					t.Errorf("expected error, got nil")
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if *cred != test.expectCred {
+				t.Errorf("got unexpected credentials: %+v, expected %+v", *cred, test.expectCred)
+			}
+			if clt.credentialCache != cred {
+				t.Errorf("credentials not cached in client")
+			}
+		})
+	}
+}
+
+func TestCredentialHelperReplyApply(t *testing.T) {
+	auth := sshAuthMethod{
+		Username:       "provider",
+		Password:       "provider",
+		PrivateKeyFile: "/provider/key",
+		Passphrase:     "provider",
+		CertificatePEM: "provider",
+	}
+	cred := &credentialHelperReply{Username: "helper", SSHKeyPEM: "helper"}
+	cred.apply(&auth)
+	expected := sshAuthMethod{
+		Username:      "helper",
+		Password:      "provider",
+		PrivateKeyPEM: "helper",
+	}
+	if auth.Username != expected.Username ||
+		auth.Password != expected.Password ||
+		auth.PrivateKeyPEM != expected.PrivateKeyPEM ||
+		auth.PrivateKeyFile != expected.PrivateKeyFile ||
+		auth.Passphrase != expected.Passphrase ||
+		auth.CertificatePEM != expected.CertificatePEM {
+		t.Errorf("got unexpected auth: %+v, expected %+v", auth, expected)
+	}
+}
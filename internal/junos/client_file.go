@@ -1,7 +1,6 @@
 package junos
 
 import (
-	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -30,23 +29,23 @@ func (clt *Client) appendFakeCreateSetFile(lines []string) error {
 	return nil
 }
 
-// log message in junosLogFile.
+// logFile appends message in debug log file if set.
+// Messages are already masked of sensitive data by the session.
 func (clt *Client) logFile(message string) {
-	if clt.logFileDst != "" {
-		f, err := os.OpenFile(clt.logFileDst,
-			os.O_WRONLY|os.O_CREATE|os.O_APPEND, os.FileMode(clt.filePermission))
-		if err != nil {
-			var perr *os.PathError
-			if !errors.As(err, &perr) {
-				log.Fatal(err)
-			}
-			log.Printf("[WARN] appending debug_netconf_log file: %s", perr.Error())
-		}
-		defer f.Close()
-		logger := log.New(f, "", log.LstdFlags)
+	if clt.logFileDst == "" {
+		return
+	}
+	f, err := os.OpenFile(clt.logFileDst,
+		os.O_WRONLY|os.O_CREATE|os.O_APPEND, os.FileMode(clt.filePermission))
+	if err != nil {
+		log.Printf("[WARN] appending debug_netconf_log file: %s", err.Error())
 
-		logger.Printf("%s", message)
+		return
 	}
+	defer f.Close()
+	logger := log.New(f, "", log.LstdFlags)
+
+	logger.Printf("%s", message)
 }
 
 func (clt *Client) FilePermission() int64 {
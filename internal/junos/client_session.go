@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 func (clt *Client) StartNewSession(ctx context.Context) (*Session, error) {
@@ -23,49 +26,118 @@ func (clt *Client) StartNewSession(ctx context.Context) (*Session, error) {
 			auth.Passphrase = clt.junosSSHKeyPass
 		}
 	}
+	if clt.junosSSHCertPEM != "" {
+		auth.CertificatePEM = clt.junosSSHCertPEM
+	}
+	if clt.junosSSHCertFile != "" {
+		auth.CertificateFile = clt.junosSSHCertFile
+	}
 	if clt.junosPassword != "" {
 		auth.Password = clt.junosPassword
 	}
+	auth.Methods = clt.junosSSHAuthMethods
+	cred, err := clt.readCredentialHelper(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cred.apply(&auth)
 	auth.Timeout = clt.junosSSHTimeoutToEstab
-	sess, err := netconfNewSession(
-		ctx,
-		net.JoinHostPort(clt.junosIP, strconv.Itoa(clt.junosPort)),
-		&auth,
-		&openSSHOptions{
-			Retry:   clt.junosSSHRetryToEstab,
-			Timeout: clt.junosSSHTimeoutToEstab,
-		},
-	)
+	logCtx := newLogContext(ctx, clt.junosIP)
+	host := net.JoinHostPort(clt.junosIP, strconv.Itoa(clt.junosPort))
+	openSSH := openSSHOptions{
+		Retry:         clt.junosSSHRetryToEstab,
+		Timeout:       clt.junosSSHTimeoutToEstab,
+		Proxy:         clt.junosSSHProxy,
+		JumpHostsAuth: clt.sshJumpHostsAuth(),
+		Throttle:      clt.connectThrottle,
+		Metrics:       clt.deviceMetrics(),
+	}
+	start := time.Now()
+	sess, err := netconfNewSession(logCtx, host, &auth, &openSSH)
 	if err != nil {
 		if sess != nil && sess.netconf != nil {
 			_ = sess.closeNetconf(sess.sleepSSHClosed)
 		}
+		fields := map[string]interface{}{
+			logFieldDuration: time.Since(start).String(),
+			logFieldError:    err.Error(),
+		}
+		tflog.SubsystemError(logCtx, logSubsystemSSH, "opening session failed", fields)
+		clt.logFile(logFileMessage(logSubsystemSSH, "opening session failed", fields))
 
 		return nil, err
 	}
-	sess.logFile = func(message string) {
-		message = "[" + sess.localAddress + "->" + sess.remoteAddress + "]" + message
-		clt.logFile(message)
+	sess.ctx = logContextWithAddresses(sess.ctx, sess.localAddress, sess.remoteAddress)
+	sess.metrics = openSSH.Metrics
+	sess.reconnect = func(ctx context.Context) (*Session, error) {
+		return netconfNewSession(ctx, host, &auth, &openSSH)
+	}
+	if clt.logFileDst != "" {
+		sess.logFile = func(message string) {
+			message = "[" + sess.localAddress + "->" + sess.remoteAddress + "]" + message
+			clt.logFile(message)
+		}
 	}
 	sess.sleepLock = clt.sleepLock
 	sess.sleepShort = clt.sleepShort
 	sess.sleepSSHClosed = clt.sleepSSHClosed
+	sess.commitTimeout = time.Duration(clt.commitTimeout) * time.Second
 	if clt.fakeCreateSetFile != "" {
 		sess.fakeSetFile = clt.appendFakeCreateSetFile
 	}
+	if clt.auditJournalFile != "" {
+		sess.journal = clt.appendJournal
+	}
 	if sess.SystemInformation.HardwareModel == "" {
 		_ = sess.closeNetconf(sess.sleepSSHClosed)
 
 		return nil, fmt.Errorf("can't read model of device with <get-system-information/> netconf command")
 	}
-	sess.logFile("[StartNewSession] session opened")
+	sess.metrics.observe(metricSessionEstablishment, "", time.Since(start))
+	sess.logDebug(logSubsystemSSH, "session opened", map[string]interface{}{
+		logFieldDuration: time.Since(start),
+		"model":          sess.SystemInformation.HardwareModel,
+	})
 
 	return sess, nil
 }
 
-func (clt *Client) NewSessionWithoutNetconf(_ context.Context) *Session {
+// sshJumpHostsAuth generates authentication methods for each SSH jump host,
+// with default port 22 and default username of Junos device.
+func (clt *Client) sshJumpHostsAuth() []sshJumpHostAuth {
+	jumpHostsAuth := make([]sshJumpHostAuth, len(clt.junosSSHJumpHosts))
+	for i, jumpHost := range clt.junosSSHJumpHosts {
+		port := jumpHost.Port
+		if port == 0 {
+			port = 22
+		}
+		auth := sshAuthMethod{
+			Username:       jumpHost.Username,
+			Password:       jumpHost.Password,
+			PrivateKeyPEM:  jumpHost.SSHKeyPEM,
+			PrivateKeyFile: jumpHost.SSHKeyFile,
+			Passphrase:     jumpHost.SSHKeyPass,
+			HostKey:        jumpHost.HostKey,
+			Timeout:        clt.junosSSHTimeoutToEstab,
+		}
+		if auth.Username == "" {
+			auth.Username = clt.junosUserName
+		}
+		jumpHostsAuth[i] = sshJumpHostAuth{
+			Address: net.JoinHostPort(jumpHost.Address, strconv.Itoa(port)),
+			Auth:    &auth,
+		}
+	}
+
+	return jumpHostsAuth
+}
+
+func (clt *Client) NewSessionWithoutNetconf(ctx context.Context) *Session {
 	sess := Session{
-		logFile: clt.logFile,
+		ctx: newLogContext(ctx, clt.junosIP),
+	}
+	if clt.logFileDst != "" {
+		sess.logFile = clt.logFile
 	}
 	if clt.fakeCreateSetFile != "" {
 		sess.fakeSetFile = clt.appendFakeCreateSetFile
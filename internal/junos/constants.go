@@ -30,26 +30,45 @@ const (
 	OspfV2 = "ospf"
 	OspfV3 = "ospf3"
 
+	SSHAuthMethodPublicKey           = "publickey"
+	SSHAuthMethodPassword            = "password"
+	SSHAuthMethodKeyboardInteractive = "keyboard-interactive"
+
+	InterfaceReleaseDisable  = "disable"
+	InterfaceReleaseDelete   = "delete"
+	InterfaceReleaseGroup    = "group"
+	InterfaceReleaseSnapshot = "snapshot"
+	InterfaceReleaseTemplate = "template"
+
 	CantReadValuesNotEnoughFields = "can't read values for %s in '%s': not enough fields"
 
-	EnvHost                  = "JUNOS_HOST"
-	EnvPort                  = "JUNOS_PORT"
-	EnvUsername              = "JUNOS_USERNAME"
-	EnvPassword              = "JUNOS_PASSWORD"
-	EnvKeyPem                = "JUNOS_KEYPEM"
-	EnvKeyFile               = "JUNOS_KEYFILE"
-	EnvKeyPass               = "JUNOS_KEYPASS"
-	EnvGroupInterfaceDelete  = "JUNOS_GROUP_INTERFACE_DELETE"
-	EnvSleepShort            = "JUNOS_SLEEP_SHORT"
-	EnvSleepLock             = "JUNOS_SLEEP_LOCK"
-	EnvSleepSSHClosed        = "JUNOS_SLEEP_SSH_CLOSED"
-	EnvSSHTimeoutToEstablish = "JUNOS_SSH_TIMEOUT_TO_ESTABLISH"
-	EnvSSHRetryToEstablish   = "JUNOS_SSH_RETRY_TO_ESTABLISH"
-	EnvFilePermission        = "JUNOS_FILE_PERMISSION"
-	EnvLogPath               = "JUNOS_LOG_PATH"
-	EnvFakecreateSetfile     = "JUNOS_FAKECREATE_SETFILE"
-	EnvFakeupdateAlso        = "JUNOS_FAKEUPDATE_ALSO"
-	EnvFakedeleteAlso        = "JUNOS_FAKEDELETE_ALSO"
+	EnvHost                     = "JUNOS_HOST"
+	EnvPort                     = "JUNOS_PORT"
+	EnvUsername                 = "JUNOS_USERNAME"
+	EnvPassword                 = "JUNOS_PASSWORD"
+	EnvKeyPem                   = "JUNOS_KEYPEM"
+	EnvKeyFile                  = "JUNOS_KEYFILE"
+	EnvKeyPass                  = "JUNOS_KEYPASS"
+	EnvCertPem                  = "JUNOS_CERTPEM"
+	EnvCertFile                 = "JUNOS_CERTFILE"
+	EnvCredentialHelper         = "JUNOS_CREDENTIAL_HELPER"
+	EnvGroupInterfaceDelete     = "JUNOS_GROUP_INTERFACE_DELETE"
+	EnvInterfaceReleasePolicy   = "JUNOS_INTERFACE_RELEASE_POLICY"
+	EnvInterfaceReleaseTemplate = "JUNOS_INTERFACE_RELEASE_TEMPLATE"
+	EnvSleepShort               = "JUNOS_SLEEP_SHORT"
+	EnvSleepLock                = "JUNOS_SLEEP_LOCK"
+	EnvSleepSSHClosed           = "JUNOS_SLEEP_SSH_CLOSED"
+	EnvCommitTimeout            = "JUNOS_COMMIT_TIMEOUT"
+	EnvSSHTimeoutToEstablish    = "JUNOS_SSH_TIMEOUT_TO_ESTABLISH"
+	EnvSSHRetryToEstablish      = "JUNOS_SSH_RETRY_TO_ESTABLISH"
+	EnvSSHProxy                 = "JUNOS_SSH_PROXY"
+	EnvFilePermission           = "JUNOS_FILE_PERMISSION"
+	EnvLogPath                  = "JUNOS_LOG_PATH"
+	EnvMetricsPath              = "JUNOS_METRICS_PATH"
+	EnvAuditJournalPath         = "JUNOS_AUDIT_JOURNAL_PATH"
+	EnvFakecreateSetfile        = "JUNOS_FAKECREATE_SETFILE"
+	EnvFakeupdateAlso           = "JUNOS_FAKEUPDATE_ALSO"
+	EnvFakedeleteAlso           = "JUNOS_FAKEDELETE_ALSO"
 
 	DefaultInterfaceTestAcc        = "ge-0/0/3"
 	DefaultInterfaceTestAcc2       = "ge-0/0/4"
@@ -0,0 +1,142 @@
+package junos
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	bchk "github.com/jeremmfr/go-utils/basiccheck"
+)
+
+// InterfaceRelease describes how an interface is released when a resource is destroyed
+// and which configuration of an interface is considered as released (not configured).
+// The lines are set lines relative to the interface (like the output of 'display set relative').
+type InterfaceRelease struct {
+	policy  string
+	lines   []string // lines set on the interface to release it
+	disable []string // lines set on the interface to only disable it
+}
+
+// parseInterfaceReleaseTemplate splits the template in set lines relative to the interface.
+func parseInterfaceReleaseTemplate(template string) ([]string, error) {
+	lines := make([]string, 0)
+	for _, line := range strings.Split(template, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, SetLS) || strings.TrimSpace(strings.TrimPrefix(line, SetLS)) == "" {
+			return nil, fmt.Errorf("line %q in interface release template need to start with 'set '", line)
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+// Policy returns the policy used to release interfaces.
+func (release InterfaceRelease) Policy() string {
+	return release.policy
+}
+
+// WithSnapshot returns the release with the lines of snapshot captured
+// when the interface has been taken, only with the snapshot policy.
+func (release InterfaceRelease) WithSnapshot(snapshot []string) InterfaceRelease {
+	if release.policy == InterfaceReleaseSnapshot {
+		release.lines = snapshot
+	}
+
+	return release
+}
+
+// Logical returns the release for logical interfaces.
+// A logical interface is always deleted when destroying its resource and no snapshot is captured,
+// so the snapshot policy is handled like the delete policy
+// (only the lines to disable an interface are considered as released).
+func (release InterfaceRelease) Logical() InterfaceRelease {
+	if release.policy == InterfaceReleaseSnapshot {
+		release.policy = InterfaceReleaseDelete
+		release.lines = nil
+	}
+
+	return release
+}
+
+// LogMessage returns the operation to add in the log of commit when releasing an interface.
+func (release InterfaceRelease) LogMessage() string {
+	if release.policy == InterfaceReleaseDisable {
+		return "disable(NC)"
+	}
+
+	return "release(" + release.policy + ")"
+}
+
+// SetLines returns the set lines to release the interface
+// (nothing if the interface only needs to be deleted).
+func (release InterfaceRelease) SetLines(name string) []string {
+	return interfaceReleaseLines(SetLS+"interfaces "+name+" ", release.lines)
+}
+
+// DisableLines returns the set lines to disable the interface.
+func (release InterfaceRelease) DisableLines(name string) []string {
+	return interfaceReleaseLines(SetLS+"interfaces "+name+" ", release.disable)
+}
+
+// DelLines returns the delete lines to remove the configuration set
+// to release the interface (or to disable it without lines to release) before taking it.
+func (release InterfaceRelease) DelLines(name string) []string {
+	lines := release.lines
+	if len(lines) == 0 {
+		lines = release.disable
+	}
+	delLines := make([]string, 0, len(lines))
+	for _, line := range lines {
+		statement := strings.TrimPrefix(line, SetLS)
+		// the description can be deleted without its value (which can be quoted or not)
+		if strings.HasPrefix(statement, "description ") {
+			statement = "description"
+		}
+		if delLine := DeleteLS + "interfaces " + name + " " + statement; !bchk.InSlice(delLine, delLines) {
+			delLines = append(delLines, delLine)
+		}
+	}
+
+	return delLines
+}
+
+// IsReleased returns true if the configuration lines of the interface (relative set lines)
+// are the lines to release or to disable it.
+func (release InterfaceRelease) IsReleased(configLines []string) bool {
+	if len(configLines) == 0 {
+		return false
+	}
+
+	return sameLines(configLines, release.lines) || sameLines(configLines, release.disable)
+}
+
+func interfaceReleaseLines(prefix string, lines []string) []string {
+	output := make([]string, len(lines))
+	for i, line := range lines {
+		output[i] = prefix + strings.TrimPrefix(line, SetLS)
+	}
+
+	return output
+}
+
+// sameLines compares lines without taking into account the order.
+func sameLines(lines, expected []string) bool {
+	if len(lines) != len(expected) {
+		return false
+	}
+	sortedLines := append([]string(nil), lines...)
+	sortedExpected := append([]string(nil), expected...)
+	sort.Strings(sortedLines)
+	sort.Strings(sortedExpected)
+	for i, line := range sortedLines {
+		if line != sortedExpected[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,186 @@
+package junos
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInterfaceRelease(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		client          *Client
+		setLines        []string
+		disableLines    []string
+		delLines        []string
+		released        [][]string
+		notReleased     [][]string
+		logMessage      string
+		snapshot        []string
+		snapshotRelease bool
+	}
+
+	tests := map[string]testCase{
+		"disable": {
+			client:       NewClient("192.0.2.1"),
+			setLines:     []string{"set interfaces ge-0/0/3 description NC", "set interfaces ge-0/0/3 disable"},
+			disableLines: []string{"set interfaces ge-0/0/3 description NC", "set interfaces ge-0/0/3 disable"},
+			delLines:     []string{"delete interfaces ge-0/0/3 description", "delete interfaces ge-0/0/3 disable"},
+			released: [][]string{
+				{"set disable", "set description NC"},
+				{"set description NC", "set disable"},
+			},
+			notReleased: [][]string{
+				{},
+				{"set disable"},
+				{"set description NC", "set disable", "set mtu 9000"},
+			},
+			logMessage: "disable(NC)",
+		},
+		"disable_group": {
+			client:       NewClient("192.0.2.1").WithGroupInterfaceDelete("NC"),
+			setLines:     []string{"set interfaces ge-0/0/3 apply-groups NC"},
+			disableLines: []string{"set interfaces ge-0/0/3 apply-groups NC"},
+			delLines:     []string{"delete interfaces ge-0/0/3 apply-groups NC"},
+			released:     [][]string{{"set apply-groups NC"}},
+			notReleased:  [][]string{{"set description NC", "set disable"}},
+			logMessage:   "disable(NC)",
+		},
+		"delete": {
+			client:       NewClient("192.0.2.1"),
+			setLines:     []string{},
+			disableLines: []string{"set interfaces ge-0/0/3 description NC", "set interfaces ge-0/0/3 disable"},
+			delLines:     []string{"delete interfaces ge-0/0/3 description", "delete interfaces ge-0/0/3 disable"},
+			released:     [][]string{{"set description NC", "set disable"}},
+			notReleased:  [][]string{{"set disable"}},
+			logMessage:   "release(delete)",
+		},
+		"template": {
+			client:       NewClient("192.0.2.1").WithGroupInterfaceDelete("NC"),
+			setLines:     []string{"set interfaces ge-0/0/3 description \"free port\"", "set interfaces ge-0/0/3 mtu 1514"},
+			disableLines: []string{"set interfaces ge-0/0/3 description \"free port\"", "set interfaces ge-0/0/3 mtu 1514"},
+			delLines:     []string{"delete interfaces ge-0/0/3 description", "delete interfaces ge-0/0/3 mtu 1514"},
+			released:     [][]string{{"set mtu 1514", "set description \"free port\""}},
+			notReleased:  [][]string{{"set apply-groups NC"}, {"set description NC", "set disable"}},
+			logMessage:   "release(template)",
+		},
+		"snapshot": {
+			client:          NewClient("192.0.2.1"),
+			setLines:        []string{"set interfaces ge-0/0/3 description uplink", "set interfaces ge-0/0/3 mtu 9000"},
+			disableLines:    []string{"set interfaces ge-0/0/3 description NC", "set interfaces ge-0/0/3 disable"},
+			delLines:        []string{"delete interfaces ge-0/0/3 description", "delete interfaces ge-0/0/3 mtu 9000"},
+			released:        [][]string{{"set mtu 9000", "set description uplink"}, {"set description NC", "set disable"}},
+			notReleased:     [][]string{{"set mtu 9000"}},
+			logMessage:      "release(snapshot)",
+			snapshot:        []string{"set description uplink", "set mtu 9000"},
+			snapshotRelease: true,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			policy := name
+			if name == "disable_group" {
+				policy = InterfaceReleaseDisable
+			}
+			if _, err := test.client.WithInterfaceReleasePolicy(policy); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if policy == InterfaceReleaseTemplate {
+				if _, err := test.client.WithInterfaceReleaseTemplate(
+					"set description \"free port\"\n\n  set mtu 1514\n",
+				); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+			if err := test.client.CheckInterfaceRelease(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			release := test.client.InterfaceRelease().WithSnapshot(test.snapshot)
+
+			if got := release.SetLines("ge-0/0/3"); !reflect.DeepEqual(got, test.setLines) {
+				t.Errorf("SetLines() = %q, expected %q", got, test.setLines)
+			}
+			if got := release.DisableLines("ge-0/0/3"); !reflect.DeepEqual(got, test.disableLines) {
+				t.Errorf("DisableLines() = %q, expected %q", got, test.disableLines)
+			}
+			if got := release.DelLines("ge-0/0/3"); !reflect.DeepEqual(got, test.delLines) {
+				t.Errorf("DelLines() = %q, expected %q", got, test.delLines)
+			}
+			for _, lines := range test.released {
+				if !release.IsReleased(lines) {
+					t.Errorf("IsReleased(%q) = false, expected true", lines)
+				}
+			}
+			for _, lines := range test.notReleased {
+				if release.IsReleased(lines) {
+					t.Errorf("IsReleased(%q) = true, expected false", lines)
+				}
+			}
+			if got := release.LogMessage(); got != test.logMessage {
+				t.Errorf("LogMessage() = %q, expected %q", got, test.logMessage)
+			}
+		})
+	}
+}
+
+func TestInterfaceReleaseErrors(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient("192.0.2.1")
+	if _, err := client.WithInterfaceReleasePolicy("shutdown"); err == nil {
+		t.Errorf("expected error with unknown policy")
+	}
+	if _, err := client.WithInterfaceReleaseTemplate("set disable\ndelete description"); err == nil {
+		t.Errorf("expected error with line without set")
+	}
+	if _, err := client.WithInterfaceReleasePolicy(InterfaceReleaseGroup); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := client.CheckInterfaceRelease(); err == nil {
+		t.Errorf("expected error with group policy without group")
+	}
+	if _, err := client.WithInterfaceReleasePolicy(InterfaceReleaseTemplate); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := client.CheckInterfaceRelease(); err == nil {
+		t.Errorf("expected error with template policy without template")
+	}
+}
+
+func TestInterfaceReleaseLogical(t *testing.T) {
+	t.Parallel()
+
+	client, err := NewClient("192.0.2.1").WithInterfaceReleasePolicy(InterfaceReleaseSnapshot)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	release := client.InterfaceRelease().WithSnapshot([]string{"set description uplink"}).Logical()
+	if got := release.Policy(); got != InterfaceReleaseDelete {
+		t.Errorf("Policy() = %q, expected %q", got, InterfaceReleaseDelete)
+	}
+	if got := release.SetLines("ge-0/0/3.0"); len(got) != 0 {
+		t.Errorf("SetLines() = %q, expected no lines", got)
+	}
+	if release.IsReleased([]string{"set description uplink"}) {
+		t.Errorf("IsReleased() = true with lines of snapshot, expected false")
+	}
+	if !release.IsReleased([]string{"set description NC", "set disable"}) {
+		t.Errorf("IsReleased() = false with lines to disable, expected true")
+	}
+
+	template, err := NewClient("192.0.2.1").WithInterfaceReleaseTemplate("set disable")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := template.WithInterfaceReleasePolicy(InterfaceReleaseTemplate); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := template.InterfaceRelease().Logical().SetLines("ge-0/0/3.0"); !reflect.DeepEqual(
+		got, []string{"set interfaces ge-0/0/3.0 disable"},
+	) {
+		t.Errorf("SetLines() = %q, expected the lines of template", got)
+	}
+}
@@ -0,0 +1,143 @@
+package junos
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	journalCommitSuccess  = "success"
+	journalCommitFailure  = "failure"
+	journalCommitNone     = "not_committed"
+	journalOperationClear = "clear"
+)
+
+// journalMutex serializes the writes in journal files between sessions.
+var journalMutex = &sync.Mutex{} //nolint:gochecknoglobals
+
+// journalEntry is a line of the audit journal with the configuration lines loaded
+// and the result of their commit.
+type journalEntry struct {
+	Timestamp      string   `json:"timestamp"`
+	Device         string   `json:"device"`
+	ResourceType   string   `json:"resource_type,omitempty"`
+	ResourceID     string   `json:"resource_id,omitempty"`
+	Operation      string   `json:"operation"`
+	Lines          []string `json:"lines"`
+	LoadError      string   `json:"load_error,omitempty"`
+	CommitResult   string   `json:"commit_result"`
+	CommitError    string   `json:"commit_error,omitempty"`
+	CommitWarnings []string `json:"commit_warnings,omitempty"`
+	CommitRevision string   `json:"commit_revision,omitempty"`
+}
+
+// journalOperation extracts the operation and the resource type from the log message of commit
+// ('<operation> resource <type>').
+func journalOperation(logMessage string) (operation, resourceType string) {
+	operation, resourceType, found := strings.Cut(logMessage, " resource ")
+	if !found {
+		return logMessage, ""
+	}
+
+	return strings.TrimSpace(operation), strings.TrimSpace(resourceType)
+}
+
+// SetResourceID sets the ID of the resource managed by the session to add it in the audit journal.
+func (sess *Session) SetResourceID(id string) {
+	sess.resourceID = id
+}
+
+// journalCommit writes an entry in the audit journal (if set) for the commit
+// with the configuration lines loaded since the last entry and the result of commit.
+func (sess *Session) journalCommit(logMessage, revision string, warnings []error, commitErr error) error {
+	if sess.journal == nil {
+		return nil
+	}
+	entry := sess.newJournalEntry()
+	entry.Operation, entry.ResourceType = journalOperation(logMessage)
+	entry.CommitResult = journalCommitSuccess
+	for _, warning := range warnings {
+		entry.CommitWarnings = append(entry.CommitWarnings, maskSensitiveData(warning.Error()))
+	}
+	if commitErr != nil {
+		entry.CommitResult = journalCommitFailure
+		entry.CommitError = maskSensitiveData(commitErr.Error())
+	} else {
+		entry.CommitRevision = revision
+	}
+
+	return sess.writeJournal(entry)
+}
+
+// journalDiscard writes an entry in the audit journal (if set) for the configuration lines
+// loaded since the last entry but cleared without commit
+// (loading failed, check after loading failed, ...).
+func (sess *Session) journalDiscard() error {
+	if sess.journal == nil || len(sess.journalLines) == 0 {
+		return nil
+	}
+	entry := sess.newJournalEntry()
+	entry.Operation = journalOperationClear
+	entry.CommitResult = journalCommitNone
+
+	return sess.writeJournal(entry)
+}
+
+// newJournalEntry returns an entry of audit journal with the configuration lines
+// loaded since the last entry (secrets masked).
+func (sess *Session) newJournalEntry() journalEntry {
+	entry := journalEntry{
+		Timestamp:  time.Now().UTC().Format(time.RFC3339Nano),
+		ResourceID: sess.resourceID,
+		Lines:      make([]string, len(sess.journalLines)),
+	}
+	for i, line := range sess.journalLines {
+		entry.Lines[i] = maskSensitiveData(line)
+	}
+	if sess.journalLoadErr != nil {
+		entry.LoadError = maskSensitiveData(sess.journalLoadErr.Error())
+	}
+
+	return entry
+}
+
+// writeJournal writes the entry in the audit journal and resets the lines recorded.
+func (sess *Session) writeJournal(entry journalEntry) error {
+	sess.journalLines = nil
+	sess.journalLoadErr = nil
+
+	return sess.journal(entry)
+}
+
+// appendJournal appends the entry in JSON to the audit journal file.
+func (clt *Client) appendJournal(entry journalEntry) error {
+	entry.Device = clt.junosIP
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("encoding audit journal entry: %w", err)
+	}
+	journalMutex.Lock()
+	defer journalMutex.Unlock()
+	dirJournal := filepath.Dir(clt.auditJournalFile)
+	if _, err := os.Stat(dirJournal); err != nil {
+		if err := os.MkdirAll(dirJournal, os.FileMode(directoryPermission)); err != nil {
+			return fmt.Errorf("creating parent directory of '%s': %w", clt.auditJournalFile, err)
+		}
+	}
+	f, err := os.OpenFile(clt.auditJournalFile,
+		os.O_APPEND|os.O_CREATE|os.O_WRONLY, os.FileMode(clt.filePermission))
+	if err != nil {
+		return fmt.Errorf("opening file '%s': %w", clt.auditJournalFile, err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("writing in file '%s': %w", clt.auditJournalFile, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package junos
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jeremmfr/go-netconf/netconf"
+)
+
+func TestJournalOperation(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string][2]string{
+		"create resource junos_interface_logical": {"create", "junos_interface_logical"},
+		"disable(NC) resource junos_interface":    {"disable(NC)", "junos_interface"},
+		"commit a file with resource junos_null_commit_file": {
+			"commit a file with", "junos_null_commit_file",
+		},
+		"manual commit": {"manual commit", ""},
+	}
+	for logMessage, expected := range tests {
+		operation, resourceType := journalOperation(logMessage)
+		if operation != expected[0] || resourceType != expected[1] {
+			t.Errorf("journalOperation(%q) = %q, %q, expected %q, %q",
+				logMessage, operation, resourceType, expected[0], expected[1])
+		}
+	}
+}
+
+func TestSessionJournalCommit(t *testing.T) {
+	t.Parallel()
+
+	const replyCommitRevision = "<rpc-reply><commit-results><routing-engine><name>re0</name>" +
+		"<commit-success/><commit-revision>re0-1690884000-42</commit-revision>" +
+		"</routing-engine></commit-results></rpc-reply>"
+
+	journalFile := filepath.Join(t.TempDir(), "journal", "audit.jsonl")
+	client := NewClient("192.0.2.1").WithAuditJournalFile(journalFile)
+	for _, commitReply := range []string{
+		replyCommitRevision,
+		"<rpc-reply><rpc-error><error-severity>error</error-severity>" +
+			"<error-message>configuration check-out failed</error-message></rpc-error></rpc-reply>",
+		"", // no commit, configuration cleared
+	} {
+		transport := &testScriptTransport{replies: []string{testReplyOk, testReplyOk}}
+		if commitReply != "" {
+			transport.replies = append(transport.replies, commitReply)
+		}
+		transport.replies = append(transport.replies, testReplyOk, testReplyOk)
+		sess := &Session{
+			ctx:     context.Background(),
+			netconf: &netconf.Session{Transport: transport},
+			journal: client.appendJournal,
+		}
+		if err := sess.ConfigLock(context.Background()); err != nil {
+			t.Fatalf("unexpected lock error: %s", err)
+		}
+		if err := sess.ConfigSet([]string{
+			"set system login user test authentication encrypted-password \"$6$abcdef\"",
+		}); err != nil {
+			t.Fatalf("unexpected set error: %s", err)
+		}
+		sess.SetResourceID("test")
+		if commitReply != "" {
+			_, _ = sess.CommitConf("create resource junos_system_login_user")
+		}
+		_ = sess.ConfigClear()
+	}
+
+	content, err := os.ReadFile(journalFile)
+	if err != nil {
+		t.Fatalf("reading journal: %s", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines in journal, got:\n%s", content)
+	}
+	entries := make([]journalEntry, len(lines))
+	for i, line := range lines {
+		if err := json.Unmarshal([]byte(line), &entries[i]); err != nil {
+			t.Fatalf("decoding journal line: %s", err)
+		}
+		if len(entries[i].Lines) != 1 || strings.Contains(entries[i].Lines[0], "$6$") {
+			t.Errorf("unexpected lines (or secret not masked) in journal entry: %v", entries[i].Lines)
+		}
+	}
+	success, failure, cleared := entries[0], entries[1], entries[2]
+
+	if success.Device != "192.0.2.1" ||
+		success.ResourceType != "junos_system_login_user" ||
+		success.ResourceID != "test" ||
+		success.Operation != "create" ||
+		success.CommitResult != journalCommitSuccess ||
+		success.CommitRevision != "re0-1690884000-42" {
+		t.Errorf("unexpected journal entry for successful commit: %s", lines[0])
+	}
+	if failure.CommitResult != journalCommitFailure ||
+		!strings.Contains(failure.CommitError, "configuration check-out failed") ||
+		failure.CommitRevision != "" {
+		t.Errorf("unexpected journal entry for failed commit: %s", lines[1])
+	}
+	if cleared.Operation != journalOperationClear ||
+		cleared.CommitResult != journalCommitNone ||
+		cleared.ResourceID != "test" {
+		t.Errorf("unexpected journal entry for cleared configuration: %s", lines[2])
+	}
+}
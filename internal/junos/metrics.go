@@ -0,0 +1,530 @@
+package junos
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	metricSessionEstablishment = "junos_session_establishment_seconds"
+	metricRPCDuration          = "junos_rpc_duration_seconds"
+	metricRPCErrors            = "junos_rpc_errors"
+	metricLockWait             = "junos_config_lock_wait_seconds"
+	metricCommitDuration       = "junos_commit_duration_seconds"
+	metricRetries              = "junos_retries"
+
+	metricRetryConnect  = "connect"
+	metricRetryLock     = "lock"
+	metricRetryRecovery = "session_recovery"
+)
+
+type metricDefinition struct {
+	help  string
+	label string
+}
+
+var metricDefinitions = map[string]metricDefinition{ //nolint:gochecknoglobals
+	metricSessionEstablishment: {
+		help: "Time to establish a netconf session.",
+	},
+	metricRPCDuration: {
+		help:  "Latency of netconf rpc by rpc type.",
+		label: "rpc",
+	},
+	metricRPCErrors: {
+		help:  "Number of netconf rpc returning an error by rpc type.",
+		label: "rpc",
+	},
+	metricLockWait: {
+		help: "Time to wait for the lock of candidate configuration.",
+	},
+	metricCommitDuration: {
+		help: "Duration of commits.",
+	},
+	metricRetries: {
+		help:  "Number of retries by operation.",
+		label: "operation",
+	},
+}
+
+// metricBuckets are the upper bounds (in seconds) of histogram buckets.
+var metricBuckets = []float64{ //nolint:gochecknoglobals
+	0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300,
+}
+
+// metricsFiles stores the metrics collectors by path of file to write them when the provider shuts down.
+var metricsFiles = struct { //nolint:gochecknoglobals
+	mutex      sync.Mutex
+	collectors map[string]*metricsCollector
+}{
+	collectors: make(map[string]*metricsCollector),
+}
+
+type metricKey struct {
+	name       string
+	device     string
+	labelValue string
+}
+
+type metricHistogram struct {
+	buckets []uint64
+	count   uint64
+	sum     float64
+}
+
+// metricsCollector collects counters and histograms of netconf exchanges for devices.
+type metricsCollector struct {
+	mutex      sync.Mutex
+	permission os.FileMode
+	counters   map[metricKey]uint64
+	histograms map[metricKey]*metricHistogram
+}
+
+// deviceMetrics records metrics of a device in a collector (nothing is recorded if nil).
+type deviceMetrics struct {
+	collector *metricsCollector
+	device    string
+}
+
+// registerMetricsFile returns the collector for the file path,
+// shared between clients configured with the same path.
+func registerMetricsFile(path string, permission os.FileMode) *metricsCollector {
+	metricsFiles.mutex.Lock()
+	defer metricsFiles.mutex.Unlock()
+	if collector, ok := metricsFiles.collectors[path]; ok {
+		return collector
+	}
+	collector := newMetricsCollector(permission)
+	metricsFiles.collectors[path] = collector
+
+	return collector
+}
+
+func newMetricsCollector(permission os.FileMode) *metricsCollector {
+	return &metricsCollector{
+		permission: permission,
+		counters:   make(map[metricKey]uint64),
+		histograms: make(map[metricKey]*metricHistogram),
+	}
+}
+
+// WriteMetricsFiles writes the metrics collected in each file configured on clients,
+// in JSON format if the path has the .json extension, otherwise in OpenMetrics text format.
+// The metrics are added to those already in the file (written by previous provider processes).
+func WriteMetricsFiles() error {
+	metricsFiles.mutex.Lock()
+	defer metricsFiles.mutex.Unlock()
+
+	paths := make([]string, 0, len(metricsFiles.collectors))
+	for path := range metricsFiles.collectors {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	var errs []error
+	for _, path := range paths {
+		if err := metricsFiles.collectors[path].writeFile(path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m *deviceMetrics) observe(name, labelValue string, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.collector.observe(metricKey{name: name, device: m.device, labelValue: labelValue}, duration.Seconds())
+}
+
+func (m *deviceMetrics) inc(name, labelValue string) {
+	if m == nil {
+		return
+	}
+	m.collector.inc(metricKey{name: name, device: m.device, labelValue: labelValue})
+}
+
+func (c *metricsCollector) observe(key metricKey, value float64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	histogram := c.histogram(key)
+	for i, bound := range metricBuckets {
+		if value <= bound {
+			histogram.buckets[i]++
+		}
+	}
+	histogram.count++
+	histogram.sum += value
+}
+
+func (c *metricsCollector) inc(key metricKey) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.counters[key]++
+}
+
+// histogram returns the histogram of key, created if it doesn't exist.
+func (c *metricsCollector) histogram(key metricKey) *metricHistogram {
+	histogram, ok := c.histograms[key]
+	if !ok {
+		histogram = &metricHistogram{buckets: make([]uint64, len(metricBuckets))}
+		c.histograms[key] = histogram
+	}
+
+	return histogram
+}
+
+// add adds the counters and histograms of other in c.
+func (c *metricsCollector) add(other *metricsCollector) {
+	for key, value := range other.counters {
+		c.counters[key] += value
+	}
+	for key, otherHistogram := range other.histograms {
+		histogram := c.histogram(key)
+		for i := range histogram.buckets {
+			histogram.buckets[i] += otherHistogram.buckets[i]
+		}
+		histogram.count += otherHistogram.count
+		histogram.sum += otherHistogram.sum
+	}
+}
+
+func (c *metricsCollector) writeFile(path string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	jsonFormat := strings.EqualFold(filepath.Ext(path), ".json")
+	// Terraform starts a provider process for each command,
+	// so add the metrics of previous processes already in the file
+	merged := newMetricsCollector(c.permission)
+	if content, err := os.ReadFile(path); err == nil {
+		previous, err := parseMetrics(content, jsonFormat)
+		if err != nil {
+			return fmt.Errorf("reading previous metrics in file %s: %w", path, err)
+		}
+		merged.add(previous)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("reading metrics file %s: %w", path, err)
+	}
+	merged.add(c)
+
+	var content []byte
+	if jsonFormat {
+		var err error
+		content, err = json.MarshalIndent(merged.jsonReport(), "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding metrics to json: %w", err)
+		}
+		content = append(content, '\n')
+	} else {
+		content = []byte(merged.openMetrics())
+	}
+	if err := os.WriteFile(path, content, c.permission); err != nil {
+		return fmt.Errorf("writing metrics file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// sortedKeys returns the keys of metrics sorted by name, device and label value.
+func sortedKeys[V any](metrics map[metricKey]V) []metricKey {
+	keys := make([]metricKey, 0, len(metrics))
+	for key := range metrics {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].name != keys[j].name {
+			return keys[i].name < keys[j].name
+		}
+		if keys[i].device != keys[j].device {
+			return keys[i].device < keys[j].device
+		}
+
+		return keys[i].labelValue < keys[j].labelValue
+	})
+
+	return keys
+}
+
+// labels returns the labels of metric in OpenMetrics format with extra labels at the end.
+func (key metricKey) labels(extra ...string) string {
+	labels := []string{"device=" + strconv.Quote(key.device)}
+	if label := metricDefinitions[key.name].label; label != "" {
+		labels = append(labels, label+"="+strconv.Quote(key.labelValue))
+	}
+	labels = append(labels, extra...)
+
+	return "{" + strings.Join(labels, ",") + "}"
+}
+
+// openMetrics generates the metrics in OpenMetrics text format.
+func (c *metricsCollector) openMetrics() string {
+	var output strings.Builder
+	family := ""
+	for _, key := range sortedKeys(c.counters) {
+		if key.name != family {
+			family = key.name
+			output.WriteString("# TYPE " + key.name + " counter\n")
+			output.WriteString("# HELP " + key.name + " " + metricDefinitions[key.name].help + "\n")
+		}
+		output.WriteString(key.name + "_total" + key.labels() + " " + strconv.FormatUint(c.counters[key], 10) + "\n")
+	}
+	for _, key := range sortedKeys(c.histograms) {
+		if key.name != family {
+			family = key.name
+			output.WriteString("# TYPE " + key.name + " histogram\n")
+			output.WriteString("# UNIT " + key.name + " seconds\n")
+			output.WriteString("# HELP " + key.name + " " + metricDefinitions[key.name].help + "\n")
+		}
+		histogram := c.histograms[key]
+		for i, bound := range metricBuckets {
+			output.WriteString(key.name + "_bucket" +
+				key.labels("le="+strconv.Quote(strconv.FormatFloat(bound, 'f', -1, 64))) + " " +
+				strconv.FormatUint(histogram.buckets[i], 10) + "\n")
+		}
+		output.WriteString(key.name + "_bucket" + key.labels(`le="+Inf"`) + " " +
+			strconv.FormatUint(histogram.count, 10) + "\n")
+		output.WriteString(key.name + "_count" + key.labels() + " " + strconv.FormatUint(histogram.count, 10) + "\n")
+		output.WriteString(key.name + "_sum" + key.labels() + " " + strconv.FormatFloat(histogram.sum, 'f', -1, 64) + "\n")
+	}
+	output.WriteString("# EOF\n")
+
+	return output.String()
+}
+
+type metricsJSONReport struct {
+	Counters   []metricsJSONCounter   `json:"counters"`
+	Histograms []metricsJSONHistogram `json:"histograms"`
+}
+
+type metricsJSONCounter struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels"`
+	Value  uint64            `json:"value"`
+}
+
+type metricsJSONHistogram struct {
+	Name    string              `json:"name"`
+	Labels  map[string]string   `json:"labels"`
+	Count   uint64              `json:"count"`
+	Sum     float64             `json:"sum"`
+	Buckets []metricsJSONBucket `json:"buckets"`
+}
+
+type metricsJSONBucket struct {
+	UpperBound float64 `json:"le"`
+	Count      uint64  `json:"count"`
+}
+
+func (key metricKey) jsonLabels() map[string]string {
+	labels := map[string]string{"device": key.device}
+	if label := metricDefinitions[key.name].label; label != "" {
+		labels[label] = key.labelValue
+	}
+
+	return labels
+}
+
+// jsonReport generates the metrics to encode in JSON.
+func (c *metricsCollector) jsonReport() metricsJSONReport {
+	report := metricsJSONReport{
+		Counters:   make([]metricsJSONCounter, 0, len(c.counters)),
+		Histograms: make([]metricsJSONHistogram, 0, len(c.histograms)),
+	}
+	for _, key := range sortedKeys(c.counters) {
+		report.Counters = append(report.Counters, metricsJSONCounter{
+			Name:   key.name + "_total",
+			Labels: key.jsonLabels(),
+			Value:  c.counters[key],
+		})
+	}
+	for _, key := range sortedKeys(c.histograms) {
+		histogram := c.histograms[key]
+		buckets := make([]metricsJSONBucket, len(metricBuckets))
+		for i, bound := range metricBuckets {
+			buckets[i] = metricsJSONBucket{UpperBound: bound, Count: histogram.buckets[i]}
+		}
+		report.Histograms = append(report.Histograms, metricsJSONHistogram{
+			Name:    key.name,
+			Labels:  key.jsonLabels(),
+			Count:   histogram.count,
+			Sum:     histogram.sum,
+			Buckets: buckets,
+		})
+	}
+
+	return report
+}
+
+// parseMetrics reads the metrics of a file written by writeFile
+// (in JSON or OpenMetrics text format).
+func parseMetrics(content []byte, jsonFormat bool) (*metricsCollector, error) {
+	if jsonFormat {
+		var report metricsJSONReport
+		if err := json.Unmarshal(content, &report); err != nil {
+			return nil, fmt.Errorf("decoding json: %w", err)
+		}
+
+		return report.collector()
+	}
+
+	return parseOpenMetrics(string(content))
+}
+
+// metricKeyFromLabels returns the key of metric with its name and its labels.
+func metricKeyFromLabels(name string, labels map[string]string) (metricKey, error) {
+	definition, ok := metricDefinitions[name]
+	if !ok {
+		return metricKey{}, fmt.Errorf("unknown metric %q", name)
+	}
+	key := metricKey{name: name, device: labels["device"]}
+	if definition.label != "" {
+		key.labelValue = labels[definition.label]
+	}
+
+	return key, nil
+}
+
+// collector returns the metrics of the JSON report in a collector.
+func (report metricsJSONReport) collector() (*metricsCollector, error) {
+	c := newMetricsCollector(0)
+	for _, counter := range report.Counters {
+		key, err := metricKeyFromLabels(strings.TrimSuffix(counter.Name, "_total"), counter.Labels)
+		if err != nil {
+			return nil, err
+		}
+		c.counters[key] += counter.Value
+	}
+	for _, jsonHistogram := range report.Histograms {
+		key, err := metricKeyFromLabels(jsonHistogram.Name, jsonHistogram.Labels)
+		if err != nil {
+			return nil, err
+		}
+		histogram := c.histogram(key)
+		for _, bucket := range jsonHistogram.Buckets {
+			i := sort.SearchFloat64s(metricBuckets, bucket.UpperBound)
+			if i == len(metricBuckets) || metricBuckets[i] != bucket.UpperBound {
+				return nil, fmt.Errorf("unknown bucket %v for metric %q", bucket.UpperBound, jsonHistogram.Name)
+			}
+			histogram.buckets[i] += bucket.Count
+		}
+		histogram.count += jsonHistogram.Count
+		histogram.sum += jsonHistogram.Sum
+	}
+
+	return c, nil
+}
+
+// parseOpenMetrics reads the metrics in OpenMetrics text format generated by openMetrics.
+func parseOpenMetrics(content string) (*metricsCollector, error) {
+	c := newMetricsCollector(0)
+	for _, line := range strings.Split(content, "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		series, labels, value, err := parseOpenMetricsLine(line)
+		if err != nil {
+			return nil, err
+		}
+		name, suffix, found := "", "", false
+		for _, suffix = range []string{"_total", "_bucket", "_count", "_sum"} {
+			name, found = strings.CutSuffix(series, suffix)
+			if _, ok := metricDefinitions[name]; found && ok {
+				break
+			}
+			found = false
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown metric %q", series)
+		}
+		key, err := metricKeyFromLabels(name, labels)
+		if err != nil {
+			return nil, err
+		}
+		if suffix == "_total" {
+			count, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing value of %q: %w", line, err)
+			}
+			c.counters[key] += count
+
+			continue
+		}
+		histogram := c.histogram(key)
+		switch suffix {
+		case "_bucket":
+			if labels["le"] == "+Inf" {
+				continue
+			}
+			bound, err := strconv.ParseFloat(labels["le"], 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing bucket of %q: %w", line, err)
+			}
+			i := sort.SearchFloat64s(metricBuckets, bound)
+			if i == len(metricBuckets) || metricBuckets[i] != bound {
+				return nil, fmt.Errorf("unknown bucket in %q", line)
+			}
+			count, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing value of %q: %w", line, err)
+			}
+			histogram.buckets[i] += count
+		case "_count":
+			count, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing value of %q: %w", line, err)
+			}
+			histogram.count += count
+		case "_sum":
+			sum, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing value of %q: %w", line, err)
+			}
+			histogram.sum += sum
+		}
+	}
+
+	return c, nil
+}
+
+// parseOpenMetricsLine splits a line of metric in OpenMetrics text format
+// ('<series>{<label>="<value>",...} <value>').
+func parseOpenMetricsLine(line string) (series string, labels map[string]string, value string, _ error) {
+	series, rest, found := strings.Cut(line, "{")
+	if !found {
+		return "", nil, "", fmt.Errorf("metric without labels: %q", line)
+	}
+	labels = make(map[string]string)
+	for !strings.HasPrefix(rest, "}") {
+		name, quoted, found := strings.Cut(rest, "=")
+		if !found {
+			return "", nil, "", fmt.Errorf("malformed labels: %q", line)
+		}
+		quotedValue, err := strconv.QuotedPrefix(quoted)
+		if err != nil {
+			return "", nil, "", fmt.Errorf("malformed label %q: %q", name, line)
+		}
+		labels[name], _ = strconv.Unquote(quotedValue)
+		rest = strings.TrimPrefix(quoted[len(quotedValue):], ",")
+	}
+
+	return series, labels, strings.TrimSpace(strings.TrimPrefix(rest, "}")), nil
+}
+
+// rpcType returns the name of the first element of rpc to use it as metric label.
+func rpcType(rpc string) string {
+	name := strings.TrimPrefix(strings.TrimSpace(rpc), "<")
+	if i := strings.IndexAny(name, " />"); i >= 0 {
+		name = name[:i]
+	}
+
+	return name
+}
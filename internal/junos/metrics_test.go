@@ -0,0 +1,142 @@
+package junos
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRPCType(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		rpcCandidateLock:                          "lock",
+		rpcCompareActive:                          "get-configuration",
+		RPCGetAlarmInformation:                    "get-alarm-information",
+		"<command format=\"text\">show</command>": "command",
+		" <get-commit-information/>":              "get-commit-information",
+	}
+	for rpc, expected := range tests {
+		if got := rpcType(rpc); got != expected {
+			t.Errorf("rpcType(%q) = %q, expected %q", rpc, got, expected)
+		}
+	}
+}
+
+func TestMetricsWriteFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	openMetricsFile := filepath.Join(dir, "metrics.txt")
+	jsonFile := filepath.Join(dir, "metrics.json")
+	for _, file := range []string{openMetricsFile, jsonFile} {
+		client := NewClient("192.0.2.1").WithMetricsFile(file)
+		if client.WithMetricsFile(file).metrics != registerMetricsFile(file, 0o644) {
+			t.Fatalf("collector not shared for same file")
+		}
+		metrics := client.deviceMetrics()
+		metrics.observe(metricRPCDuration, "command", 20*time.Millisecond)
+		metrics.observe(metricRPCDuration, "command", 2*time.Second)
+		metrics.inc(metricRPCErrors, "lock")
+		metrics.inc(metricRetries, metricRetryLock)
+		metrics.inc(metricRetries, metricRetryLock)
+	}
+	var noMetrics *deviceMetrics
+	noMetrics.observe(metricCommitDuration, "", time.Second)
+	noMetrics.inc(metricRetries, metricRetryConnect)
+
+	if err := WriteMetricsFiles(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	content, err := os.ReadFile(openMetricsFile)
+	if err != nil {
+		t.Fatalf("reading file: %s", err)
+	}
+	for _, line := range []string{
+		"# TYPE junos_retries counter",
+		`junos_retries_total{device="192.0.2.1",operation="lock"} 2`,
+		`junos_rpc_errors_total{device="192.0.2.1",rpc="lock"} 1`,
+		"# TYPE junos_rpc_duration_seconds histogram",
+		`junos_rpc_duration_seconds_bucket{device="192.0.2.1",rpc="command",le="0.025"} 1`,
+		`junos_rpc_duration_seconds_bucket{device="192.0.2.1",rpc="command",le="2.5"} 2`,
+		`junos_rpc_duration_seconds_bucket{device="192.0.2.1",rpc="command",le="+Inf"} 2`,
+		`junos_rpc_duration_seconds_count{device="192.0.2.1",rpc="command"} 2`,
+		`junos_rpc_duration_seconds_sum{device="192.0.2.1",rpc="command"} 2.02`,
+	} {
+		if !strings.Contains(string(content), line+"\n") {
+			t.Errorf("line %q not found in OpenMetrics file:\n%s", line, content)
+		}
+	}
+	if !strings.HasSuffix(string(content), "# EOF\n") {
+		t.Errorf("OpenMetrics file doesn't end with EOF marker")
+	}
+
+	content, err = os.ReadFile(jsonFile)
+	if err != nil {
+		t.Fatalf("reading file: %s", err)
+	}
+	var report metricsJSONReport
+	if err := json.Unmarshal(content, &report); err != nil {
+		t.Fatalf("decoding json file: %s", err)
+	}
+	if len(report.Counters) != 2 || len(report.Histograms) != 1 {
+		t.Fatalf("unexpected metrics in json file:\n%s", content)
+	}
+	if c := report.Counters[0]; c.Name != "junos_retries_total" || c.Labels["operation"] != "lock" || c.Value != 2 {
+		t.Errorf("unexpected counter in json file: %+v", c)
+	}
+	if h := report.Histograms[0]; h.Count != 2 || h.Labels["rpc"] != "command" || h.Labels["device"] != "192.0.2.1" {
+		t.Errorf("unexpected histogram in json file: %+v", h)
+	}
+}
+
+func TestMetricsWriteFileMerge(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for _, file := range []string{filepath.Join(dir, "metrics.txt"), filepath.Join(dir, "metrics.json")} {
+		// a collector per provider process
+		for range [2]struct{}{} {
+			metrics := &deviceMetrics{collector: newMetricsCollector(0o644), device: "192.0.2.1"}
+			metrics.observe(metricRPCDuration, "command", 20*time.Millisecond)
+			metrics.observe(metricCommitDuration, "", 2*time.Second)
+			metrics.inc(metricRetries, metricRetryLock)
+			if err := metrics.collector.writeFile(file); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("reading file: %s", err)
+		}
+		merged, err := parseMetrics(content, strings.HasSuffix(file, ".json"))
+		if err != nil {
+			t.Fatalf("unexpected error parsing %s: %s", file, err)
+		}
+		retries := metricKey{name: metricRetries, device: "192.0.2.1", labelValue: metricRetryLock}
+		if got := merged.counters[retries]; got != 2 {
+			t.Errorf("unexpected counter in %s: got %d, want 2", file, got)
+		}
+		rpcDuration := metricKey{name: metricRPCDuration, device: "192.0.2.1", labelValue: "command"}
+		if h := merged.histograms[rpcDuration]; h == nil || h.count != 2 || h.buckets[2] != 2 || h.sum != 0.04 {
+			t.Errorf("unexpected rpc duration histogram in %s: %+v", file, h)
+		}
+		commitDuration := metricKey{name: metricCommitDuration, device: "192.0.2.1"}
+		if h := merged.histograms[commitDuration]; h == nil || h.count != 2 || h.sum != 4 {
+			t.Errorf("unexpected commit duration histogram in %s: %+v", file, h)
+		}
+	}
+
+	invalid := filepath.Join(dir, "invalid.txt")
+	if err := os.WriteFile(invalid, []byte("unknown_metric_total{} 1\n"), 0o600); err != nil {
+		t.Fatalf("writing file: %s", err)
+	}
+	if err := newMetricsCollector(0o644).writeFile(invalid); err == nil {
+		t.Errorf("expected error with unknown metric in existing file")
+	}
+}
@@ -1,10 +1,13 @@
 package junos
 
 import (
+	"context"
+	"encoding/base64"
 	"encoding/xml"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
 
@@ -18,17 +21,47 @@ const (
 	rpcConfigStringSet = "<load-configuration action=\"set\" format=\"text\">" +
 		"<configuration-set>%s</configuration-set></load-configuration>"
 	rpcSystemInfo      = "<get-system-information/>"
+	rpcSoftwareInfo    = "<get-software-information/>"
 	rpcCommit          = "<commit-configuration><log>%s</log></commit-configuration>"
 	rpcCandidateLock   = "<lock><target><candidate/></target></lock>"
 	rpcCandidateUnlock = "<unlock><target><candidate/></target></unlock>"
 	rpcClearCandidate  = "<delete-config><target><candidate/></target></delete-config>"
+	rpcCompareActive   = "<get-configuration compare=\"rollback\" rollback=\"0\" format=\"text\"/>"
+	rpcCommitInfo      = "<get-commit-information/>"
 	rpcClose           = "<close-session/>"
-
-	RPCGetInterfaceInformationInterfaceName = "<get-interface-information><interface-name>%s</interface-name></get-interface-information>" //nolint:lll
+	rpcFilePut         = "<file-put><filename>%s</filename><permission>%s</permission>" +
+		"<encoding>base64</encoding><delete-if-exist/><file-contents>%s</file-contents></file-put>"
+	rpcFileDelete = "<file-delete><path>%s</path></file-delete>"
+
+	RPCGetAlarmInformation                  = `<get-alarm-information/>`
+	RPCGetArpTableInformation               = `<get-arp-table-information><no-resolve/><expiration-time/>%s</get-arp-table-information>` //nolint:lll
+	RPCGetBgpNeighborInformation            = `<get-bgp-neighbor-information>%s</get-bgp-neighbor-information>`
+	RPCGetChassisInventory                  = `<get-chassis-inventory/>`
+	RPCGetDestinationNatRuleSetsInformation = `<retrieve-destination-nat-rule-sets><all/></retrieve-destination-nat-rule-sets>` //nolint:lll
+	RPCGetEnvironmentInformation            = `<get-environment-information/>`
+	RPCGetIkeSecurityAssociationsDetail     = `<get-ike-security-associations-information><detail/></get-ike-security-associations-information>`       //nolint:lll
+	RPCGetInterfaceInformationExtensive     = `<get-interface-information><extensive/><interface-name>%s</interface-name></get-interface-information>` //nolint:lll
+	RPCGetInterfaceInformationInterfaceName = "<get-interface-information><interface-name>%s</interface-name></get-interface-information>"             //nolint:lll
 	RPCGetInterfacesInformationTerse        = `<get-interface-information><terse/></get-interface-information>`
 	RPCGetInterfaceInformationTerse         = `<get-interface-information>%s<terse/></get-interface-information>`
-	RPCGetRouteAllInformation               = `<get-route-information><all/></get-route-information>`
-	RPCGetRouteAllTableInformation          = `<get-route-information><all/><table>%s</table></get-route-information>`
+	RPCGetInterfaceOpticsDiagnostics        = `<get-interface-optics-diagnostics-information><interface-name>%s</interface-name></get-interface-optics-diagnostics-information>` //nolint:lll
+	RPCGetIPv6NdInformation                 = `<get-ipv6-nd-information>%s</get-ipv6-nd-information>`
+	RPCGetIsisAdjacencyInformation          = `<get-isis-adjacency-information>%s</get-isis-adjacency-information>`
+	RPCGetLldpInterfaceNeighbors            = `<get-lldp-interface-neighbors-information><interface-name>%s</interface-name></get-lldp-interface-neighbors-information>` //nolint:lll
+	RPCGetLldpNeighbors                     = `<get-lldp-neighbors-information/>`
+	RPCGetMatchFirewallPolicies             = `<match-firewall-policies>%s</match-firewall-policies>`
+	RPCGetOspfInterfaceInformation          = `<get-ospf-interface-information>%s</get-ospf-interface-information>`
+	RPCGetOspfNeighborInformation           = `<get-ospf-neighbor-information>%s</get-ospf-neighbor-information>`
+	RPCGetOspf3InterfaceInformation         = `<get-ospf3-interface-information>%s</get-ospf3-interface-information>`
+	RPCGetOspf3NeighborInformation          = `<get-ospf3-neighbor-information>%s</get-ospf3-neighbor-information>`
+	RPCGetRouteInformation                  = `<get-route-information>%s</get-route-information>`
+	RPCGetPKICACertificateInformation       = `<get-pki-ca-certificate><detail/></get-pki-ca-certificate>`
+	RPCGetPKILocalCertificateInformation    = `<get-pki-local-certificate><detail/></get-pki-local-certificate>`
+	RPCGetPKILocalCertificateIDInformation  = `<get-pki-local-certificate><certificate-id>%s</certificate-id><detail/></get-pki-local-certificate>` //nolint:lll
+	RPCGetSecurityAssociationsDetail        = `<get-security-associations-information><detail/></get-security-associations-information>`            //nolint:lll
+	RPCGetSecurityPoliciesHitCount          = `<get-security-policies-hit-count>%s</get-security-policies-hit-count>`
+	RPCGetSourceNatRuleSetsInformation      = `<retrieve-source-nat-rule-sets><all/></retrieve-source-nat-rule-sets>`
+	RPCGetStaticNatRuleSetsInformation      = `<retrieve-static-nat-rule-sets><all/></retrieve-static-nat-rule-sets>`
 
 	XMLStartTagConfigOut = "<configuration-output>"
 	XMLEndTagConfigOut   = "</configuration-output>"
@@ -45,6 +78,33 @@ type sysInfo struct {
 	SerialNumber  string `xml:"serial-number"`
 	HostName      string `xml:"host-name"`
 	ClusterNode   *bool  `xml:"cluster-node"`
+
+	// Information below are only read with GatherSoftwareFacts (get-software-information)
+	REName             string            `xml:"-"`
+	MultiRoutingEngine bool              `xml:"-"`
+	MultiNode          bool              `xml:"-"`
+	Software           []sysInfoSoftware `xml:"-"`
+}
+
+type softwareInfoReply struct {
+	SoftwareInformation       sysInfoSoftware `xml:"software-information"`
+	MultiRoutingEngineResults struct {
+		Item []struct {
+			REName              string          `xml:"re-name"`
+			SoftwareInformation sysInfoSoftware `xml:"software-information"`
+		} `xml:"multi-routing-engine-item"`
+	} `xml:"multi-routing-engine-results"`
+}
+
+type sysInfoSoftware struct {
+	REName       string `xml:"-"`
+	HostName     string `xml:"host-name"`
+	ProductModel string `xml:"product-model"`
+	JunosVersion string `xml:"junos-version"`
+	Packages     []struct {
+		Name    string `xml:"name"`
+		Comment string `xml:"comment"`
+	} `xml:"package-information"`
 }
 
 type commandXMLConfig struct {
@@ -52,8 +112,325 @@ type commandXMLConfig struct {
 }
 
 type commitResults struct {
-	XMLName xml.Name           `xml:"commit-results"`
-	Errors  []netconf.RPCError `xml:"rpc-error"`
+	XMLName        xml.Name           `xml:"commit-results"`
+	Errors         []netconf.RPCError `xml:"rpc-error"`
+	RoutingEngines []struct {
+		Name           string `xml:"name"`
+		CommitRevision string `xml:"commit-revision"`
+	} `xml:"routing-engine"`
+}
+
+// revision returns the first commit revision found in routing engines results.
+func (results commitResults) revision() string {
+	for _, re := range results.RoutingEngines {
+		if v := strings.TrimSpace(re.CommitRevision); v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+type compareConfigurationReply struct {
+	XMLName xml.Name `xml:"configuration-information"`
+	Output  string   `xml:"configuration-output"`
+}
+
+type commitInformationReply struct {
+	XMLName xml.Name        `xml:"commit-information"`
+	History []commitHistory `xml:"commit-history"`
+}
+
+type commitHistory struct {
+	SequenceNumber string `xml:"sequence-number"`
+	User           string `xml:"user"`
+	Client         string `xml:"client"`
+	DateTime       string `xml:"date-time"`
+	Log            string `xml:"log"`
+}
+
+func (commit *commitHistory) trim() {
+	commit.SequenceNumber = strings.TrimSpace(commit.SequenceNumber)
+	commit.User = strings.TrimSpace(commit.User)
+	commit.Client = strings.TrimSpace(commit.Client)
+	commit.DateTime = strings.TrimSpace(commit.DateTime)
+	commit.Log = strings.TrimSpace(commit.Log)
+}
+
+func (commit commitHistory) String() string {
+	return fmt.Sprintf("%s by %s via %s with log %q", commit.DateTime, commit.User, commit.Client, commit.Log)
+}
+
+type GetBgpNeighborInformationReply struct {
+	BgpInfo struct {
+		Peer []struct {
+			PeerAddress    string `xml:"peer-address"`
+			PeerAS         string `xml:"peer-as"`
+			LocalAddress   string `xml:"local-address"`
+			LocalAS        string `xml:"local-as"`
+			PeerGroup      string `xml:"peer-group"`
+			PeerCfgRti     string `xml:"peer-cfg-rti"`
+			PeerType       string `xml:"peer-type"`
+			PeerState      string `xml:"peer-state"`
+			LastState      string `xml:"last-state"`
+			LastEvent      string `xml:"last-event"`
+			LastError      string `xml:"last-error"`
+			PeerID         string `xml:"peer-id"`
+			FlapCount      int    `xml:"flap-count"`
+			ActiveHoldtime int    `xml:"active-holdtime"`
+			Rib            []struct {
+				Name                  string `xml:"name"`
+				ActivePrefixCount     int    `xml:"active-prefix-count"`
+				ReceivedPrefixCount   int    `xml:"received-prefix-count"`
+				AcceptedPrefixCount   int    `xml:"accepted-prefix-count"`
+				SuppressedPrefixCount int    `xml:"suppressed-prefix-count"`
+				AdvertisedPrefixCount int    `xml:"advertised-prefix-count"`
+			} `xml:"bgp-rib"`
+		} `xml:"bgp-peer"`
+	} `xml:"bgp-information"`
+}
+
+type GetAlarmInformationReply struct {
+	AlarmInformation          AlarmInformation `xml:"alarm-information"`
+	MultiRoutingEngineResults struct {
+		Item []struct {
+			REName           string           `xml:"re-name"`
+			AlarmInformation AlarmInformation `xml:"alarm-information"`
+		} `xml:"multi-routing-engine-item"`
+	} `xml:"multi-routing-engine-results"`
+}
+
+type AlarmInformation struct {
+	AlarmDetail []struct {
+		AlarmTime             string `xml:"alarm-time"`
+		AlarmClass            string `xml:"alarm-class"`
+		AlarmType             string `xml:"alarm-type"`
+		AlarmDescription      string `xml:"alarm-description"`
+		AlarmShortDescription string `xml:"alarm-short-description"`
+	} `xml:"alarm-detail"`
+}
+
+type GetChassisInventoryReply struct {
+	ChassisInventory          ChassisInventory `xml:"chassis-inventory"`
+	MultiRoutingEngineResults struct {
+		Item []struct {
+			REName           string           `xml:"re-name"`
+			ChassisInventory ChassisInventory `xml:"chassis-inventory"`
+		} `xml:"multi-routing-engine-item"`
+	} `xml:"multi-routing-engine-results"`
+}
+
+type ChassisInventory struct {
+	Chassis []struct {
+		Name         string                   `xml:"name"`
+		SerialNumber string                   `xml:"serial-number"`
+		Description  string                   `xml:"description"`
+		Module       []ChassisInventoryModule `xml:"chassis-module"`
+	} `xml:"chassis"`
+}
+
+type ChassisInventoryModule struct {
+	Name         string                   `xml:"name"`
+	Version      string                   `xml:"version"`
+	PartNumber   string                   `xml:"part-number"`
+	SerialNumber string                   `xml:"serial-number"`
+	Description  string                   `xml:"description"`
+	ModelNumber  string                   `xml:"model-number"`
+	CleiCode     string                   `xml:"clei-code"`
+	SubModule    []ChassisInventoryModule `xml:"chassis-sub-module"`
+	SubSubModule []ChassisInventoryModule `xml:"chassis-sub-sub-module"`
+}
+
+type GetEnvironmentInformationReply struct {
+	EnvironmentInformation    EnvironmentInformation `xml:"environment-information"`
+	MultiRoutingEngineResults struct {
+		Item []struct {
+			REName                 string                 `xml:"re-name"`
+			EnvironmentInformation EnvironmentInformation `xml:"environment-information"`
+		} `xml:"multi-routing-engine-item"`
+	} `xml:"multi-routing-engine-results"`
+}
+
+type EnvironmentInformation struct {
+	EnvironmentItem []struct {
+		Name        string `xml:"name"`
+		Class       string `xml:"class"`
+		Status      string `xml:"status"`
+		Temperature struct {
+			Text    string `xml:",chardata"`
+			Celsius string `xml:"celsius,attr"`
+		} `xml:"temperature"`
+		Comment string `xml:"comment"`
+	} `xml:"environment-item"`
+}
+
+type GetIkeSecurityAssociationsReply struct {
+	IkeSecurityAssociationsInformation struct {
+		IkeSecurityAssociations      []IkeSecurityAssociation `xml:"ike-security-associations"`
+		IkeSecurityAssociationsBlock []IkeSecurityAssociation `xml:"ike-security-associations-block"` // with detail
+	} `xml:"ike-security-associations-information"`
+}
+
+type IkeSecurityAssociation struct {
+	Index           string `xml:"ike-sa-index"`
+	GatewayName     string `xml:"ike-sa-gateway-name"`
+	RemoteAddress   string `xml:"ike-sa-remote-address"`
+	State           string `xml:"ike-sa-state"`
+	InitiatorCookie string `xml:"ike-sa-initiator-cookie"`
+	ResponderCookie string `xml:"ike-sa-responder-cookie"`
+	ExchangeType    string `xml:"ike-sa-exchange-type"`
+}
+
+type GetSecurityAssociationsReply struct {
+	IPsecSecurityAssociationsInformation struct {
+		IPsecSecurityAssociationsBlock []struct {
+			TunnelIndex               string `xml:"sa-tunnel-index"`
+			VpnName                   string `xml:"sa-vpn-name"`
+			RemoteGateway             string `xml:"sa-remote-gateway"`
+			BindInterface             string `xml:"sa-bind-interface"`
+			BlockState                string `xml:"sa-block-state"`
+			IPsecSecurityAssociations []struct {
+				Direction    string `xml:"sa-direction"`
+				Spi          string `xml:"sa-spi"`
+				Protocol     string `xml:"sa-protocol"`
+				HardLifetime string `xml:"sa-hard-lifetime"`
+				State        string `xml:"sa-state"`
+			} `xml:"ipsec-security-associations"`
+		} `xml:"ipsec-security-associations-block"`
+	} `xml:"ipsec-security-associations-information"`
+}
+
+type GetIsisAdjacencyInformationReply struct {
+	IsisAdjacencyInformation struct {
+		IsisAdjacency []struct {
+			InterfaceName  string `xml:"interface-name"`
+			SystemName     string `xml:"system-name"`
+			Level          string `xml:"level"`
+			AdjacencyState string `xml:"adjacency-state"`
+			Holdtime       string `xml:"holdtime"`
+			Snpa           string `xml:"snpa"`
+			IPAddress      string `xml:"ip-address"`
+			IPv6Address    string `xml:"ipv6-address"`
+		} `xml:"isis-adjacency"`
+	} `xml:"isis-adjacency-information"`
+}
+
+type GetLldpNeighborsReply struct {
+	LldpNeighborsInformation struct {
+		LldpNeighborInformation []struct {
+			LocalPortID             string `xml:"lldp-local-port-id"`
+			LocalInterface          string `xml:"lldp-local-interface"`
+			LocalParentInterface    string `xml:"lldp-local-parent-interface-name"`
+			RemoteChassisIDSubtype  string `xml:"lldp-remote-chassis-id-subtype"`
+			RemoteChassisID         string `xml:"lldp-remote-chassis-id"`
+			RemotePortIDSubtype     string `xml:"lldp-remote-port-id-subtype"`
+			RemotePortID            string `xml:"lldp-remote-port-id"`
+			RemotePortDescription   string `xml:"lldp-remote-port-description"`
+			RemoteSystemName        string `xml:"lldp-remote-system-name"`
+			RemoteManagementAddress string `xml:"lldp-remote-management-address"`
+		} `xml:"lldp-neighbor-information"`
+	} `xml:"lldp-neighbors-information"`
+}
+
+type GetMatchFirewallPoliciesReply struct {
+	SecurityPolicies struct {
+		SecurityContext []struct {
+			ContextInformation struct {
+				SourceZoneName      string `xml:"source-zone-name"`
+				DestinationZoneName string `xml:"destination-zone-name"`
+			} `xml:"context-information"`
+			Policies []struct {
+				PolicyInformation []struct {
+					PolicyName           string `xml:"policy-name"`
+					PolicyState          string `xml:"policy-state"`
+					PolicyIdentifier     string `xml:"policy-identifier"`
+					PolicySequenceNumber int    `xml:"policy-sequence-number"`
+					PolicyAction         struct {
+						ActionType string `xml:"action-type"`
+					} `xml:"policy-action"`
+				} `xml:"policy-information"`
+			} `xml:"policies"`
+		} `xml:"security-context"`
+	} `xml:"security-policies"`
+}
+
+type GetSecurityPoliciesHitCountReply struct {
+	PolicyHitCount struct {
+		Entry []struct {
+			Index    int    `xml:"policy-hit-count-index"`
+			FromZone string `xml:"policy-hit-count-from-zone"`
+			ToZone   string `xml:"policy-hit-count-to-zone"`
+			Name     string `xml:"policy-hit-count-policy-name"`
+			Count    int64  `xml:"policy-hit-count-count"`
+		} `xml:"policy-hit-count-entry"`
+	} `xml:"policy-hit-count"`
+}
+
+type GetSourceNatRuleSetsReply struct {
+	RuleDetail struct {
+		Entry []NatRuleEntry `xml:"source-nat-rule-entry"`
+	} `xml:"source-nat-rule-detail-information"`
+}
+
+type GetDestinationNatRuleSetsReply struct {
+	RuleDetail struct {
+		Entry []NatRuleEntry `xml:"destination-nat-rule-entry"`
+	} `xml:"destination-nat-rule-detail-information"`
+}
+
+type GetStaticNatRuleSetsReply struct {
+	RuleDetail struct {
+		Entry []NatRuleEntry `xml:"static-nat-rule-entry"`
+	} `xml:"static-nat-rule-detail-information"`
+}
+
+type NatRuleEntry struct {
+	RuleName            string `xml:"rule-name"`
+	RuleSetName         string `xml:"rule-set-name"`
+	RuleID              string `xml:"rule-id"`
+	RuleTranslationHits int64  `xml:"rule-translation-hits"`
+	SuccHits            int64  `xml:"succ-hits"`
+	FailedHits          int64  `xml:"failed-hits"`
+}
+
+type GetOspfNeighborInformationReply struct {
+	OspfNeighborInformation struct {
+		OspfNeighbor []OspfNeighbor `xml:"ospf-neighbor"`
+	} `xml:"ospf-neighbor-information"`
+	Ospf3NeighborInformation struct {
+		Ospf3Neighbor []OspfNeighbor `xml:"ospf3-neighbor"`
+	} `xml:"ospf3-neighbor-information"`
+}
+
+type OspfNeighbor struct {
+	NeighborAddress   string `xml:"neighbor-address"`
+	InterfaceName     string `xml:"interface-name"`
+	OspfNeighborState string `xml:"ospf-neighbor-state"`
+	NeighborID        string `xml:"neighbor-id"`
+	NeighborPriority  string `xml:"neighbor-priority"`
+	OspfArea          string `xml:"ospf-area"`
+	DrAddress         string `xml:"dr-address"`
+	BdrAddress        string `xml:"bdr-address"`
+	DrID              string `xml:"dr-id"`
+	BdrID             string `xml:"bdr-id"`
+}
+
+type GetOspfInterfaceInformationReply struct {
+	OspfInterfaceInformation struct {
+		OspfInterface []OspfInterface `xml:"ospf-interface"`
+	} `xml:"ospf-interface-information"`
+	Ospf3InterfaceInformation struct {
+		Ospf3Interface []OspfInterface `xml:"ospf3-interface"`
+	} `xml:"ospf3-interface-information"`
+}
+
+type OspfInterface struct {
+	InterfaceName      string `xml:"interface-name"`
+	OspfInterfaceState string `xml:"ospf-interface-state"`
+	OspfArea           string `xml:"ospf-area"`
+	DrID               string `xml:"dr-id"`
+	BdrID              string `xml:"bdr-id"`
+	NeighborCount      string `xml:"neighbor-count"`
 }
 
 type GetPhysicalInterfaceTerseReply struct {
@@ -66,6 +443,79 @@ type GetPhysicalInterfaceTerseReply struct {
 	} `xml:"interface-information"`
 }
 
+type GetPhysicalInterfaceExtensiveReply struct {
+	InterfaceInfo struct {
+		PhysicalInterface []struct {
+			Name                    string `xml:"name"`
+			AdminStatus             string `xml:"admin-status"`
+			OperStatus              string `xml:"oper-status"`
+			Description             string `xml:"description"`
+			Mtu                     string `xml:"mtu"`
+			Speed                   string `xml:"speed"`
+			Duplex                  string `xml:"duplex"`
+			LinkMode                string `xml:"link-mode"`
+			CurrentPhysicalAddress  string `xml:"current-physical-address"`
+			HardwarePhysicalAddress string `xml:"hardware-physical-address"`
+			InterfaceFlapped        struct {
+				Text    string `xml:",chardata"`
+				Seconds string `xml:"seconds,attr"`
+			} `xml:"interface-flapped"`
+			TrafficStatistics struct {
+				InputBytes    string `xml:"input-bytes"`
+				InputBps      string `xml:"input-bps"`
+				InputPackets  string `xml:"input-packets"`
+				InputPps      string `xml:"input-pps"`
+				OutputBytes   string `xml:"output-bytes"`
+				OutputBps     string `xml:"output-bps"`
+				OutputPackets string `xml:"output-packets"`
+				OutputPps     string `xml:"output-pps"`
+			} `xml:"traffic-statistics"`
+			InputErrorList struct {
+				InputErrors         string `xml:"input-errors"`
+				InputDrops          string `xml:"input-drops"`
+				FramingErrors       string `xml:"framing-errors"`
+				InputRunts          string `xml:"input-runts"`
+				InputDiscards       string `xml:"input-discards"`
+				InputFifoErrors     string `xml:"input-fifo-errors"`
+				InputResourceErrors string `xml:"input-resource-errors"`
+			} `xml:"input-error-list"`
+			OutputErrorList struct {
+				CarrierTransitions   string `xml:"carrier-transitions"`
+				OutputErrors         string `xml:"output-errors"`
+				OutputDrops          string `xml:"output-drops"`
+				OutputCollisions     string `xml:"output-collisions"`
+				MtuErrors            string `xml:"mtu-errors"`
+				OutputFifoErrors     string `xml:"output-fifo-errors"`
+				OutputResourceErrors string `xml:"output-resource-errors"`
+			} `xml:"output-error-list"`
+		} `xml:"physical-interface"`
+	} `xml:"interface-information"`
+}
+
+type GetInterfaceOpticsDiagnosticsReply struct {
+	InterfaceInfo struct {
+		PhysicalInterface []struct {
+			Name              string `xml:"name"`
+			OpticsDiagnostics *struct {
+				ModuleTemperature struct {
+					Text    string `xml:",chardata"`
+					Celsius string `xml:"celsius,attr"`
+				} `xml:"module-temperature"`
+				ModuleVoltage               string `xml:"module-voltage"`
+				LaserBiasCurrent            string `xml:"laser-bias-current"`
+				LaserOutputPowerDbm         string `xml:"laser-output-power-dbm"`
+				RxSignalAvgOpticalPowerDbm  string `xml:"rx-signal-avg-optical-power-dbm"`
+				OpticsDiagnosticsLaneValues []struct {
+					LaneIndex              string `xml:"lane-index"`
+					LaserBiasCurrent       string `xml:"laser-bias-current"`
+					LaserOutputPowerDbm    string `xml:"laser-output-power-dbm"`
+					LaserRxOpticalPowerDbm string `xml:"laser-rx-optical-power-dbm"`
+				} `xml:"optics-diagnostics-lane-values"`
+			} `xml:"optics-diagnostics"`
+		} `xml:"physical-interface"`
+	} `xml:"interface-information"`
+}
+
 type GetLogicalInterfaceTerseReply struct {
 	InterfaceInfo struct {
 		LogicalInterface []struct {
@@ -82,6 +532,29 @@ type GetLogicalInterfaceTerseReply struct {
 	} `xml:"interface-information"`
 }
 
+type GetArpTableInformationReply struct {
+	ArpTableInfo struct {
+		ArpTableEntry []struct {
+			MacAddress    string `xml:"mac-address"`
+			IPAddress     string `xml:"ip-address"`
+			InterfaceName string `xml:"interface-name"`
+			TimeToExpire  string `xml:"time-to-expire"`
+		} `xml:"arp-table-entry"`
+	} `xml:"arp-table-information"`
+}
+
+type GetIPv6NdInformationReply struct {
+	IPv6NdInfo struct {
+		IPv6NdEntry []struct {
+			NeighborAddress   string `xml:"ipv6-nd-neighbor-address"`
+			NeighborL2Address string `xml:"ipv6-nd-neighbor-l2-address"`
+			State             string `xml:"ipv6-nd-state"`
+			Expire            string `xml:"ipv6-nd-expire"`
+			InterfaceName     string `xml:"ipv6-nd-interface-name"`
+		} `xml:"ipv6-nd-entry"`
+	} `xml:"ipv6-nd-information"`
+}
+
 type GetRouteInformationReply struct {
 	RouteInfo struct {
 		RouteTable []struct {
@@ -89,29 +562,63 @@ type GetRouteInformationReply struct {
 			Route     []struct {
 				Destination string `xml:"rt-destination"`
 				Entry       []struct {
-					ASPath          string    `xml:"as-path"`
+					ASPath string `xml:"as-path"`
+					Age    struct {
+						Text    string `xml:",chardata"`
+						Seconds string `xml:"seconds,attr"`
+					} `xml:"age"`
+					Communities     []string  `xml:"communities>community"`
 					CurrentActive   *struct{} `xml:"current-active"`
 					LocalPreference int       `xml:"local-preference"`
 					Metric          int       `xml:"metric"`
 					NextHop         []struct {
 						SelectedNextHop *struct{} `xml:"selected-next-hop"`
 						LocalInterface  string    `xml:"nh-local-interface"`
+						MplsLabel       string    `xml:"mpls-label"`
 						To              string    `xml:"to"`
 						Via             string    `xml:"via"`
 					} `xml:"nh"`
-					NextHopType string `xml:"nh-type"`
-					Preference  int    `xml:"preference"`
-					Protocol    string `xml:"protocol-name"`
+					NextHopType     string `xml:"nh-type"`
+					Preference      int    `xml:"preference"`
+					Protocol        string `xml:"protocol-name"`
+					ValidationState string `xml:"validation-state"`
 				} `xml:"rt-entry"`
 			} `xml:"rt"`
 		} `xml:"route-table"`
 	} `xml:"route-information"`
 }
 
+type GetPKICACertificateReply struct {
+	PKICACertificateInfo struct {
+		Certificate []PKICertificateDetail `xml:"pki-ca-certificate"`
+	} `xml:"pki-ca-certificate-information"`
+}
+
+type GetPKILocalCertificateReply struct {
+	PKILocalCertificateInfo struct {
+		Certificate []PKICertificateDetail `xml:"pki-local-certificate"`
+	} `xml:"pki-local-certificate-information"`
+}
+
+type PKICertificateDetail struct {
+	Identifier   string `xml:"certificate-identifier"`
+	SerialNumber string `xml:"serial-number"`
+	Issuer       struct {
+		CommonName   string `xml:"common-name"`
+		Organization string `xml:"organization"`
+	} `xml:"issuer"`
+	Subject  string `xml:"subject-string"`
+	Validity struct {
+		NotBefore string `xml:"not-before"`
+		NotAfter  string `xml:"not-after"`
+	} `xml:"validity"`
+	PublicKeyAlgorithm string `xml:"public-key-algorithm"`
+}
+
 // gatherFacts gathers basic information about the device.
 func (sess *Session) gatherFacts() error {
 	// Get info for get-system-information and populate SystemInformation Struct
-	val, err := sess.netconf.Exec(netconf.RawMethod(rpcSystemInfo))
+	val, err := sess.netconfExec(sess.ctx, rpcSystemInfo)
 	if err != nil {
 		return fmt.Errorf("executing netconf get-system-information: %w", err)
 	}
@@ -133,10 +640,73 @@ func (sess *Session) gatherFacts() error {
 	return nil
 }
 
+// GatherSoftwareFacts gathers routing engines and versions information about the device
+// in SystemInformation.
+// Not gathered when opening the session as only a few data sources need them.
+func (sess *Session) GatherSoftwareFacts() error {
+	val, err := sess.netconfExec(sess.ctx, rpcSoftwareInfo)
+	if err != nil {
+		return fmt.Errorf("executing netconf get-software-information: %w", err)
+	}
+
+	if val.Errors != nil {
+		var errorsMsg []string
+		for _, m := range val.Errors {
+			errorsMsg = append(errorsMsg, fmt.Sprintf("%v", m))
+		}
+
+		return fmt.Errorf(strings.Join(errorsMsg, "\n"))
+	}
+	var reply softwareInfoReply
+	if err := xml.Unmarshal([]byte(val.RawReply), &reply); err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q of get-software-information: %w", val.RawReply, err)
+	}
+	sess.SystemInformation.fillSoftware(reply)
+
+	return nil
+}
+
+func (info *sysInfo) fillSoftware(reply softwareInfoReply) {
+	info.REName = ""
+	info.MultiRoutingEngine = false
+	info.MultiNode = false
+	info.Software = make([]sysInfoSoftware, 0)
+	if len(reply.MultiRoutingEngineResults.Item) == 0 {
+		software := reply.SoftwareInformation
+		software.trim()
+		info.Software = append(info.Software, software)
+
+		return
+	}
+	info.MultiRoutingEngine = true
+	for _, item := range reply.MultiRoutingEngineResults.Item {
+		software := item.SoftwareInformation
+		software.REName = strings.TrimSpace(item.REName)
+		software.trim()
+		if strings.HasPrefix(software.REName, "node") {
+			info.MultiNode = true
+		}
+		if info.REName == "" && software.HostName != "" && software.HostName == info.HostName {
+			info.REName = software.REName
+		}
+		info.Software = append(info.Software, software)
+	}
+}
+
+func (software *sysInfoSoftware) trim() {
+	software.HostName = strings.TrimSpace(software.HostName)
+	software.ProductModel = strings.TrimSpace(software.ProductModel)
+	software.JunosVersion = strings.TrimSpace(software.JunosVersion)
+	for i := range software.Packages {
+		software.Packages[i].Name = strings.TrimSpace(software.Packages[i].Name)
+		software.Packages[i].Comment = strings.TrimSpace(software.Packages[i].Comment)
+	}
+}
+
 // netconfCommand (show, execute) on Junos device.
 func (sess *Session) netconfCommand(cmd string) (string, error) {
 	command := fmt.Sprintf(rpcCommand, cmd)
-	reply, err := sess.netconf.Exec(netconf.RawMethod(command))
+	reply, err := sess.netconfExec(sess.ctx, command)
 	if err != nil {
 		return "", fmt.Errorf("executing netconf command: %w", err)
 	}
@@ -157,7 +727,7 @@ func (sess *Session) netconfCommand(cmd string) (string, error) {
 }
 
 func (sess *Session) netconfCommandXML(cmd string) (string, error) {
-	reply, err := sess.netconf.Exec(netconf.RawMethod(cmd))
+	reply, err := sess.netconfExec(sess.ctx, cmd)
 	if err != nil {
 		return "", fmt.Errorf("executing netconf xml command: %w", err)
 	}
@@ -172,7 +742,7 @@ func (sess *Session) netconfCommandXML(cmd string) (string, error) {
 
 func (sess *Session) netconfConfigSet(cmd []string) (string, error) {
 	command := fmt.Sprintf(rpcConfigStringSet, strings.Join(cmd, "\n"))
-	reply, err := sess.netconf.Exec(netconf.RawMethod(command))
+	reply, err := sess.netconfExec(sess.ctx, command)
 	if err != nil {
 		return "", fmt.Errorf("executing netconf apply of set/delete command: %w", err)
 	}
@@ -189,21 +759,74 @@ func (sess *Session) netconfConfigSet(cmd []string) (string, error) {
 	return "", nil
 }
 
+// netconfFilePut creates a file on device with content encoded in base64.
+func (sess *Session) netconfFilePut(filename, permission string, content []byte) error {
+	reply, err := sess.netconfExec(sess.ctx, fmt.Sprintf(
+		rpcFilePut, filename, permission, base64.StdEncoding.EncodeToString(content),
+	))
+	if err != nil {
+		return fmt.Errorf("executing netconf file-put: %w", err)
+	}
+	if reply.Errors != nil {
+		for _, m := range reply.Errors {
+			return errors.New(m.Error())
+		}
+	}
+
+	return nil
+}
+
+// netconfFileDelete deletes a file on device.
+func (sess *Session) netconfFileDelete(filename string) error {
+	reply, err := sess.netconfExec(sess.ctx, fmt.Sprintf(rpcFileDelete, filename))
+	if err != nil {
+		return fmt.Errorf("executing netconf file-delete: %w", err)
+	}
+	if reply.Errors != nil {
+		for _, m := range reply.Errors {
+			return errors.New(m.Error())
+		}
+	}
+
+	return nil
+}
+
 // netConfConfigLock locks the candidate configuration.
-func (sess *Session) netconfConfigLock() bool {
-	reply, err := sess.netconf.Exec(netconf.RawMethod(rpcCandidateLock))
+// It returns false without error when the candidate configuration is locked (or modified)
+// by another session, so the lock can be retried.
+func (sess *Session) netconfConfigLock(ctx context.Context) (bool, error) {
+	reply, err := sess.netconfExec(ctx, rpcCandidateLock)
 	if err != nil {
-		return false
+		var rpcErr *netconf.RPCError
+		if errors.As(err, &rpcErr) && isConfigLockHeld(rpcErr) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("executing netconf config lock: %w", err)
 	}
 	if reply.Errors != nil {
-		return false
+		for i := range reply.Errors {
+			if !isConfigLockHeld(&reply.Errors[i]) {
+				return false, errors.New("config lock: " + reply.Errors[i].Message)
+			}
+		}
+
+		return false, nil
 	}
 
-	return true
+	return true, nil
+}
+
+// isConfigLockHeld returns true if the rpc error is due to a lock (or modifications)
+// of the candidate configuration by another session.
+func isConfigLockHeld(rpcErr *netconf.RPCError) bool {
+	return strings.TrimSpace(rpcErr.Tag) == "lock-denied" ||
+		strings.Contains(rpcErr.Message, "configuration database locked") ||
+		strings.Contains(rpcErr.Message, "configuration database modified")
 }
 
 func (sess *Session) netconfConfigClear() []error {
-	reply, err := sess.netconf.Exec(netconf.RawMethod(rpcClearCandidate))
+	reply, err := sess.netconfExec(sess.ctx, rpcClearCandidate)
 	if err != nil {
 		return []error{fmt.Errorf("executing netconf config clear: %w", err)}
 	}
@@ -221,7 +844,7 @@ func (sess *Session) netconfConfigClear() []error {
 
 // Unlock unlocks the candidate configuration.
 func (sess *Session) netconfConfigUnlock() []error {
-	reply, err := sess.netconf.Exec(netconf.RawMethod(rpcCandidateUnlock))
+	reply, err := sess.netconfExec(sess.ctx, rpcCandidateUnlock)
 	if err != nil {
 		return []error{fmt.Errorf("executing netconf config unlock: %w", err)}
 	}
@@ -237,52 +860,152 @@ func (sess *Session) netconfConfigUnlock() []error {
 	return []error{}
 }
 
-// netconfCommit commits the configuration.
-func (sess *Session) netconfCommit(logMessage string) (_warn []error, _err error) {
-	reply, err := sess.netconf.Exec(netconf.RawMethod(fmt.Sprintf(rpcCommit, logMessage)))
+// netconfCommit commits the configuration and returns the commit revision from the reply
+// (empty if the device doesn't provide it).
+func (sess *Session) netconfCommit(
+	ctx context.Context, logMessage string,
+) (
+	_revision string, _warn []error, _err error,
+) {
+	reply, err := sess.netconfExec(ctx, fmt.Sprintf(rpcCommit, logMessage))
 	if err != nil {
-		return []error{}, fmt.Errorf("executing netconf commit: %w", err)
+		return "", []error{}, fmt.Errorf("executing netconf commit: %w", err)
+	}
+
+	var results commitResults
+	if strings.Contains(reply.Data, "<commit-results>") {
+		err = xml.Unmarshal([]byte(reply.Data), &results)
+		if err != nil {
+			return "", []error{}, fmt.Errorf("unmarshaling xml reply %q of commit-configuration: %w", reply.Data, err)
+		}
 	}
 
 	if reply.Errors != nil {
 		warnings := make([]error, 0)
 		for _, m := range reply.Errors {
 			if m.Severity == errorSeverity {
-				return warnings, errors.New(m.Error())
+				return "", warnings, errors.New(m.Error())
 			}
 			warnings = append(warnings, errors.New(m.Error()))
 		}
 
-		return warnings, nil
+		return results.revision(), warnings, nil
 	}
 
-	var errs commitResults
-	if strings.Contains(reply.Data, "<commit-results>") {
-		err = xml.Unmarshal([]byte(reply.Data), &errs)
-		if err != nil {
-			return []error{}, fmt.Errorf("unmarshaling xml reply %q of commit-configuration: %w", reply.Data, err)
+	if results.Errors != nil {
+		warnings := make([]error, 0)
+		for _, m := range results.Errors {
+			if m.Severity == errorSeverity {
+				return "", []error{}, errors.New(m.Error())
+			}
+			warnings = append(warnings, errors.New(m.Error()))
 		}
 
-		if errs.Errors != nil {
-			warnings := make([]error, 0)
-			for _, m := range errs.Errors {
-				if m.Severity == errorSeverity {
-					return []error{}, errors.New(m.Error())
-				}
-				warnings = append(warnings, errors.New(m.Error()))
-			}
+		return results.revision(), warnings, nil
+	}
 
-			return warnings, nil
+	return results.revision(), []error{}, nil
+}
+
+// netconfCompareActive returns the differences between candidate and active configuration.
+func (sess *Session) netconfCompareActive() (string, error) {
+	reply, err := sess.netconfExec(sess.ctx, rpcCompareActive)
+	if err != nil {
+		return "", fmt.Errorf("executing netconf compare configuration: %w", err)
+	}
+	if reply.Errors != nil {
+		for _, m := range reply.Errors {
+			return "", errors.New(m.Error())
 		}
 	}
+	var output compareConfigurationReply
+	if err := xml.Unmarshal([]byte(reply.Data), &output); err != nil {
+		return "", fmt.Errorf("unmarshaling xml reply %q of compare configuration: %w", reply.Data, err)
+	}
 
-	return []error{}, nil
+	return strings.TrimSpace(output.Output), nil
+}
+
+// netconfLastCommit returns the last commit in commit history.
+func (sess *Session) netconfLastCommit() (commitHistory, error) {
+	reply, err := sess.netconfExec(sess.ctx, rpcCommitInfo)
+	if err != nil {
+		return commitHistory{}, fmt.Errorf("executing netconf get-commit-information: %w", err)
+	}
+	if reply.Errors != nil {
+		for _, m := range reply.Errors {
+			return commitHistory{}, errors.New(m.Error())
+		}
+	}
+	var output commitInformationReply
+	if err := xml.Unmarshal([]byte(reply.Data), &output); err != nil {
+		return commitHistory{}, fmt.Errorf("unmarshaling xml reply %q of get-commit-information: %w", reply.Data, err)
+	}
+	if len(output.History) == 0 {
+		return commitHistory{}, errors.New("no commit in commit history")
+	}
+	last := output.History[0]
+	last.trim()
+
+	return last, nil
+}
+
+// netconfExec executes the rpc and waits for the reply or the end of ctx.
+// When ctx is done before the reply, the transport is closed to abort the exchange
+// and the session can't be used anymore.
+// When the transport is lost, the returned error wraps errTransportLost.
+// The latency and the errors are recorded in metrics by rpc type.
+func (sess *Session) netconfExec(ctx context.Context, rpc string) (*netconf.RPCReply, error) {
+	start := time.Now()
+	reply, err := sess.netconfExecContext(ctx, rpc)
+	sess.metrics.observe(metricRPCDuration, rpcType(rpc), time.Since(start))
+	if err != nil {
+		sess.metrics.inc(metricRPCErrors, rpcType(rpc))
+	}
+
+	return reply, err
+}
+
+func (sess *Session) netconfExecContext(ctx context.Context, rpc string) (*netconf.RPCReply, error) {
+	if sess.netconf == nil {
+		return nil, errors.New("netconf session closed")
+	}
+	if ctx == nil {
+		return sess.checkTransport(sess.netconf.Exec(netconf.RawMethod(rpc)))
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("netconf rpc aborted: %w", err)
+	}
+
+	type execResult struct {
+		reply *netconf.RPCReply
+		err   error
+	}
+	result := make(chan execResult, 1)
+	go func() {
+		reply, err := sess.netconf.Exec(netconf.RawMethod(rpc))
+		result <- execResult{reply: reply, err: err}
+	}()
+	select {
+	case res := <-result:
+		return sess.checkTransport(res.reply, res.err)
+	case <-ctx.Done():
+		_ = sess.netconf.Transport.Close()
+		<-result
+		sess.netconf = nil
+
+		return nil, fmt.Errorf("netconf rpc aborted (transport closed): %w", ctx.Err())
+	}
 }
 
 // Close disconnects our session to the device.
 func (sess *Session) closeNetconf(sleepClosed int) error {
+	if sess.netconf == nil {
+		return nil
+	}
 	_, err := sess.netconf.Exec(netconf.RawMethod(rpcClose))
 	sess.netconf.Transport.Close()
+	sess.netconf = nil
 	if err != nil {
 		utils.Sleep(sleepClosed)
 
@@ -0,0 +1,171 @@
+package junos
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jeremmfr/go-netconf/netconf"
+)
+
+// testTransport is a netconf transport which replies with reply
+// or blocks until closed when reply is empty.
+type testTransport struct {
+	reply     string
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newTestTransport(reply string) *testTransport {
+	return &testTransport{
+		reply:  reply,
+		closed: make(chan struct{}),
+	}
+}
+
+func (t *testTransport) Send([]byte) error {
+	select {
+	case <-t.closed:
+		return errors.New("transport closed")
+	default:
+		return nil
+	}
+}
+
+func (t *testTransport) Receive() ([]byte, error) {
+	if t.reply != "" {
+		return []byte(t.reply), nil
+	}
+	<-t.closed
+
+	return nil, errors.New("transport closed")
+}
+
+func (t *testTransport) Close() error {
+	t.closeOnce.Do(func() { close(t.closed) })
+
+	return nil
+}
+
+func (t *testTransport) ReceiveHello() (*netconf.HelloMessageReceive, error) {
+	return &netconf.HelloMessageReceive{}, nil
+}
+
+func (t *testTransport) SendHello(*netconf.HelloMessageSend) error {
+	return nil
+}
+
+func TestSessionNetconfExec(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reply", func(t *testing.T) {
+		t.Parallel()
+
+		sess := &Session{
+			ctx: context.Background(),
+			netconf: &netconf.Session{
+				Transport: newTestTransport("<rpc-reply><ok/></rpc-reply>"),
+			},
+		}
+		if _, err := sess.netconfExec(sess.ctx, rpcCandidateLock); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !sess.HasNetconf() {
+			t.Errorf("session closed after successful rpc")
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		transport := newTestTransport("")
+		sess := &Session{
+			ctx: ctx,
+			netconf: &netconf.Session{
+				Transport: transport,
+			},
+		}
+		_, err := sess.netconfExec(sess.ctx, rpcCandidateLock)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected deadline exceeded error, got %v", err)
+		}
+		select {
+		case <-transport.closed:
+		default:
+			t.Errorf("transport not closed after timeout")
+		}
+		if sess.HasNetconf() {
+			t.Errorf("session not closed after timeout")
+		}
+		if _, err := sess.netconfExec(context.Background(), rpcCandidateUnlock); err == nil {
+			t.Errorf("expected error with closed session, got nil")
+		}
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		transport := newTestTransport("<rpc-reply><ok/></rpc-reply>")
+		sess := &Session{
+			ctx: ctx,
+			netconf: &netconf.Session{
+				Transport: transport,
+			},
+		}
+		if _, err := sess.netconfExec(sess.ctx, rpcCandidateLock); !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected canceled error, got %v", err)
+		}
+		if !sess.HasNetconf() {
+			t.Errorf("session closed without rpc sent")
+		}
+	})
+}
+
+func TestSessionGatherFacts(t *testing.T) {
+	t.Parallel()
+
+	transport := &testScriptTransport{replies: []string{
+		"<rpc-reply><system-information><hardware-model>mx240</hardware-model>" +
+			"<host-name>router1</host-name></system-information></rpc-reply>",
+		"<rpc-reply><multi-routing-engine-results>" +
+			"<multi-routing-engine-item><re-name>re0</re-name><software-information>" +
+			"<host-name>router1</host-name><junos-version>22.4R1</junos-version>" +
+			"</software-information></multi-routing-engine-item>" +
+			"<multi-routing-engine-item><re-name>re1</re-name><software-information>" +
+			"<host-name>router1-re1</host-name><junos-version>22.4R1</junos-version>" +
+			"</software-information></multi-routing-engine-item>" +
+			"</multi-routing-engine-results></rpc-reply>",
+	}}
+	sess := &Session{
+		ctx: context.Background(),
+		netconf: &netconf.Session{
+			Transport: transport,
+		},
+	}
+	if err := sess.gatherFacts(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(transport.sent) != 1 {
+		t.Errorf("expected only one rpc when opening session, got %d", len(transport.sent))
+	}
+	if sess.SystemInformation.HardwareModel != "mx240" || sess.SystemInformation.Software != nil {
+		t.Errorf("unexpected system information: %+v", sess.SystemInformation)
+	}
+	if err := sess.GatherSoftwareFacts(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !sess.SystemInformation.MultiRoutingEngine ||
+		sess.SystemInformation.MultiNode ||
+		sess.SystemInformation.REName != "re0" ||
+		len(sess.SystemInformation.Software) != 2 ||
+		sess.SystemInformation.Software[1].REName != "re1" ||
+		sess.SystemInformation.Software[1].JunosVersion != "22.4R1" {
+		t.Errorf("unexpected software information: %+v", sess.SystemInformation)
+	}
+}
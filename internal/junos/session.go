@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"net"
 	"os"
 	"time"
 
@@ -21,31 +20,55 @@ type Session struct {
 	netconf           *netconf.Session
 	localAddress      string
 	remoteAddress     string
+	ctx               context.Context //nolint:containedctx
 	logFile           func(string)
 	fakeSetFile       func([]string) error
 	sleepShort        int
 	sleepLock         int
 	sleepSSHClosed    int
+	commitTimeout     time.Duration
+	reconnect         func(context.Context) (*Session, error)
+	metrics           *deviceMetrics
+	journal           func(journalEntry) error
+	resourceID        string
+	journalLines      []string
+	journalLoadErr    error
+	configLocked      bool
+	pendingSetLines   []string
 }
 
 type sshAuthMethod struct {
-	Password       string
-	Username       string
-	PrivateKeyPEM  string
-	PrivateKeyFile string
-	Passphrase     string
-	Ciphers        []string
-	Timeout        int
+	Password        string
+	Username        string
+	PrivateKeyPEM   string
+	PrivateKeyFile  string
+	Passphrase      string
+	CertificatePEM  string
+	CertificateFile string
+	HostKey         string
+	Methods         []string
+	Ciphers         []string
+	Timeout         int
 }
 
 type openSSHOptions struct {
-	Retry   int
-	Timeout int
+	Retry         int
+	Timeout       int
+	Proxy         string
+	JumpHostsAuth []sshJumpHostAuth
+	Throttle      *connectThrottle
+	Metrics       *deviceMetrics
+}
+
+type sshJumpHostAuth struct {
+	Address string
+	Auth    *sshAuthMethod
 }
 
 type sshOptions struct {
 	*openSSHOptions
 	ClientConfig *ssh.ClientConfig
+	JumpHosts    []sshJumpHostConfig
 }
 
 // netconfNewSession establishes a new connection to a Junos device that we will use
@@ -64,12 +87,26 @@ func netconfNewSession(
 	if err != nil {
 		return nil, err
 	}
+	jumpHosts := make([]sshJumpHostConfig, len(openSSH.JumpHostsAuth))
+	for i, jumpHost := range openSSH.JumpHostsAuth {
+		jumpHostConfig, err := genSSHClientConfig(jumpHost.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("jump host %s: %w", jumpHost.Address, err)
+		}
+		jumpHosts[i] = sshJumpHostConfig{
+			Address:      jumpHost.Address,
+			ClientConfig: jumpHostConfig,
+		}
+	}
 
-	return netconfNewSessionWithConfig(ctx, host, &sshOptions{openSSH, clientConfig})
+	return netconfNewSessionWithConfig(ctx, host, &sshOptions{openSSH, clientConfig, jumpHosts})
 }
 
 // netconfNewSessionWithConfig establishes a new connection to a Junos device that we will use
 // to run our commands against.
+// The connection goes through the proxy and the SSH jump hosts if they are set.
+// Retries wait with an exponential backoff and new connections are delayed
+// when the device limits them.
 func netconfNewSessionWithConfig(
 	ctx context.Context,
 	host string,
@@ -77,9 +114,6 @@ func netconfNewSessionWithConfig(
 ) (
 	*Session, error,
 ) {
-	netDialer := net.Dialer{
-		Timeout: time.Duration(sshOpts.Timeout) * time.Second,
-	}
 	retry := sshOpts.Retry
 	if retry < 1 {
 		retry = 1
@@ -87,56 +121,56 @@ func netconfNewSessionWithConfig(
 	if retry > 10 {
 		retry = 10
 	}
-	sleepTime := 0
-toretry:
+	retryBackoff := newBackoff(connectBackoffBase, connectBackoffMax)
 	for retry > 0 {
 		retry--
-		conn, err := netDialer.DialContext(ctx, "tcp", host)
+		if err := sshOpts.Throttle.wait(ctx); err != nil {
+			return nil, fmt.Errorf("error connecting to %s: %w", host, err)
+		}
+		conn, err := sshOpts.dial(ctx, host)
 		if err != nil {
-			select {
-			case <-ctx.Done():
+			if ctx.Err() != nil || retry == 0 {
 				return nil, fmt.Errorf("error connecting to %s: %w", host, err)
-			default:
-				if retry != 0 {
-					log.Printf("[WARN] connecting to %s: %s, go retry", host, err.Error())
-					// sleep with time increasing as things try
-					sleepTime++
-					utils.Sleep(sleepTime)
-
-					continue toretry
-				}
-
+			}
+			log.Printf("[WARN] connecting to %s: %s, go retry", host, err.Error())
+			sshOpts.Metrics.inc(metricRetries, metricRetryConnect)
+			if err := sleepContext(ctx, retryBackoff.next()); err != nil {
 				return nil, fmt.Errorf("error connecting to %s: %w", host, err)
 			}
+
+			continue
 		}
-		s, err := netconf.NewSSHSession(conn, sshOpts.ClientConfig)
+		s, err := sshOpts.newNetconfSession(ctx, conn)
 		if err != nil {
 			conn.Close()
-			select {
-			case <-ctx.Done():
+			if isConnectionLimited(err) {
+				delay := sshOpts.Throttle.limited()
+				log.Printf("[WARN] initializing SSH session to %s: %s, "+
+					"device seems to limit connections, delay new connections by %s", host, err.Error(), delay)
+			}
+			if ctx.Err() != nil || retry == 0 {
 				return nil, fmt.Errorf("initializing SSH session to %s: %w", host, err)
-			default:
-				if retry != 0 {
-					log.Printf("[WARN] initializing SSH session to %s: %s, go retry", host, err.Error())
-					// sleep with time increasing as things try
-					sleepTime++
-					utils.Sleep(sleepTime)
-
-					continue toretry
-				}
-
+			}
+			log.Printf("[WARN] initializing SSH session to %s: %s, go retry", host, err.Error())
+			sshOpts.Metrics.inc(metricRetries, metricRetryConnect)
+			if err := sleepContext(ctx, retryBackoff.next()); err != nil {
 				return nil, fmt.Errorf("initializing SSH session to %s: %w", host, err)
 			}
+
+			continue
 		}
+		sshOpts.Throttle.succeeded()
 
-		return newSessionFromNetconf(s, conn.LocalAddr().String(), conn.RemoteAddr().String())
+		return newSessionFromNetconf(ctx, s, conn.LocalAddr().String(), conn.RemoteAddr().String())
 	}
 	// this return can't happen
 	return nil, fmt.Errorf("connecting to %s: retries exceeded", host)
 }
 
 // newSessionFromNetconf uses an existing netconf.Session to run our commands against.
+// The netconf exchanges of the session are aborted when ctx is done.
 func newSessionFromNetconf(
+	ctx context.Context,
 	netConfSess *netconf.Session,
 	localAddress,
 	remoteAddress string,
@@ -144,6 +178,7 @@ func newSessionFromNetconf(
 	*Session, error,
 ) {
 	sess := &Session{
+		ctx:           ctx,
 		netconf:       netConfSess,
 		localAddress:  localAddress,
 		remoteAddress: remoteAddress,
@@ -152,51 +187,161 @@ func newSessionFromNetconf(
 	return sess, sess.gatherFacts()
 }
 
-// genSSHClientConfig is a wrapper function based around the auth method defined
-// (user/password or private key) which returns the SSH client configuration used to
-// connect.
+// genSSHClientConfig is a wrapper function based around the auth methods defined
+// (private key with or without certificate, SSH agent, password, keyboard-interactive)
+// which returns the SSH client configuration used to connect.
+// The authentication methods are tried in the order of auth.Methods
+// (or DefaultSSHAuthMethods if not set).
 func genSSHClientConfig(auth *sshAuthMethod) (*ssh.ClientConfig, error) {
-	configs := make([]*ssh.ClientConfig, 0)
-	configs = append(configs, &ssh.ClientConfig{})
+	config := &ssh.ClientConfig{
+		User:            auth.Username,
+		Timeout:         time.Duration(auth.Timeout) * time.Second,
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	}
+	config.Ciphers = auth.Ciphers
+	if auth.HostKey != "" {
+		hostKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(auth.HostKey))
+		if err != nil {
+			return config, fmt.Errorf("parsing host key: %w", err)
+		}
+		config.HostKeyCallback = ssh.FixedHostKey(hostKey)
+	}
+	methods := auth.Methods
+	if len(methods) == 0 {
+		methods = DefaultSSHAuthMethods()
+	}
+	for _, method := range methods {
+		switch method {
+		case SSHAuthMethodPublicKey:
+			authMethod, err := genSSHPublicKeyAuth(auth)
+			if err != nil {
+				return config, err
+			}
+			if authMethod != nil {
+				config.Auth = append(config.Auth, authMethod)
+			}
+		case SSHAuthMethodPassword:
+			if len(auth.Password) > 0 {
+				config.Auth = append(config.Auth, ssh.Password(auth.Password))
+			}
+		case SSHAuthMethodKeyboardInteractive:
+			if len(auth.Password) > 0 {
+				config.Auth = append(config.Auth, ssh.KeyboardInteractive(sshKeyboardInteractivePassword(auth.Password)))
+			}
+		default:
+			return config, fmt.Errorf("unknown SSH authentication method %q", method)
+		}
+	}
+	if len(config.Auth) == 0 {
+		return config, errors.New("no credentials/keys available")
+	}
+
+	return config, nil
+}
 
-	// keys method
+// genSSHPublicKeyAuth returns the publickey authentication method
+// with the private key (and its certificate if set) or with the keys provided by a SSH agent.
+// It returns nil without error if there are no keys available.
+func genSSHPublicKeyAuth(auth *sshAuthMethod) (ssh.AuthMethod, error) {
+	var signer ssh.Signer
 	switch {
 	case len(auth.PrivateKeyPEM) > 0:
-		config, err := netconf.SSHConfigPubKeyPem(auth.Username, []byte(auth.PrivateKeyPEM), auth.Passphrase)
+		var err error
+		signer, err = parseSSHPrivateKey([]byte(auth.PrivateKeyPEM), auth.Passphrase)
 		if err != nil {
-			return config, fmt.Errorf("creating new SSHConfig with PEM private key: %w", err)
+			return nil, fmt.Errorf("parsing PEM private key: %w", err)
 		}
-		configs = append(configs, config)
 	case len(auth.PrivateKeyFile) > 0:
-		config, err := netconf.SSHConfigPubKeyFile(auth.Username, auth.PrivateKeyFile, auth.Passphrase)
+		key, err := os.ReadFile(auth.PrivateKeyFile)
 		if err != nil {
-			return config, fmt.Errorf("creating new SSHConfig with file private key: %w", err)
+			return nil, fmt.Errorf("reading private key file: %w", err)
 		}
-		configs = append(configs, config)
+		signer, err = parseSSHPrivateKey(key, auth.Passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("parsing private key file %s: %w", auth.PrivateKeyFile, err)
+		}
+	case len(auth.CertificatePEM) > 0 || len(auth.CertificateFile) > 0:
+		return nil, errors.New("a SSH certificate need to be paired with a private key")
 	case os.Getenv("SSH_AUTH_SOCK") != "":
 		config, err := netconf.SSHConfigPubKeyAgent(auth.Username)
 		if err != nil {
 			log.Printf("[WARN] communicating with SSH agent: %s", err.Error())
-		} else {
-			configs = append(configs, config)
+
+			return nil, nil
 		}
+
+		return config.Auth[0], nil
+	default:
+		return nil, nil
 	}
-	if len(auth.Password) > 0 {
-		config := netconf.SSHConfigPassword(auth.Username, auth.Password)
-		configs = append(configs, config)
+
+	certificate := []byte(auth.CertificatePEM)
+	if len(certificate) == 0 && len(auth.CertificateFile) > 0 {
+		var err error
+		certificate, err = os.ReadFile(auth.CertificateFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading certificate file: %w", err)
+		}
 	}
-	if len(configs) == 1 {
-		return configs[0], errors.New("no credentials/keys available")
+	if len(certificate) == 0 {
+		return ssh.PublicKeys(signer), nil
 	}
-	configs[0] = configs[1]
-	configs[0].Ciphers = auth.Ciphers
-	configs[0].HostKeyCallback = ssh.InsecureIgnoreHostKey()
-	for _, v := range configs[2:] {
-		configs[0].Auth = append(configs[0].Auth, v.Auth...)
+	certSigner, err := newSSHCertSigner(certificate, signer)
+	if err != nil {
+		return nil, err
 	}
-	configs[0].Timeout = time.Duration(auth.Timeout) * time.Second
 
-	return configs[0], nil
+	// try with certificate first and then with the key alone
+	return ssh.PublicKeys(certSigner, signer), nil
+}
+
+// parseSSHPrivateKey parses a private key, with the passphrase if the key is encrypted.
+func parseSSHPrivateKey(key []byte, passphrase string) (ssh.Signer, error) {
+	signer, err := ssh.ParsePrivateKey(key)
+	if err != nil {
+		var passphraseMissingError *ssh.PassphraseMissingError
+		if errors.As(err, &passphraseMissingError) && passphrase != "" {
+			return ssh.ParsePrivateKeyWithPassphrase(key, []byte(passphrase))
+		}
+
+		return nil, err
+	}
+
+	return signer, nil
+}
+
+// newSSHCertSigner parses an OpenSSH certificate (in authorized_keys format)
+// and returns a signer with it paired with the private key.
+func newSSHCertSigner(certificate []byte, signer ssh.Signer) (ssh.Signer, error) {
+	pubKey, _, _, _, err := ssh.ParseAuthorizedKey(certificate)
+	if err != nil {
+		return nil, fmt.Errorf("parsing SSH certificate: %w", err)
+	}
+	cert, ok := pubKey.(*ssh.Certificate)
+	if !ok {
+		return nil, fmt.Errorf("parsing SSH certificate: %s is not a certificate", pubKey.Type())
+	}
+	certSigner, err := ssh.NewCertSigner(cert, signer)
+	if err != nil {
+		return nil, fmt.Errorf("pairing SSH certificate with private key: %w", err)
+	}
+
+	return certSigner, nil
+}
+
+// sshKeyboardInteractivePassword returns a keyboard-interactive challenge responder
+// which answers password to each prompt without echo (and empty to others).
+func sshKeyboardInteractivePassword(password string) ssh.KeyboardInteractiveChallenge {
+	return func(_, _ string, questions []string, echos []bool) ([]string, error) {
+		answers := make([]string, len(questions))
+		for i := range questions {
+			if i < len(echos) && !echos[i] {
+				answers[i] = password
+			}
+		}
+
+		return answers, nil
+	}
 }
 
 func (sess *Session) HasNetconf() bool {
@@ -205,12 +350,28 @@ func (sess *Session) HasNetconf() bool {
 
 // Command (show, execute) on Junos device via netconf.
 func (sess *Session) Command(cmd string) (string, error) {
+	start := time.Now()
 	read, err := sess.netconfCommand(cmd)
-	sess.logFile(fmt.Sprintf("[Command] cmd: %q", cmd))
-	sess.logFile(fmt.Sprintf("[Command] read: %q", read))
+	if sess.canRecover(err) {
+		if err = sess.recoverSession(err); err == nil {
+			read, err = sess.netconfCommand(cmd)
+		}
+	}
+	fields := map[string]interface{}{
+		logFieldRPC:      "command",
+		"command":        cmd,
+		logFieldDuration: time.Since(start),
+	}
+	sess.logDebug(logSubsystemRPC, "command executed", fields)
+	sess.logTrace(logSubsystemRPC, "command reply", map[string]interface{}{
+		logFieldRPC: "command",
+		"command":   cmd,
+		"reply":     read,
+	})
 	utils.SleepShort(sess.sleepShort)
 	if err != nil && read != EmptyW {
-		sess.logFile(fmt.Sprintf("[Command] err: %q", err))
+		fields[logFieldError] = err
+		sess.logError(logSubsystemRPC, "command failed", fields)
 
 		return "", err
 	}
@@ -220,12 +381,26 @@ func (sess *Session) Command(cmd string) (string, error) {
 
 // CommandXML send XML cmd on Junos device via netconf.
 func (sess *Session) CommandXML(cmd string) (string, error) {
+	start := time.Now()
 	read, err := sess.netconfCommandXML(cmd)
-	sess.logFile(fmt.Sprintf("[CommandXML] cmd: %q", cmd))
-	sess.logFile(fmt.Sprintf("[CommandXML] read: %q", read))
+	if sess.canRecover(err) {
+		if err = sess.recoverSession(err); err == nil {
+			read, err = sess.netconfCommandXML(cmd)
+		}
+	}
+	fields := map[string]interface{}{
+		logFieldRPC:      cmd,
+		logFieldDuration: time.Since(start),
+	}
+	sess.logDebug(logSubsystemRPC, "xml rpc executed", fields)
+	sess.logTrace(logSubsystemRPC, "xml rpc reply", map[string]interface{}{
+		logFieldRPC: cmd,
+		"reply":     read,
+	})
 	utils.SleepShort(sess.sleepShort)
 	if err != nil {
-		sess.logFile(fmt.Sprintf("[CommandXML] err: %q", err))
+		fields[logFieldError] = err
+		sess.logError(logSubsystemRPC, "xml rpc failed", fields)
 
 		return "", err
 	}
@@ -235,17 +410,43 @@ func (sess *Session) CommandXML(cmd string) (string, error) {
 
 // ConfigSet append candidate configuration with set/delete lines
 // on Junos device via netconf or in fake file if set.
+// The lines are kept until the commit to replay them if the session needs to be recovered
+// and recorded for the audit journal if set, even when their loading fails.
 func (sess *Session) ConfigSet(cmd []string) error {
 	if sess.netconf != nil {
+		if sess.journal != nil {
+			sess.journalLines = append(sess.journalLines, cmd...)
+		}
+		start := time.Now()
 		message, err := sess.netconfConfigSet(cmd)
+		if sess.canRecover(err) {
+			if err = sess.recoverSession(err); err == nil {
+				message, err = sess.netconfConfigSet(cmd)
+			}
+		}
 		utils.SleepShort(sess.sleepShort)
-		sess.logFile(fmt.Sprintf("[ConfigSet] cmd: %q", cmd))
-		sess.logFile(fmt.Sprintf("[ConfigSet] message: %q", message))
+		fields := map[string]interface{}{
+			logFieldRPC:      "load-configuration",
+			"lines":          cmd,
+			logFieldDuration: time.Since(start),
+		}
+		sess.logDebug(logSubsystemConfig, "configuration lines loaded", fields)
+		if message != "" {
+			sess.logTrace(logSubsystemConfig, "load configuration reply", map[string]interface{}{
+				logFieldRPC: "load-configuration",
+				"reply":     message,
+			})
+		}
 		if err != nil {
-			sess.logFile(fmt.Sprintf("[ConfigSet] err: %q", err))
+			fields[logFieldError] = err
+			sess.logError(logSubsystemConfig, "loading configuration lines failed", fields)
+			if sess.journal != nil {
+				sess.journalLoadErr = err
+			}
 
 			return err
 		}
+		sess.pendingSetLines = append(sess.pendingSetLines, cmd...)
 
 		return nil
 	} else if sess.fakeSetFile != nil {
@@ -255,64 +456,152 @@ func (sess *Session) ConfigSet(cmd []string) error {
 	return fmt.Errorf("internal error: call Session.ConfigSet without netconf session or fake set file")
 }
 
-// ConfigLock lock candidate configuration and retry with sleep between when fail.
+// ConfigLock lock candidate configuration and retry with sleep between when fail
+// (exponential backoff if the sleep is not set).
 func (sess *Session) ConfigLock(ctx context.Context) error {
+	start := time.Now()
+	lockBackoff := newBackoff(lockBackoffBase, lockBackoffMax)
 	for {
 		select {
 		case <-ctx.Done():
-			sess.logFile("[ConfigLock] lock aborted")
+			sess.logWarn(logSubsystemConfig, "configuration lock aborted", map[string]interface{}{
+				logFieldRPC:      "lock-configuration",
+				logFieldDuration: time.Since(start),
+			})
 
 			return fmt.Errorf("candidate configuration lock attempt aborted")
 		default:
-			if sess.netconfConfigLock() {
-				sess.logFile("[ConfigLock] config locked")
+			locked, err := sess.netconfConfigLock(ctx)
+			if sess.canRecover(err) {
+				if err := sess.recoverSession(err); err != nil {
+					return err
+				}
+
+				continue
+			}
+			if err != nil {
+				sess.logError(logSubsystemConfig, "configuration lock failed", map[string]interface{}{
+					logFieldRPC:      "lock-configuration",
+					logFieldDuration: time.Since(start),
+					logFieldError:    err,
+				})
+
+				return err
+			}
+			if locked {
+				sess.configLocked = true
+				sess.pendingSetLines = nil
+				sess.metrics.observe(metricLockWait, "", time.Since(start))
+				sess.logDebug(logSubsystemConfig, "configuration locked", map[string]interface{}{
+					logFieldRPC:      "lock-configuration",
+					logFieldDuration: time.Since(start),
+				})
 				utils.SleepShort(sess.sleepShort)
 
 				return nil
 			}
-			sess.logFile("[ConfigLock] sleep to wait the lock")
-			utils.Sleep(sess.sleepLock)
+			sess.metrics.inc(metricRetries, metricRetryLock)
+			sleep := sess.lockSleep(lockBackoff)
+			sess.logDebug(logSubsystemConfig, "sleep to wait the configuration lock", map[string]interface{}{
+				logFieldRPC: "lock-configuration",
+				"sleep":     sleep,
+			})
+			_ = sleepContext(ctx, sleep)
 		}
 	}
 }
 
 // ConfigClear clear potential candidate configuration and unlock it.
+// The lines loaded but not committed are written in the audit journal if set.
 func (sess *Session) ConfigClear() (errs []error) {
+	start := time.Now()
+	if journalErr := sess.journalDiscard(); journalErr != nil {
+		errs = append(errs, fmt.Errorf("writing audit journal: %w", journalErr))
+	}
 	errs = append(errs, sess.netconfConfigClear()...)
 	errs = append(errs, sess.netconfConfigUnlock()...)
+	sess.configLocked = false
+	sess.pendingSetLines = nil
 
-	sess.logFile("[ConfigClear] config cleared/unlocked")
+	fields := map[string]interface{}{
+		logFieldRPC:      "unlock-configuration",
+		logFieldDuration: time.Since(start),
+	}
+	if len(errs) > 0 {
+		fields[logFieldError] = errors.Join(errs...)
+		sess.logWarn(logSubsystemConfig, "configuration cleared/unlocked with errors", fields)
+	} else {
+		sess.logDebug(logSubsystemConfig, "configuration cleared/unlocked", fields)
+	}
 	utils.SleepShort(sess.sleepShort)
 
 	return
 }
 
-// CommitConf commit the configuration with message via netconf.
+// CommitConf commit the configuration with message via netconf
+// and abort it if commit timeout is set and exceeded.
+// If the session is lost during the commit, it is recovered and the commit is executed again
+// only if it has not been applied.
+// The lines committed and the result are written in the audit journal if set.
 func (sess *Session) CommitConf(logMessage string) (_warnings []error, _err error) {
-	sess.logFile(fmt.Sprintf("[CommitConf] commit %q", logMessage))
-	warns, err := sess.netconfCommit(logMessage)
+	ctx := sess.ctx
+	if ctx != nil && sess.commitTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, sess.commitTimeout)
+		defer cancel()
+	}
+	start := time.Now()
+	revision, warns, err := sess.netconfCommit(ctx, logMessage)
+	if sess.canRecover(err) {
+		revision, warns, err = sess.recoverCommit(ctx, logMessage, err)
+	}
+	sess.metrics.observe(metricCommitDuration, "", time.Since(start))
 	utils.SleepShort(sess.sleepShort)
-	if len(warns) > 0 {
-		for _, w := range warns {
-			sess.logFile(fmt.Sprintf("[CommitConf] commit warning: %q", w))
-		}
+	fields := map[string]interface{}{
+		logFieldRPC:      "commit-configuration",
+		"log":            logMessage,
+		logFieldDuration: time.Since(start),
+	}
+	if journalErr := sess.journalCommit(logMessage, revision, warns, err); journalErr != nil {
+		warns = append(warns, fmt.Errorf("writing audit journal: %w", journalErr))
+	}
+	for _, w := range warns {
+		sess.logWarn(logSubsystemCommit, "commit warning", map[string]interface{}{
+			logFieldRPC: "commit-configuration",
+			"warning":   w,
+		})
 	}
 	if err != nil {
-		sess.logFile(fmt.Sprintf("[CommitConf] commit error: %q", err))
+		fields[logFieldError] = err
+		sess.logError(logSubsystemCommit, "commit failed", fields)
 
 		return warns, err
 	}
+	sess.pendingSetLines = nil
+	sess.logDebug(logSubsystemCommit, "configuration committed", fields)
 
 	return warns, nil
 }
 
+// lockSleep returns the time to wait before the next attempt to lock candidate configuration:
+// the sleep if set or the next delay of lockBackoff.
+func (sess *Session) lockSleep(lockBackoff *backoff) time.Duration {
+	if sess.sleepLock >= 0 {
+		return time.Duration(sess.sleepLock) * time.Second
+	}
+
+	return lockBackoff.next()
+}
+
 func (sess *Session) Close() {
 	if sess.HasNetconf() {
 		err := sess.closeNetconf(sess.sleepSSHClosed)
 		if err != nil {
-			sess.logFile(fmt.Sprintf("[Close] err: %q", err))
+			sess.logWarn(logSubsystemSSH, "closing session failed", map[string]interface{}{
+				logFieldError: err,
+			})
 		} else {
-			sess.logFile("[Close] session closed")
+			sess.logDebug(logSubsystemSSH, "session closed", nil)
 		}
 	}
 }
@@ -0,0 +1,338 @@
+package junos
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/jeremmfr/go-netconf/netconf"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/net/proxy"
+)
+
+// SSHJumpHost information to connect on a SSH jump host (bastion)
+// used to reach Junos device.
+type SSHJumpHost struct {
+	Address    string
+	Port       int
+	Username   string
+	Password   string
+	SSHKeyPEM  string
+	SSHKeyFile string
+	SSHKeyPass string
+	HostKey    string
+}
+
+type sshJumpHostConfig struct {
+	Address      string
+	ClientConfig *ssh.ClientConfig
+}
+
+type contextDialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// validateSSHProxy check that proxyURL is an URL usable to reach SSH target.
+func validateSSHProxy(proxyURL string) (*url.URL, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing proxy URL: %w", err)
+	}
+	switch u.Scheme {
+	case "socks5", "socks5h", "http":
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q in proxy URL, need to be socks5, socks5h or http", u.Scheme)
+	}
+	if u.Hostname() == "" || u.Port() == "" {
+		return nil, fmt.Errorf("missing host or port in proxy URL %q", u.Redacted())
+	}
+
+	return u, nil
+}
+
+// dial opens a connection to host,
+// through the proxy and the chain of SSH jump hosts if they are set.
+func (sshOpts *sshOptions) dial(ctx context.Context, host string) (net.Conn, error) {
+	netDialer := &net.Dialer{
+		Timeout: time.Duration(sshOpts.Timeout) * time.Second,
+	}
+	var dialer contextDialer = netDialer
+	if sshOpts.Proxy != "" {
+		var err error
+		dialer, err = newProxyDialer(sshOpts.Proxy, netDialer)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(sshOpts.JumpHosts) == 0 {
+		return dialer.DialContext(ctx, "tcp", host)
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", sshOpts.JumpHosts[0].Address)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to jump host %s: %w", sshOpts.JumpHosts[0].Address, err)
+	}
+	chained := &jumpConn{
+		firstConn:  conn,
+		localAddr:  conn.LocalAddr(),
+		remoteAddr: jumpAddr(host),
+	}
+	// ssh.NewClientConn uses neither ctx nor the timeout of ClientConfig,
+	// so the handshakes (which all go through the first connection) are bounded
+	// with a deadline on this connection and by closing it when ctx is done.
+	firstConn := conn
+	if deadline := sshOpts.handshakeDeadline(ctx); !deadline.IsZero() {
+		_ = firstConn.SetDeadline(deadline)
+	}
+	handshakeDone := make(chan struct{})
+	defer close(handshakeDone)
+	go func() {
+		select {
+		case <-ctx.Done():
+			firstConn.Close()
+		case <-handshakeDone:
+		}
+	}()
+	for i, jumpHost := range sshOpts.JumpHosts {
+		next := host
+		if i+1 < len(sshOpts.JumpHosts) {
+			next = sshOpts.JumpHosts[i+1].Address
+		}
+		sshConn, chans, reqs, err := ssh.NewClientConn(conn, jumpHost.Address, jumpHost.ClientConfig)
+		if err != nil {
+			conn.Close()
+			_ = chained.closeJumpClients()
+
+			return nil, fmt.Errorf("initializing SSH session to jump host %s: %w", jumpHost.Address, err)
+		}
+		client := ssh.NewClient(sshConn, chans, reqs)
+		chained.jumpClients = append(chained.jumpClients, client)
+		// open a direct-tcpip channel to the next hop
+		conn, err = client.Dial("tcp", next)
+		if err != nil {
+			_ = chained.closeJumpClients()
+
+			return nil, fmt.Errorf("opening tunnel to %s through jump host %s: %w", next, jumpHost.Address, err)
+		}
+	}
+	if err := firstConn.SetDeadline(time.Time{}); err != nil {
+		conn.Close()
+		_ = chained.closeJumpClients()
+
+		return nil, fmt.Errorf("resetting deadline of connection to jump host %s: %w", sshOpts.JumpHosts[0].Address, err)
+	}
+	chained.Conn = conn
+
+	return chained, nil
+}
+
+// newNetconfSession initializes the SSH and netconf sessions on conn to the device.
+// Like the handshakes with jump hosts, the handshake is bounded with a deadline on conn
+// and by closing conn when ctx is done.
+func (sshOpts *sshOptions) newNetconfSession(ctx context.Context, conn net.Conn) (*netconf.Session, error) {
+	if deadline := sshOpts.handshakeDeadline(ctx); !deadline.IsZero() {
+		_ = conn.SetDeadline(deadline)
+	}
+	handshakeDone := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-handshakeDone:
+		}
+	}()
+	sess, err := netconf.NewSSHSession(conn, sshOpts.ClientConfig)
+	close(handshakeDone)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		sess.Close()
+
+		return nil, fmt.Errorf("resetting deadline of connection: %w", err)
+	}
+
+	return sess, nil
+}
+
+// handshakeDeadline returns the deadline to establish SSH connections
+// with the timeout to establish and the deadline of ctx (zero if none of them is set).
+func (sshOpts *sshOptions) handshakeDeadline(ctx context.Context) time.Time {
+	var deadline time.Time
+	if sshOpts.Timeout > 0 {
+		deadline = time.Now().Add(time.Duration(sshOpts.Timeout) * time.Second)
+	}
+	if ctxDeadline, ok := ctx.Deadline(); ok && (deadline.IsZero() || ctxDeadline.Before(deadline)) {
+		deadline = ctxDeadline
+	}
+
+	return deadline
+}
+
+// jumpConn is a connection tunneled through SSH jump hosts.
+// Closing it also closes the SSH connections to the jump hosts.
+// The deadlines are set on the connection to the first jump host which carries the whole tunnel
+// (the SSH channels don't support them).
+type jumpConn struct {
+	net.Conn
+	firstConn   net.Conn
+	localAddr   net.Addr
+	remoteAddr  net.Addr
+	jumpClients []io.Closer
+}
+
+func (c *jumpConn) SetDeadline(t time.Time) error {
+	return c.firstConn.SetDeadline(t)
+}
+
+func (c *jumpConn) SetReadDeadline(t time.Time) error {
+	return c.firstConn.SetReadDeadline(t)
+}
+
+func (c *jumpConn) SetWriteDeadline(t time.Time) error {
+	return c.firstConn.SetWriteDeadline(t)
+}
+
+func (c *jumpConn) LocalAddr() net.Addr {
+	return c.localAddr
+}
+
+func (c *jumpConn) RemoteAddr() net.Addr {
+	return c.remoteAddr
+}
+
+func (c *jumpConn) Close() error {
+	err := c.Conn.Close()
+	if errJump := c.closeJumpClients(); err == nil {
+		err = errJump
+	}
+
+	return err
+}
+
+// closeJumpClients closes the SSH connections to the jump hosts, last hop first.
+func (c *jumpConn) closeJumpClients() error {
+	var err error
+	for i := len(c.jumpClients) - 1; i >= 0; i-- {
+		if errClose := c.jumpClients[i].Close(); errClose != nil && err == nil &&
+			!errors.Is(errClose, net.ErrClosed) {
+			err = errClose
+		}
+	}
+	c.jumpClients = nil
+
+	return err
+}
+
+// jumpAddr is the address of the final target reached through jump hosts.
+type jumpAddr string
+
+func (a jumpAddr) Network() string {
+	return "tcp"
+}
+
+func (a jumpAddr) String() string {
+	return string(a)
+}
+
+// newProxyDialer returns a dialer that connects through the proxy
+// defined by proxyURL (SOCKS5 or HTTP CONNECT).
+func newProxyDialer(proxyURL string, forward *net.Dialer) (contextDialer, error) {
+	u, err := validateSSHProxy(proxyURL)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "http":
+		return &httpConnectDialer{
+			proxyAddress: u.Host,
+			user:         u.User,
+			forward:      forward,
+		}, nil
+	default:
+		var auth *proxy.Auth
+		if u.User != nil {
+			auth = &proxy.Auth{User: u.User.Username()}
+			auth.Password, _ = u.User.Password()
+		}
+		dialer, err := proxy.SOCKS5("tcp", u.Host, auth, forward)
+		if err != nil {
+			return nil, fmt.Errorf("creating SOCKS5 dialer: %w", err)
+		}
+		ctxDialer, ok := dialer.(contextDialer)
+		if !ok {
+			return nil, errors.New("internal error: SOCKS5 dialer doesn't support context")
+		}
+
+		return ctxDialer, nil
+	}
+}
+
+// httpConnectDialer connects through a HTTP proxy with the CONNECT method.
+type httpConnectDialer struct {
+	proxyAddress string
+	user         *url.Userinfo
+	forward      *net.Dialer
+}
+
+func (d *httpConnectDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	conn, err := d.forward.DialContext(ctx, network, d.proxyAddress)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to proxy %s: %w", d.proxyAddress, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+		defer func() { _ = conn.SetDeadline(time.Time{}) }()
+	}
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: make(http.Header),
+	}
+	if d.user != nil {
+		password, _ := d.user.Password()
+		req.Header.Set("Proxy-Authorization", "Basic "+
+			base64.StdEncoding.EncodeToString([]byte(d.user.Username()+":"+password)))
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("sending CONNECT request to proxy %s: %w", d.proxyAddress, err)
+	}
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("reading CONNECT response from proxy %s: %w", d.proxyAddress, err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+
+		return nil, fmt.Errorf("proxy %s refused CONNECT to %s: %s", d.proxyAddress, address, resp.Status)
+	}
+	if reader.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, reader: reader}, nil
+	}
+
+	return conn, nil
+}
+
+// bufferedConn is a connection with data already read in a buffer.
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
@@ -0,0 +1,60 @@
+package junos
+
+import (
+	"time"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
+)
+
+// FilePut create or overwrite a file on Junos device via netconf
+// (content is not logged).
+func (sess *Session) FilePut(filename, permission string, content []byte) error {
+	start := time.Now()
+	err := sess.netconfFilePut(filename, permission, content)
+	if sess.canRecover(err) {
+		if err = sess.recoverSession(err); err == nil {
+			err = sess.netconfFilePut(filename, permission, content)
+		}
+	}
+	fields := map[string]interface{}{
+		logFieldRPC:      "file-put",
+		"file":           filename,
+		logFieldDuration: time.Since(start),
+	}
+	utils.SleepShort(sess.sleepShort)
+	if err != nil {
+		fields[logFieldError] = err
+		sess.logError(logSubsystemRPC, "file upload failed", fields)
+
+		return err
+	}
+	sess.logDebug(logSubsystemRPC, "file uploaded", fields)
+
+	return nil
+}
+
+// FileDelete remove a file on Junos device via netconf.
+func (sess *Session) FileDelete(filename string) error {
+	start := time.Now()
+	err := sess.netconfFileDelete(filename)
+	if sess.canRecover(err) {
+		if err = sess.recoverSession(err); err == nil {
+			err = sess.netconfFileDelete(filename)
+		}
+	}
+	fields := map[string]interface{}{
+		logFieldRPC:      "file-delete",
+		"file":           filename,
+		logFieldDuration: time.Since(start),
+	}
+	utils.SleepShort(sess.sleepShort)
+	if err != nil {
+		fields[logFieldError] = err
+		sess.logError(logSubsystemRPC, "file deletion failed", fields)
+
+		return err
+	}
+	sess.logDebug(logSubsystemRPC, "file deleted", fields)
+
+	return nil
+}
@@ -21,3 +21,9 @@ func (sess *Session) CheckInterfaceExists(interFace string) (bool, error) {
 
 	return true, nil
 }
+
+// PortInterfaceNameMatch returns a regexp matching the physical interface names
+// resulting from a port of a PIC, with or without channelization (like et-0/0/0 or et-0/0/0:1).
+func PortInterfaceNameMatch(fpc, pic, port string) string {
+	return `^(ge|mge|xe|et)-` + fpc + "/" + pic + "/" + port + `(:\d+)?$`
+}
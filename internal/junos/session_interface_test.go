@@ -0,0 +1,39 @@
+package junos
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestPortInterfaceNameMatch(t *testing.T) {
+	t.Parallel()
+
+	match := regexp.MustCompile(PortInterfaceNameMatch("0", "1", "2"))
+	for _, name := range []string{
+		"ge-0/1/2",
+		"mge-0/1/2",
+		"xe-0/1/2",
+		"et-0/1/2",
+		"xe-0/1/2:0",
+		"xe-0/1/2:3",
+		"et-0/1/2:1",
+	} {
+		if !match.MatchString(name) {
+			t.Errorf("%q doesn't match %q", name, match)
+		}
+	}
+	for _, name := range []string{
+		"et-0/1/20",
+		"et-0/1/2:1.0",
+		"et-0/1/2.0",
+		"et-10/1/2",
+		"et-0/11/2",
+		"xe-0/1/2:",
+		"ae-0/1/2",
+		"fxp0",
+	} {
+		if match.MatchString(name) {
+			t.Errorf("%q matches %q", name, match)
+		}
+	}
+}
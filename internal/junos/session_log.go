@@ -0,0 +1,160 @@
+package junos
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Subsystems of provider logger used by sessions.
+// Log level of each subsystem can be set with the TF_LOG_PROVIDER_<SUBSYSTEM> environment variable
+// (for example TF_LOG_PROVIDER_JUNOS_RPC=TRACE).
+const (
+	logSubsystemSSH    = "junos_ssh"
+	logSubsystemRPC    = "junos_rpc"
+	logSubsystemConfig = "junos_config"
+	logSubsystemCommit = "junos_commit"
+
+	logEnvLevel = "TF_LOG_PROVIDER"
+
+	logFieldDevice        = "device"
+	logFieldLocalAddress  = "session_local_address"
+	logFieldRemoteAddress = "session_remote_address"
+	logFieldRPC           = "rpc"
+	logFieldDuration      = "duration"
+	logFieldError         = "error"
+
+	logMaskedValue = "***"
+)
+
+var (
+	logSubsystems = []string{
+		logSubsystemSSH,
+		logSubsystemRPC,
+		logSubsystemConfig,
+		logSubsystemCommit,
+	}
+
+	// value after a sensitive keyword in set lines or text output.
+	logMaskKeywordValue = regexp.MustCompile(`((?:^|[\s"])(?:` +
+		`encrypted-password|plain-text-password-value|authentication-password|privacy-password|` +
+		`simple-password|password|authentication-key|secret|ascii-text|hexadecimal|key` +
+		`)\s+)("[^"]*"|[^\s"<]+)`)
+	// content of a sensitive element in XML output.
+	logMaskXMLElement = regexp.MustCompile(`(<(` +
+		`encrypted-password|plain-text-password-value|authentication-password|privacy-password|` +
+		`simple-password|password|authentication-key|secret|ascii-text|hexadecimal|key` +
+		`)>)[^<]*(</)`)
+	// Junos encrypted secrets ($9$) and password hashes ($1$, $5$, $6$).
+	logMaskJunosSecret = regexp.MustCompile(`\$[1569]\$[^\s"<]+`)
+)
+
+// maskSensitiveData replaces passwords, secrets and keys in text with a masked value.
+func maskSensitiveData(text string) string {
+	text = logMaskKeywordValue.ReplaceAllString(text, "${1}"+logMaskedValue)
+	text = logMaskXMLElement.ReplaceAllString(text, "${1}"+logMaskedValue+"${3}")
+
+	return logMaskJunosSecret.ReplaceAllString(text, logMaskedValue)
+}
+
+// newLogContext creates subsystems of provider logger for a session with device field.
+func newLogContext(ctx context.Context, device string) context.Context {
+	for _, subsystem := range logSubsystems {
+		ctx = tflog.NewSubsystem(ctx, subsystem,
+			tflog.WithLevelFromEnv(logEnvLevel, strings.ToUpper(subsystem)),
+			tflog.WithAdditionalLocationOffset(2),
+			tflog.WithRootFields(),
+		)
+		ctx = tflog.SubsystemSetField(ctx, subsystem, logFieldDevice, device)
+	}
+
+	return ctx
+}
+
+// logContextWithAddresses adds local and remote addresses of session as fields in all subsystems.
+func logContextWithAddresses(ctx context.Context, localAddress, remoteAddress string) context.Context {
+	for _, subsystem := range logSubsystems {
+		ctx = tflog.SubsystemSetField(ctx, subsystem, logFieldLocalAddress, localAddress)
+		ctx = tflog.SubsystemSetField(ctx, subsystem, logFieldRemoteAddress, remoteAddress)
+	}
+
+	return ctx
+}
+
+// logFileMessage formats message with fields (sorted by key) for debug file.
+func logFileMessage(subsystem, message string, fields map[string]interface{}) string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var fileMessage strings.Builder
+	fileMessage.WriteString("[" + subsystem + "] " + message)
+	for _, k := range keys {
+		fileMessage.WriteString(fmt.Sprintf(" %s=%q", k, fmt.Sprint(fields[k])))
+	}
+
+	return fileMessage.String()
+}
+
+// maskFields masks sensitive data in string values of fields.
+func maskFields(fields map[string]interface{}) map[string]interface{} {
+	masked := make(map[string]interface{}, len(fields))
+	for k, v := range fields {
+		switch value := v.(type) {
+		case string:
+			masked[k] = maskSensitiveData(value)
+		case []string:
+			maskedValue := make([]string, len(value))
+			for i, s := range value {
+				maskedValue[i] = maskSensitiveData(s)
+			}
+			masked[k] = maskedValue
+		case error:
+			masked[k] = maskSensitiveData(value.Error())
+		case time.Duration:
+			masked[k] = value.String()
+		default:
+			masked[k] = v
+		}
+	}
+
+	return masked
+}
+
+type logFunc func(ctx context.Context, subsystem, msg string, additionalFields ...map[string]interface{})
+
+// logWith masks sensitive data in fields then sends message to provider logger and to debug file if set.
+func (sess *Session) logWith(
+	logger logFunc, subsystem, message string, fields map[string]interface{},
+) {
+	fields = maskFields(fields)
+	if sess.ctx != nil {
+		logger(sess.ctx, subsystem, message, fields)
+	}
+	if sess.logFile != nil {
+		sess.logFile(logFileMessage(subsystem, message, fields))
+	}
+}
+
+func (sess *Session) logTrace(subsystem, message string, fields map[string]interface{}) {
+	sess.logWith(tflog.SubsystemTrace, subsystem, message, fields)
+}
+
+func (sess *Session) logDebug(subsystem, message string, fields map[string]interface{}) {
+	sess.logWith(tflog.SubsystemDebug, subsystem, message, fields)
+}
+
+func (sess *Session) logWarn(subsystem, message string, fields map[string]interface{}) {
+	sess.logWith(tflog.SubsystemWarn, subsystem, message, fields)
+}
+
+func (sess *Session) logError(subsystem, message string, fields map[string]interface{}) {
+	sess.logWith(tflog.SubsystemError, subsystem, message, fields)
+}
@@ -0,0 +1,69 @@
+package junos
+
+import (
+	"testing"
+)
+
+func TestMaskSensitiveData(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input  string
+		expect string
+	}
+
+	tests := map[string]testCase{
+		"no_secret": {
+			input:  "set interfaces ge-0/0/0 description \"to core\"",
+			expect: "set interfaces ge-0/0/0 description \"to core\"",
+		},
+		"encrypted_password": {
+			input:  "set system login user test authentication encrypted-password \"$6$abc$def\"",
+			expect: "set system login user test authentication encrypted-password ***",
+		},
+		"plain_text_password_value": {
+			input:  "set system root-authentication plain-text-password-value clear-text",
+			expect: "set system root-authentication plain-text-password-value ***",
+		},
+		"authentication_key": {
+			input:  "set protocols bgp group test authentication-key \"$9$abcdEFGH\"",
+			expect: "set protocols bgp group test authentication-key ***",
+		},
+		"pre_shared_key": {
+			input:  "set security ike policy test pre-shared-key ascii-text clear-text",
+			expect: "set security ike policy test pre-shared-key ascii-text ***",
+		},
+		"secret": {
+			input:  "set system radius-server 192.0.2.1 secret clear-text",
+			expect: "set system radius-server 192.0.2.1 secret ***",
+		},
+		"md5_key": {
+			input:  "set protocols ospf area 0 interface ge-0/0/0.0 authentication md5 1 key clear-text",
+			expect: "set protocols ospf area 0 interface ge-0/0/0.0 authentication md5 1 key ***",
+		},
+		"junos_secret_alone": {
+			input:  "community \"$9$abcdEFGH\" authorization read-only",
+			expect: "community \"***\" authorization read-only",
+		},
+		"xml_element": {
+			input:  "<authentication-key>$9$abcdEFGH</authentication-key><peer-as>65000</peer-as>",
+			expect: "<authentication-key>***</authentication-key><peer-as>65000</peer-as>",
+		},
+		"keyword_in_name": {
+			input:  "set security ike policy key-policy mode main",
+			expect: "set security ike policy key-policy mode main",
+		},
+	}
+
+	for name, test := range tests {
+		name := name
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := maskSensitiveData(test.input); got != test.expect {
+				t.Errorf("got unexpected masked text: %q, expected %q", got, test.expect)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package junos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/jeremmfr/go-netconf/netconf"
+)
+
+// errTransportLost is wrapped in errors of netconf exchanges when the transport of session is lost.
+var errTransportLost = errors.New("netconf transport lost")
+
+// isTransportLost checks if err comes from a connection closed or reset by the device
+// (or anything between the provider and the device).
+func isTransportLost(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, net.ErrClosed) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var opErr *net.OpError
+
+	return errors.As(err, &opErr)
+}
+
+// checkTransport closes the session when err of netconf exchange is a transport loss
+// and wraps err with errTransportLost.
+func (sess *Session) checkTransport(reply *netconf.RPCReply, err error) (*netconf.RPCReply, error) {
+	if !isTransportLost(err) {
+		return reply, err
+	}
+	_ = sess.netconf.Transport.Close()
+	sess.netconf = nil
+
+	return nil, fmt.Errorf("%w: %w", errTransportLost, err)
+}
+
+// canRecover checks if err is a transport loss and if the session can be re-established.
+func (sess *Session) canRecover(err error) bool {
+	return sess.reconnect != nil && errors.Is(err, errTransportLost)
+}
+
+// recoverSession opens a new netconf session to replace the lost one,
+// locks again the candidate configuration if it was locked
+// and replays the set lines loaded since the lock.
+func (sess *Session) recoverSession(cause error) error {
+	start := time.Now()
+	sess.metrics.inc(metricRetries, metricRetryRecovery)
+	sess.logWarn(logSubsystemSSH, "session lost, reconnecting", map[string]interface{}{
+		logFieldError: cause,
+	})
+	newSess, err := sess.reconnect(sess.ctx)
+	if err != nil {
+		sess.logError(logSubsystemSSH, "reconnecting session failed", map[string]interface{}{
+			logFieldDuration: time.Since(start),
+			logFieldError:    err,
+		})
+
+		return fmt.Errorf("%w (reconnecting: %w)", cause, err)
+	}
+	sess.metrics.observe(metricSessionEstablishment, "", time.Since(start))
+	sess.netconf = newSess.netconf
+	sess.localAddress = newSess.localAddress
+	sess.remoteAddress = newSess.remoteAddress
+	// keep SystemInformation as the device is the same and the facts gathered on demand
+	// (software facts) aren't on the new session
+	sess.ctx = logContextWithAddresses(sess.ctx, sess.localAddress, sess.remoteAddress)
+
+	if sess.configLocked {
+		if err := sess.relockConfig(); err != nil {
+			sess.logError(logSubsystemConfig, "locking configuration after reconnect failed", map[string]interface{}{
+				logFieldRPC:   "lock-configuration",
+				logFieldError: err,
+			})
+
+			return fmt.Errorf("%w (locking configuration after reconnect: %w)", cause, err)
+		}
+	}
+	if len(sess.pendingSetLines) > 0 {
+		message, err := sess.netconfConfigSet(sess.pendingSetLines)
+		// like in ConfigSet, messages of load (like 'statement not found' when a delete line is replayed
+		// after the commit has been applied) aren't errors
+		if message != "" {
+			sess.logTrace(logSubsystemConfig, "load configuration reply", map[string]interface{}{
+				logFieldRPC: "load-configuration",
+				"reply":     message,
+			})
+		}
+		if err != nil {
+			sess.logError(logSubsystemConfig, "replaying configuration lines after reconnect failed", map[string]interface{}{
+				logFieldRPC:   "load-configuration",
+				"lines":       sess.pendingSetLines,
+				logFieldError: err,
+			})
+
+			return fmt.Errorf("%w (replaying %d configuration lines after reconnect: %w)",
+				cause, len(sess.pendingSetLines), err)
+		}
+	}
+	sess.logWarn(logSubsystemSSH, "session recovered", map[string]interface{}{
+		logFieldDuration: time.Since(start),
+		"config_locked":  sess.configLocked,
+		"replayed_lines": len(sess.pendingSetLines),
+	})
+
+	return nil
+}
+
+// relockConfig locks candidate configuration on the new session and retry with sleep between when fail
+// (without recovering the session if it is lost again).
+func (sess *Session) relockConfig() error {
+	lockBackoff := newBackoff(lockBackoffBase, lockBackoffMax)
+	for {
+		locked, err := sess.netconfConfigLock(sess.ctx)
+		if err != nil && (errors.Is(err, errTransportLost) || !sess.HasNetconf()) {
+			return err
+		}
+		if locked {
+			return nil
+		}
+		if err := sleepContext(sess.ctx, sess.lockSleep(lockBackoff)); err != nil {
+			return fmt.Errorf("candidate configuration lock attempt aborted: %w", err)
+		}
+	}
+}
+
+// recoverCommit recovers the session lost during a commit and checks if the commit has been applied
+// before the transport loss: after replaying the set lines, the candidate configuration
+// has no difference with active configuration if the commit has been applied,
+// otherwise the commit is executed again.
+// The first warning returned describes what happened.
+// The commit revision is only returned when the commit is executed again.
+func (sess *Session) recoverCommit(
+	ctx context.Context, logMessage string, cause error,
+) (
+	_revision string, _warnings []error, _err error,
+) {
+	if err := sess.recoverSession(cause); err != nil {
+		return "", []error{}, fmt.Errorf("commit status unknown: %w", err)
+	}
+	diff, err := sess.netconfCompareActive()
+	if err != nil {
+		return "", []error{}, fmt.Errorf("commit status unknown after session recovery: %w", err)
+	}
+	if diff == "" {
+		message := fmt.Sprintf("netconf session lost during commit and recovered: "+
+			"commit already applied (no difference with active configuration after replaying %d lines)",
+			len(sess.pendingSetLines))
+		if lastCommit, err := sess.netconfLastCommit(); err == nil {
+			message += ", last commit " + lastCommit.String()
+		}
+		sess.logWarn(logSubsystemCommit, "commit already applied before session lost", map[string]interface{}{
+			logFieldRPC: "commit-configuration",
+			"log":       logMessage,
+		})
+
+		return "", []error{errors.New(message)}, nil
+	}
+	sess.logWarn(logSubsystemCommit, "commit not applied before session lost, commit again", map[string]interface{}{
+		logFieldRPC: "commit-configuration",
+		"log":       logMessage,
+	})
+	revision, warns, err := sess.netconfCommit(ctx, logMessage)
+	warns = append([]error{fmt.Errorf("netconf session lost during commit and recovered: "+
+		"commit not applied, %d lines replayed and committed again", len(sess.pendingSetLines)),
+	}, warns...)
+
+	return revision, warns, err
+}
@@ -0,0 +1,196 @@
+package junos
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/jeremmfr/go-netconf/netconf"
+)
+
+// testScriptTransport is a netconf transport which replies in order with replies
+// and loses the connection (io.EOF) on an empty reply or when there is no more reply.
+type testScriptTransport struct {
+	replies []string
+	sent    []string
+	closed  bool
+}
+
+func (t *testScriptTransport) Send(data []byte) error {
+	if t.closed {
+		return net.ErrClosed
+	}
+	t.sent = append(t.sent, string(data))
+
+	return nil
+}
+
+func (t *testScriptTransport) Receive() ([]byte, error) {
+	if len(t.replies) == 0 {
+		return nil, io.EOF
+	}
+	reply := t.replies[0]
+	t.replies = t.replies[1:]
+	if reply == "" {
+		return nil, io.EOF
+	}
+
+	return []byte(reply), nil
+}
+
+func (t *testScriptTransport) Close() error {
+	t.closed = true
+
+	return nil
+}
+
+func (t *testScriptTransport) ReceiveHello() (*netconf.HelloMessageReceive, error) {
+	return &netconf.HelloMessageReceive{}, nil
+}
+
+func (t *testScriptTransport) SendHello(*netconf.HelloMessageSend) error {
+	return nil
+}
+
+// sentRPC returns the rpc sent in order, with only the first element of each rpc.
+func (t *testScriptTransport) sentRPC() []string {
+	rpcs := make([]string, len(t.sent))
+	for i, data := range t.sent {
+		_, rpc, _ := strings.Cut(data, "\">")
+		rpc, _, _ = strings.Cut(rpc, ">")
+		rpcs[i] = strings.TrimSuffix(strings.Fields(rpc)[0], "/") + ">"
+	}
+
+	return rpcs
+}
+
+const (
+	testReplyOk     = "<rpc-reply><ok/></rpc-reply>"
+	testReplyNoDiff = "<rpc-reply><configuration-information>" +
+		"<configuration-output>\n</configuration-output></configuration-information></rpc-reply>"
+	testReplyDiff = "<rpc-reply><configuration-information>" +
+		"<configuration-output>[edit]\n+  interfaces</configuration-output></configuration-information></rpc-reply>"
+	testReplyCommitInfo = "<rpc-reply><commit-information><commit-history>" +
+		"<sequence-number>0</sequence-number><user>netconf</user><client>netconf</client>" +
+		"<date-time>2023-08-01 10:00:00 UTC</date-time><log>create resource</log>" +
+		"</commit-history></commit-information></rpc-reply>"
+)
+
+func newTestRecoverSession(lost *testScriptTransport, recovered *testScriptTransport) *Session {
+	sess := &Session{
+		ctx:     context.Background(),
+		netconf: &netconf.Session{Transport: lost},
+	}
+	if recovered != nil {
+		sess.reconnect = func(context.Context) (*Session, error) {
+			return &Session{netconf: &netconf.Session{Transport: recovered}}, nil
+		}
+	}
+
+	return sess
+}
+
+func TestSessionRecoverCommand(t *testing.T) {
+	t.Parallel()
+
+	recovered := &testScriptTransport{replies: []string{testReplyOk}}
+	sess := newTestRecoverSession(&testScriptTransport{}, recovered)
+	sess.SystemInformation.HardwareModel = "srx300"
+	sess.SystemInformation.REName = "node0"
+	if _, err := sess.CommandXML(RPCGetAlarmInformation); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := recovered.sentRPC(); len(got) != 1 || got[0] != "<get-alarm-information>" {
+		t.Errorf("unexpected rpc on recovered session: %v", got)
+	}
+	if sess.SystemInformation.HardwareModel != "srx300" || sess.SystemInformation.REName != "node0" {
+		t.Errorf("system information not kept after recovery: %+v", sess.SystemInformation)
+	}
+}
+
+func TestSessionRecoverWithoutReconnect(t *testing.T) {
+	t.Parallel()
+
+	sess := newTestRecoverSession(&testScriptTransport{}, nil)
+	_, err := sess.CommandXML(RPCGetAlarmInformation)
+	if !errors.Is(err, errTransportLost) {
+		t.Fatalf("expected transport lost error, got %v", err)
+	}
+	if sess.HasNetconf() {
+		t.Errorf("session not closed after transport lost")
+	}
+}
+
+func TestSessionRecoverCommit(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		replies    []string
+		expectRPC  []string
+		expectWarn string
+	}{
+		"applied": {
+			replies: []string{testReplyOk, testReplyOk, testReplyNoDiff, testReplyCommitInfo},
+			expectRPC: []string{
+				"<lock>", "<load-configuration>", "<get-configuration>", "<get-commit-information>",
+			},
+			expectWarn: "commit already applied (no difference with active configuration after replaying 1 lines), " +
+				"last commit 2023-08-01 10:00:00 UTC by netconf via netconf with log \"create resource\"",
+		},
+		"applied_replay_warning": {
+			replies: []string{
+				testReplyOk,
+				"<rpc-reply><rpc-error><error-severity>warning</error-severity>" +
+					"<error-message>statement not found</error-message></rpc-error><ok/></rpc-reply>",
+				testReplyNoDiff,
+				testReplyCommitInfo,
+			},
+			expectRPC: []string{
+				"<lock>", "<load-configuration>", "<get-configuration>", "<get-commit-information>",
+			},
+			expectWarn: "commit already applied (no difference with active configuration after replaying 1 lines), " +
+				"last commit 2023-08-01 10:00:00 UTC by netconf via netconf with log \"create resource\"",
+		},
+		"not_applied": {
+			replies: []string{testReplyOk, testReplyOk, testReplyDiff, testReplyOk},
+			expectRPC: []string{
+				"<lock>", "<load-configuration>", "<get-configuration>", "<commit-configuration>",
+			},
+			expectWarn: "commit not applied, 1 lines replayed and committed again",
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			lost := &testScriptTransport{replies: []string{testReplyOk, testReplyOk}}
+			recovered := &testScriptTransport{replies: test.replies}
+			sess := newTestRecoverSession(lost, recovered)
+			if err := sess.ConfigLock(context.Background()); err != nil {
+				t.Fatalf("unexpected lock error: %s", err)
+			}
+			if err := sess.ConfigSet([]string{"set interfaces ge-0/0/0 description test"}); err != nil {
+				t.Fatalf("unexpected set error: %s", err)
+			}
+			warns, err := sess.CommitConf("create resource")
+			if err != nil {
+				t.Fatalf("unexpected commit error: %s", err)
+			}
+			if len(warns) == 0 || !strings.HasSuffix(warns[0].Error(), test.expectWarn) {
+				t.Errorf("unexpected warnings: %v", warns)
+			}
+			got := recovered.sentRPC()
+			if strings.Join(got, " ") != strings.Join(test.expectRPC, " ") {
+				t.Errorf("unexpected rpc on recovered session: %v, expected %v", got, test.expectRPC)
+			}
+			if len(sess.pendingSetLines) != 0 {
+				t.Errorf("pending lines not cleared after commit: %v", sess.pendingSetLines)
+			}
+		})
+	}
+}
@@ -0,0 +1,499 @@
+package junos
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jeremmfr/go-netconf/netconf"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	testSSHUser     = "netconf"
+	testSSHPassword = "testPassword"
+)
+
+// testSSHServer is a local SSH server which only does authentication.
+type testSSHServer struct {
+	address string
+	mutex   sync.Mutex
+	methods []string
+}
+
+func newTestSSHServer(t *testing.T, config *ssh.ServerConfig) *testSSHServer {
+	t.Helper()
+
+	_, hostPrivKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generating host key: %s", err)
+	}
+	hostSigner, err := ssh.NewSignerFromKey(hostPrivKey)
+	if err != nil {
+		t.Fatalf("creating host signer: %s", err)
+	}
+	config.AddHostKey(hostSigner)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listening: %s", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	server := &testSSHServer{address: listener.Addr().String()}
+	config.AuthLogCallback = func(_ ssh.ConnMetadata, method string, _ error) {
+		if method == "none" {
+			return
+		}
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		server.methods = append(server.methods, method)
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				sshConn, _, _, err := ssh.NewServerConn(conn, config)
+				if err == nil {
+					sshConn.Close()
+				}
+			}()
+		}
+	}()
+
+	return server
+}
+
+func (server *testSSHServer) attemptedMethods() []string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return append([]string{}, server.methods...)
+}
+
+func (server *testSSHServer) connect(config *ssh.ClientConfig) error {
+	conn, err := net.Dial("tcp", server.address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	sshConn, _, _, err := ssh.NewClientConn(conn, server.address, config)
+	if err != nil {
+		return err
+	}
+	// server can close the connection first after authentication
+	_ = sshConn.Close()
+
+	return nil
+}
+
+type testSSHKey struct {
+	signer ssh.Signer
+	pem    string
+}
+
+func newTestSSHKey(t *testing.T) testSSHKey {
+	t.Helper()
+
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating private key: %s", err)
+	}
+	signer, err := ssh.NewSignerFromKey(privKey)
+	if err != nil {
+		t.Fatalf("creating signer: %s", err)
+	}
+	der, err := x509.MarshalECPrivateKey(privKey)
+	if err != nil {
+		t.Fatalf("marshaling private key: %s", err)
+	}
+
+	return testSSHKey{
+		signer: signer,
+		pem:    string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})),
+	}
+}
+
+func newTestSSHCertificate(t *testing.T, ca ssh.Signer, key ssh.PublicKey) string {
+	t.Helper()
+
+	cert := &ssh.Certificate{
+		Key:             key,
+		CertType:        ssh.UserCert,
+		KeyId:           "testacc",
+		ValidPrincipals: []string{testSSHUser},
+		ValidBefore:     ssh.CertTimeInfinity,
+	}
+	if err := cert.SignCert(rand.Reader, ca); err != nil {
+		t.Fatalf("signing certificate: %s", err)
+	}
+
+	return string(ssh.MarshalAuthorizedKey(cert))
+}
+
+func writeTestFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatalf("writing file: %s", err)
+	}
+
+	return file
+}
+
+func TestGenSSHClientConfig(t *testing.T) { //nolint:maintidx
+	// disable SSH agent to test only methods in configuration
+	t.Setenv("SSH_AUTH_SOCK", "")
+
+	userKey := newTestSSHKey(t)
+	otherKey := newTestSSHKey(t)
+	caKey := newTestSSHKey(t)
+	userCert := newTestSSHCertificate(t, caKey.signer, userKey.signer.PublicKey())
+
+	passwordCallback := func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+		if conn.User() == testSSHUser && string(password) == testSSHPassword {
+			return nil, nil
+		}
+
+		return nil, errors.New("bad password")
+	}
+	keyboardInteractiveCallback := func(
+		conn ssh.ConnMetadata, client ssh.KeyboardInteractiveChallenge,
+	) (*ssh.Permissions, error) {
+		answers, err := client("", "", []string{"Login: ", "Password: "}, []bool{true, false})
+		if err != nil {
+			return nil, err
+		}
+		if conn.User() == testSSHUser && len(answers) == 2 && answers[1] == testSSHPassword {
+			return nil, nil
+		}
+
+		return nil, errors.New("bad answers")
+	}
+	publicKeyCallback := func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+		if conn.User() == testSSHUser && bytes.Equal(key.Marshal(), userKey.signer.PublicKey().Marshal()) {
+			return nil, nil
+		}
+
+		return nil, errors.New("unknown public key")
+	}
+	certChecker := &ssh.CertChecker{
+		IsUserAuthority: func(auth ssh.PublicKey) bool {
+			return bytes.Equal(auth.Marshal(), caKey.signer.PublicKey().Marshal())
+		},
+	}
+
+	type testCase struct {
+		server        *ssh.ServerConfig
+		auth          sshAuthMethod
+		expectGenErr  bool
+		expectConnErr bool
+		expectMethods []string
+	}
+
+	tests := map[string]testCase{
+		"Password": {
+			server: &ssh.ServerConfig{PasswordCallback: passwordCallback},
+			auth: sshAuthMethod{
+				Username: testSSHUser,
+				Password: testSSHPassword,
+			},
+			expectMethods: []string{SSHAuthMethodPassword},
+		},
+		"PasswordWrong": {
+			server: &ssh.ServerConfig{PasswordCallback: passwordCallback},
+			auth: sshAuthMethod{
+				Username: testSSHUser,
+				Password: "wrongPassword",
+			},
+			expectConnErr: true,
+		},
+		"KeyboardInteractive": {
+			server: &ssh.ServerConfig{KeyboardInteractiveCallback: keyboardInteractiveCallback},
+			auth: sshAuthMethod{
+				Username: testSSHUser,
+				Password: testSSHPassword,
+			},
+			expectMethods: []string{SSHAuthMethodKeyboardInteractive},
+		},
+		"KeyboardInteractiveDisabled": {
+			server: &ssh.ServerConfig{KeyboardInteractiveCallback: keyboardInteractiveCallback},
+			auth: sshAuthMethod{
+				Username: testSSHUser,
+				Password: testSSHPassword,
+				Methods:  []string{SSHAuthMethodPassword},
+			},
+			expectConnErr: true,
+		},
+		"PrivateKeyPEM": {
+			server: &ssh.ServerConfig{PublicKeyCallback: publicKeyCallback},
+			auth: sshAuthMethod{
+				Username:      testSSHUser,
+				PrivateKeyPEM: userKey.pem,
+			},
+			expectMethods: []string{SSHAuthMethodPublicKey},
+		},
+		"PrivateKeyFile": {
+			server: &ssh.ServerConfig{PublicKeyCallback: publicKeyCallback},
+			auth: sshAuthMethod{
+				Username:       testSSHUser,
+				PrivateKeyFile: writeTestFile(t, "id_ecdsa", userKey.pem),
+			},
+			expectMethods: []string{SSHAuthMethodPublicKey},
+		},
+		"PrivateKeyWrong": {
+			server: &ssh.ServerConfig{PublicKeyCallback: publicKeyCallback},
+			auth: sshAuthMethod{
+				Username:      testSSHUser,
+				PrivateKeyPEM: otherKey.pem,
+			},
+			expectConnErr: true,
+		},
+		"PrivateKeyInvalid": {
+			auth: sshAuthMethod{
+				Username:      testSSHUser,
+				PrivateKeyPEM: "not a key",
+			},
+			expectGenErr: true,
+		},
+		"CertificatePEM": {
+			server: &ssh.ServerConfig{PublicKeyCallback: certChecker.Authenticate},
+			auth: sshAuthMethod{
+				Username:       testSSHUser,
+				PrivateKeyPEM:  userKey.pem,
+				CertificatePEM: userCert,
+			},
+			expectMethods: []string{SSHAuthMethodPublicKey},
+		},
+		"CertificateFile": {
+			server: &ssh.ServerConfig{PublicKeyCallback: certChecker.Authenticate},
+			auth: sshAuthMethod{
+				Username:        testSSHUser,
+				PrivateKeyFile:  writeTestFile(t, "id_ecdsa", userKey.pem),
+				CertificateFile: writeTestFile(t, "id_ecdsa-cert.pub", userCert),
+			},
+			expectMethods: []string{SSHAuthMethodPublicKey},
+		},
+		"CertificateRequired": {
+			server: &ssh.ServerConfig{PublicKeyCallback: certChecker.Authenticate},
+			auth: sshAuthMethod{
+				Username:      testSSHUser,
+				PrivateKeyPEM: userKey.pem,
+			},
+			expectConnErr: true,
+		},
+		"CertificateWithoutKey": {
+			auth: sshAuthMethod{
+				Username:       testSSHUser,
+				CertificatePEM: userCert,
+			},
+			expectGenErr: true,
+		},
+		"CertificateOtherKey": {
+			auth: sshAuthMethod{
+				Username:       testSSHUser,
+				PrivateKeyPEM:  otherKey.pem,
+				CertificatePEM: userCert,
+			},
+			expectGenErr: true,
+		},
+		"CertificateNotCert": {
+			auth: sshAuthMethod{
+				Username:       testSSHUser,
+				PrivateKeyPEM:  userKey.pem,
+				CertificatePEM: string(ssh.MarshalAuthorizedKey(userKey.signer.PublicKey())),
+			},
+			expectGenErr: true,
+		},
+		"MethodsDefaultOrder": {
+			server: &ssh.ServerConfig{
+				PublicKeyCallback: func(ssh.ConnMetadata, ssh.PublicKey) (*ssh.Permissions, error) {
+					return nil, errors.New("refused")
+				},
+				PasswordCallback: func(ssh.ConnMetadata, []byte) (*ssh.Permissions, error) {
+					return nil, errors.New("refused")
+				},
+				KeyboardInteractiveCallback: keyboardInteractiveCallback,
+			},
+			auth: sshAuthMethod{
+				Username:      testSSHUser,
+				PrivateKeyPEM: otherKey.pem,
+				Password:      testSSHPassword,
+			},
+			expectMethods: []string{
+				SSHAuthMethodPublicKey,
+				SSHAuthMethodPassword,
+				SSHAuthMethodKeyboardInteractive,
+			},
+		},
+		"MethodsCustomOrder": {
+			server: &ssh.ServerConfig{
+				PublicKeyCallback: publicKeyCallback,
+				PasswordCallback: func(ssh.ConnMetadata, []byte) (*ssh.Permissions, error) {
+					return nil, errors.New("refused")
+				},
+				KeyboardInteractiveCallback: func(
+					ssh.ConnMetadata, ssh.KeyboardInteractiveChallenge,
+				) (*ssh.Permissions, error) {
+					return nil, errors.New("refused")
+				},
+			},
+			auth: sshAuthMethod{
+				Username:      testSSHUser,
+				PrivateKeyPEM: userKey.pem,
+				Password:      testSSHPassword,
+				Methods: []string{
+					SSHAuthMethodKeyboardInteractive,
+					SSHAuthMethodPassword,
+					SSHAuthMethodPublicKey,
+				},
+			},
+			expectMethods: []string{
+				SSHAuthMethodKeyboardInteractive,
+				SSHAuthMethodPassword,
+				SSHAuthMethodPublicKey,
+			},
+		},
+		"MethodsUnknown": {
+			auth: sshAuthMethod{
+				Username: testSSHUser,
+				Password: testSSHPassword,
+				Methods:  []string{"gssapi-with-mic"},
+			},
+			expectGenErr: true,
+		},
+		"NoCredentials": {
+			auth: sshAuthMethod{
+				Username: testSSHUser,
+				Methods:  []string{SSHAuthMethodPublicKey},
+			},
+			expectGenErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			config, err := genSSHClientConfig(&test.auth)
+			if test.expectGenErr {
+				if err == nil {
+					t.Errorf("expected error from genSSHClientConfig, got nil")
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("got unexpected error from genSSHClientConfig: %s", err)
+			}
+
+			server := newTestSSHServer(t, test.server)
+			err = server.connect(config)
+			if test.expectConnErr {
+				if err == nil {
+					t.Errorf("expected error to connect, got nil")
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("got unexpected error to connect: %s", err)
+			}
+			if test.expectMethods != nil {
+				methods := server.attemptedMethods()
+				if len(methods) != len(test.expectMethods) {
+					t.Fatalf("expected methods %v, got %v", test.expectMethods, methods)
+				}
+				for i, method := range methods {
+					if method != test.expectMethods[i] {
+						t.Errorf("expected methods %v, got %v", test.expectMethods, methods)
+
+						break
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSessionConfigLock(t *testing.T) {
+	t.Parallel()
+
+	const replyLockDenied = "<rpc-reply><rpc-error><error-type>protocol</error-type>" +
+		"<error-tag>lock-denied</error-tag><error-severity>error</error-severity>" +
+		"<error-message>configuration database locked by:\n  admin terminal p0</error-message>" +
+		"</rpc-error></rpc-reply>"
+	const replyOtherError = "<rpc-reply><rpc-error><error-type>protocol</error-type>" +
+		"<error-tag>operation-failed</error-tag><error-severity>error</error-severity>" +
+		"<error-message>permission denied</error-message></rpc-error></rpc-reply>"
+
+	t.Run("lock_held", func(t *testing.T) {
+		t.Parallel()
+
+		transport := &testScriptTransport{replies: []string{replyLockDenied, replyLockDenied, testReplyOk}}
+		sess := &Session{
+			ctx:       context.Background(),
+			netconf:   &netconf.Session{Transport: transport},
+			sleepLock: 0,
+		}
+		if err := sess.ConfigLock(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !sess.configLocked || len(transport.sent) != 3 {
+			t.Errorf("expected lock after 3 attempts, locked: %t, attempts: %d", sess.configLocked, len(transport.sent))
+		}
+	})
+
+	t.Run("other_error", func(t *testing.T) {
+		t.Parallel()
+
+		transport := &testScriptTransport{replies: []string{replyOtherError, testReplyOk}}
+		sess := &Session{
+			ctx:       context.Background(),
+			netconf:   &netconf.Session{Transport: transport},
+			sleepLock: 0,
+		}
+		err := sess.ConfigLock(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "permission denied") {
+			t.Fatalf("expected permission denied error, got %v", err)
+		}
+		if len(transport.sent) != 1 {
+			t.Errorf("expected only one attempt, got %d", len(transport.sent))
+		}
+	})
+
+	t.Run("session_closed", func(t *testing.T) {
+		t.Parallel()
+
+		sess := &Session{
+			ctx:       context.Background(),
+			sleepLock: 0,
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		start := time.Now()
+		if err := sess.ConfigLock(ctx); err == nil {
+			t.Fatalf("expected error with closed session")
+		}
+		if ctx.Err() != nil {
+			t.Errorf("lock retried until deadline with closed session (%s)", time.Since(start))
+		}
+	})
+}
@@ -0,0 +1,275 @@
+package providerfwk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &bgpNeighborsInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &bgpNeighborsInfoDataSource{}
+)
+
+type bgpNeighborsInfoDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *bgpNeighborsInfoDataSource) typeName() string {
+	return providerName + "_bgp_neighbors_info"
+}
+
+func (dsc *bgpNeighborsInfoDataSource) junosName() string {
+	return "operational state of bgp neighbors"
+}
+
+func newBgpNeighborsInfoDataSource() datasource.DataSource {
+	return &bgpNeighborsInfoDataSource{}
+}
+
+func (dsc *bgpNeighborsInfoDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *bgpNeighborsInfoDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *bgpNeighborsInfoDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Get " + dsc.junosName() + ".",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the data source.",
+			},
+			"routing_instance": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only read neighbors in this routing instance.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+			"neighbor_address": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only read neighbor with this IP address.",
+				Validators: []validator.String{
+					tfvalidator.StringIPAddress(),
+				},
+			},
+			"neighbors": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each bgp neighbor found.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"peer_address":     types.StringType,
+						"peer_as":          types.StringType,
+						"local_address":    types.StringType,
+						"local_as":         types.StringType,
+						"peer_group":       types.StringType,
+						"routing_instance": types.StringType,
+						"peer_type":        types.StringType,
+						"peer_state":       types.StringType,
+						"last_state":       types.StringType,
+						"last_event":       types.StringType,
+						"last_error":       types.StringType,
+						"peer_id":          types.StringType,
+						"flap_count":       types.Int64Type,
+						"active_holdtime":  types.Int64Type,
+						"rib": types.ListType{}.WithElementType(types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"name":                    types.StringType,
+								"active_prefix_count":     types.Int64Type,
+								"received_prefix_count":   types.Int64Type,
+								"accepted_prefix_count":   types.Int64Type,
+								"suppressed_prefix_count": types.Int64Type,
+								"advertised_prefix_count": types.Int64Type,
+							},
+						}),
+					},
+				},
+			},
+		},
+	}
+}
+
+type bgpNeighborsInfoDataSourceData struct {
+	ID              types.String                              `tfsdk:"id"`
+	RoutingInstance types.String                              `tfsdk:"routing_instance"`
+	NeighborAddress types.String                              `tfsdk:"neighbor_address"`
+	Neighbors       []bgpNeighborsInfoDataSourceBlockNeighbor `tfsdk:"neighbors"`
+}
+
+type bgpNeighborsInfoDataSourceConfig struct {
+	ID              types.String `tfsdk:"id"`
+	RoutingInstance types.String `tfsdk:"routing_instance"`
+	NeighborAddress types.String `tfsdk:"neighbor_address"`
+	Neighbors       types.List   `tfsdk:"neighbors"`
+}
+
+type bgpNeighborsInfoDataSourceBlockNeighbor struct {
+	PeerAddress     types.String                                      `tfsdk:"peer_address"`
+	PeerAS          types.String                                      `tfsdk:"peer_as"`
+	LocalAddress    types.String                                      `tfsdk:"local_address"`
+	LocalAS         types.String                                      `tfsdk:"local_as"`
+	PeerGroup       types.String                                      `tfsdk:"peer_group"`
+	RoutingInstance types.String                                      `tfsdk:"routing_instance"`
+	PeerType        types.String                                      `tfsdk:"peer_type"`
+	PeerState       types.String                                      `tfsdk:"peer_state"`
+	LastState       types.String                                      `tfsdk:"last_state"`
+	LastEvent       types.String                                      `tfsdk:"last_event"`
+	LastError       types.String                                      `tfsdk:"last_error"`
+	PeerID          types.String                                      `tfsdk:"peer_id"`
+	FlapCount       types.Int64                                       `tfsdk:"flap_count"`
+	ActiveHoldtime  types.Int64                                       `tfsdk:"active_holdtime"`
+	Rib             []bgpNeighborsInfoDataSourceBlockNeighborBlockRib `tfsdk:"rib"`
+}
+
+type bgpNeighborsInfoDataSourceBlockNeighborBlockRib struct {
+	Name                  types.String `tfsdk:"name"`
+	ActivePrefixCount     types.Int64  `tfsdk:"active_prefix_count"`
+	ReceivedPrefixCount   types.Int64  `tfsdk:"received_prefix_count"`
+	AcceptedPrefixCount   types.Int64  `tfsdk:"accepted_prefix_count"`
+	SuppressedPrefixCount types.Int64  `tfsdk:"suppressed_prefix_count"`
+	AdvertisedPrefixCount types.Int64  `tfsdk:"advertised_prefix_count"`
+}
+
+func (dsc *bgpNeighborsInfoDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	var config bgpNeighborsInfoDataSourceConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	junSess, err := dsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	var data bgpNeighborsInfoDataSourceData
+	junos.MutexLock()
+	err = data.read(ctx, config, junSess)
+	junos.MutexUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
+
+		return
+	}
+
+	data.fillIDAndConfigArgument(config)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (dscData *bgpNeighborsInfoDataSourceData) fillIDAndConfigArgument(
+	config bgpNeighborsInfoDataSourceConfig,
+) {
+	dscData.RoutingInstance = config.RoutingInstance
+	dscData.NeighborAddress = config.NeighborAddress
+	idString := "routing_instance=" + config.RoutingInstance.ValueString()
+	if v := config.NeighborAddress.ValueString(); v != "" {
+		idString += junos.IDSeparator + "neighbor_address=" + v
+	}
+	dscData.ID = types.StringValue(idString)
+}
+
+func (dscData *bgpNeighborsInfoDataSourceData) read(
+	_ context.Context,
+	config bgpNeighborsInfoDataSourceConfig,
+	junSess *junos.Session,
+) error {
+	rpcArgs := ""
+	if v := config.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
+		rpcArgs += "<instance>" + v + "</instance>"
+	}
+	if v := config.NeighborAddress.ValueString(); v != "" {
+		rpcArgs += "<neighbor-address>" + v + "</neighbor-address>"
+	}
+	replyData, err := junSess.CommandXML(fmt.Sprintf(junos.RPCGetBgpNeighborInformation, rpcArgs))
+	if err != nil {
+		return err
+	}
+	var reply junos.GetBgpNeighborInformationReply
+	err = xml.Unmarshal([]byte(replyData), &reply.BgpInfo)
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	for _, peer := range reply.BgpInfo.Peer {
+		routingInstance := strings.TrimSpace(peer.PeerCfgRti)
+		if routingInstance == "" || routingInstance == "master" {
+			routingInstance = junos.DefaultW
+		}
+		if v := config.RoutingInstance.ValueString(); v != "" && v != routingInstance {
+			continue
+		}
+		neighbor := bgpNeighborsInfoDataSourceBlockNeighbor{
+			PeerAddress:     types.StringValue(bgpNeighborsInfoTrimPort(peer.PeerAddress)),
+			PeerAS:          types.StringValue(strings.TrimSpace(peer.PeerAS)),
+			LocalAddress:    types.StringValue(bgpNeighborsInfoTrimPort(peer.LocalAddress)),
+			LocalAS:         types.StringValue(strings.TrimSpace(peer.LocalAS)),
+			PeerGroup:       types.StringValue(strings.TrimSpace(peer.PeerGroup)),
+			RoutingInstance: types.StringValue(routingInstance),
+			PeerType:        types.StringValue(strings.TrimSpace(peer.PeerType)),
+			PeerState:       types.StringValue(strings.TrimSpace(peer.PeerState)),
+			LastState:       types.StringValue(strings.TrimSpace(peer.LastState)),
+			LastEvent:       types.StringValue(strings.TrimSpace(peer.LastEvent)),
+			LastError:       types.StringValue(strings.TrimSpace(peer.LastError)),
+			PeerID:          types.StringValue(strings.TrimSpace(peer.PeerID)),
+			FlapCount:       types.Int64Value(int64(peer.FlapCount)),
+			ActiveHoldtime:  types.Int64Value(int64(peer.ActiveHoldtime)),
+		}
+		for _, rib := range peer.Rib {
+			neighbor.Rib = append(neighbor.Rib, bgpNeighborsInfoDataSourceBlockNeighborBlockRib{
+				Name:                  types.StringValue(strings.TrimSpace(rib.Name)),
+				ActivePrefixCount:     types.Int64Value(int64(rib.ActivePrefixCount)),
+				ReceivedPrefixCount:   types.Int64Value(int64(rib.ReceivedPrefixCount)),
+				AcceptedPrefixCount:   types.Int64Value(int64(rib.AcceptedPrefixCount)),
+				SuppressedPrefixCount: types.Int64Value(int64(rib.SuppressedPrefixCount)),
+				AdvertisedPrefixCount: types.Int64Value(int64(rib.AdvertisedPrefixCount)),
+			})
+		}
+		dscData.Neighbors = append(dscData.Neighbors, neighbor)
+	}
+
+	return nil
+}
+
+// bgpNeighborsInfoTrimPort remove the TCP port (+179) added by Junos at the end of address.
+func bgpNeighborsInfoTrimPort(address string) string {
+	address, _, _ = strings.Cut(strings.TrimSpace(address), "+")
+
+	return address
+}
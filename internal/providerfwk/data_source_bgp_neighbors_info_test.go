@@ -0,0 +1,67 @@
+package providerfwk_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceBgpNeighborsInfo_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceBgpNeighborsInfoPre(),
+				},
+				{
+					Config: testAccDataSourceBgpNeighborsInfoConfig(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_bgp_neighbors_info.testacc_bgpNeighborsInfo",
+							"neighbors.#", "1"),
+						resource.TestCheckResourceAttr("data.junos_bgp_neighbors_info.testacc_bgpNeighborsInfo",
+							"neighbors.0.peer_address", "192.0.2.4"),
+						resource.TestCheckResourceAttr("data.junos_bgp_neighbors_info.testacc_bgpNeighborsInfo",
+							"neighbors.0.peer_as", "65002"),
+						resource.TestCheckResourceAttr("data.junos_bgp_neighbors_info.testacc_bgpNeighborsInfo",
+							"neighbors.0.peer_group", "testacc_bgpNeighborsInfo"),
+						resource.TestCheckResourceAttr("data.junos_bgp_neighbors_info.testacc_bgpNeighborsInfo",
+							"neighbors.0.routing_instance", "default"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccDataSourceBgpNeighborsInfoPre() string {
+	return `
+resource "junos_bgp_group" "testacc_bgpNeighborsInfo" {
+  name    = "testacc_bgpNeighborsInfo"
+  peer_as = "65002"
+}
+resource "junos_bgp_neighbor" "testacc_bgpNeighborsInfo" {
+  ip    = "192.0.2.4"
+  group = junos_bgp_group.testacc_bgpNeighborsInfo.name
+}
+`
+}
+
+func testAccDataSourceBgpNeighborsInfoConfig() string {
+	return `
+resource "junos_bgp_group" "testacc_bgpNeighborsInfo" {
+  name    = "testacc_bgpNeighborsInfo"
+  peer_as = "65002"
+}
+resource "junos_bgp_neighbor" "testacc_bgpNeighborsInfo" {
+  ip    = "192.0.2.4"
+  group = junos_bgp_group.testacc_bgpNeighborsInfo.name
+}
+data "junos_bgp_neighbors_info" "testacc_bgpNeighborsInfo" {
+  routing_instance = "default"
+  neighbor_address = junos_bgp_neighbor.testacc_bgpNeighborsInfo.ip
+}
+`
+}
@@ -0,0 +1,268 @@
+package providerfwk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &chassisInventoryDataSource{}
+	_ datasource.DataSourceWithConfigure = &chassisInventoryDataSource{}
+)
+
+type chassisInventoryDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *chassisInventoryDataSource) typeName() string {
+	return providerName + "_chassis_inventory"
+}
+
+func (dsc *chassisInventoryDataSource) junosName() string {
+	return "chassis hardware inventory"
+}
+
+func newChassisInventoryDataSource() datasource.DataSource {
+	return &chassisInventoryDataSource{}
+}
+
+func (dsc *chassisInventoryDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *chassisInventoryDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *chassisInventoryDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	moduleAttrTypes := map[string]attr.Type{
+		"name":          types.StringType,
+		"version":       types.StringType,
+		"part_number":   types.StringType,
+		"serial_number": types.StringType,
+		"description":   types.StringType,
+		"model_number":  types.StringType,
+		"clei_code":     types.StringType,
+	}
+	subModuleAttrTypes := map[string]attr.Type{
+		"sub_sub_module": types.ListType{}.WithElementType(types.ObjectType{
+			AttrTypes: moduleAttrTypes,
+		}),
+	}
+	for k, v := range moduleAttrTypes {
+		subModuleAttrTypes[k] = v
+	}
+	chassisModuleAttrTypes := map[string]attr.Type{
+		"sub_module": types.ListType{}.WithElementType(types.ObjectType{
+			AttrTypes: subModuleAttrTypes,
+		}),
+	}
+	for k, v := range moduleAttrTypes {
+		chassisModuleAttrTypes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Get " + dsc.junosName() + " (like `show chassis hardware`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the data source.",
+			},
+			"chassis": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each chassis found.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"re_name":       types.StringType,
+						"name":          types.StringType,
+						"serial_number": types.StringType,
+						"description":   types.StringType,
+						"module": types.ListType{}.WithElementType(types.ObjectType{
+							AttrTypes: chassisModuleAttrTypes,
+						}),
+					},
+				},
+			},
+		},
+	}
+}
+
+type chassisInventoryDataSourceData struct {
+	ID      types.String                             `tfsdk:"id"`
+	Chassis []chassisInventoryDataSourceBlockChassis `tfsdk:"chassis"`
+}
+
+type chassisInventoryDataSourceBlockChassis struct {
+	REName       types.String                                        `tfsdk:"re_name"`
+	Name         types.String                                        `tfsdk:"name"`
+	SerialNumber types.String                                        `tfsdk:"serial_number"`
+	Description  types.String                                        `tfsdk:"description"`
+	Module       []chassisInventoryDataSourceBlockChassisBlockModule `tfsdk:"module"`
+}
+
+type chassisInventoryDataSourceBlockChassisBlockModule struct {
+	Name         types.String                                                      `tfsdk:"name"`
+	Version      types.String                                                      `tfsdk:"version"`
+	PartNumber   types.String                                                      `tfsdk:"part_number"`
+	SerialNumber types.String                                                      `tfsdk:"serial_number"`
+	Description  types.String                                                      `tfsdk:"description"`
+	ModelNumber  types.String                                                      `tfsdk:"model_number"`
+	CleiCode     types.String                                                      `tfsdk:"clei_code"`
+	SubModule    []chassisInventoryDataSourceBlockChassisBlockModuleBlockSubModule `tfsdk:"sub_module"`
+}
+
+type chassisInventoryDataSourceBlockChassisBlockModuleBlockSubModule struct {
+	Name         types.String                                  `tfsdk:"name"`
+	Version      types.String                                  `tfsdk:"version"`
+	PartNumber   types.String                                  `tfsdk:"part_number"`
+	SerialNumber types.String                                  `tfsdk:"serial_number"`
+	Description  types.String                                  `tfsdk:"description"`
+	ModelNumber  types.String                                  `tfsdk:"model_number"`
+	CleiCode     types.String                                  `tfsdk:"clei_code"`
+	SubSubModule []chassisInventoryDataSourceBlockModuleDetail `tfsdk:"sub_sub_module"`
+}
+
+type chassisInventoryDataSourceBlockModuleDetail struct {
+	Name         types.String `tfsdk:"name"`
+	Version      types.String `tfsdk:"version"`
+	PartNumber   types.String `tfsdk:"part_number"`
+	SerialNumber types.String `tfsdk:"serial_number"`
+	Description  types.String `tfsdk:"description"`
+	ModelNumber  types.String `tfsdk:"model_number"`
+	CleiCode     types.String `tfsdk:"clei_code"`
+}
+
+func (dsc *chassisInventoryDataSource) Read(
+	ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	junSess, err := dsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	var data chassisInventoryDataSourceData
+	junos.MutexLock()
+	err = data.read(ctx, junSess)
+	junos.MutexUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
+
+		return
+	}
+
+	data.fillID()
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (dscData *chassisInventoryDataSourceData) fillID() {
+	dscData.ID = types.StringValue("chassis_inventory")
+}
+
+func (dscData *chassisInventoryDataSourceData) read(
+	_ context.Context, junSess *junos.Session,
+) error {
+	replyData, err := junSess.CommandXML(junos.RPCGetChassisInventory)
+	if err != nil {
+		return err
+	}
+	var reply junos.GetChassisInventoryReply
+	if strings.Contains(replyData, "<multi-routing-engine-results") {
+		err = xml.Unmarshal([]byte(replyData), &reply.MultiRoutingEngineResults)
+		if err != nil {
+			return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+		}
+		for _, item := range reply.MultiRoutingEngineResults.Item {
+			dscData.appendChassis(strings.TrimSpace(item.REName), item.ChassisInventory)
+		}
+
+		return nil
+	}
+	err = xml.Unmarshal([]byte(replyData), &reply.ChassisInventory)
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	dscData.appendChassis("", reply.ChassisInventory)
+
+	return nil
+}
+
+func (dscData *chassisInventoryDataSourceData) appendChassis(
+	reName string, inventory junos.ChassisInventory,
+) {
+	for _, chassis := range inventory.Chassis {
+		chassisBlock := chassisInventoryDataSourceBlockChassis{
+			REName:       types.StringValue(reName),
+			Name:         types.StringValue(strings.TrimSpace(chassis.Name)),
+			SerialNumber: types.StringValue(strings.TrimSpace(chassis.SerialNumber)),
+			Description:  types.StringValue(strings.TrimSpace(chassis.Description)),
+		}
+		for _, module := range chassis.Module {
+			moduleBlock := chassisInventoryDataSourceBlockChassisBlockModule{
+				Name:         types.StringValue(strings.TrimSpace(module.Name)),
+				Version:      types.StringValue(strings.TrimSpace(module.Version)),
+				PartNumber:   types.StringValue(strings.TrimSpace(module.PartNumber)),
+				SerialNumber: types.StringValue(strings.TrimSpace(module.SerialNumber)),
+				Description:  types.StringValue(strings.TrimSpace(module.Description)),
+				ModelNumber:  types.StringValue(strings.TrimSpace(module.ModelNumber)),
+				CleiCode:     types.StringValue(strings.TrimSpace(module.CleiCode)),
+			}
+			for _, subModule := range module.SubModule {
+				subModuleBlock := chassisInventoryDataSourceBlockChassisBlockModuleBlockSubModule{
+					Name:         types.StringValue(strings.TrimSpace(subModule.Name)),
+					Version:      types.StringValue(strings.TrimSpace(subModule.Version)),
+					PartNumber:   types.StringValue(strings.TrimSpace(subModule.PartNumber)),
+					SerialNumber: types.StringValue(strings.TrimSpace(subModule.SerialNumber)),
+					Description:  types.StringValue(strings.TrimSpace(subModule.Description)),
+					ModelNumber:  types.StringValue(strings.TrimSpace(subModule.ModelNumber)),
+					CleiCode:     types.StringValue(strings.TrimSpace(subModule.CleiCode)),
+				}
+				for _, subSubModule := range subModule.SubSubModule {
+					subModuleBlock.SubSubModule = append(subModuleBlock.SubSubModule,
+						chassisInventoryDataSourceBlockModuleDetail{
+							Name:         types.StringValue(strings.TrimSpace(subSubModule.Name)),
+							Version:      types.StringValue(strings.TrimSpace(subSubModule.Version)),
+							PartNumber:   types.StringValue(strings.TrimSpace(subSubModule.PartNumber)),
+							SerialNumber: types.StringValue(strings.TrimSpace(subSubModule.SerialNumber)),
+							Description:  types.StringValue(strings.TrimSpace(subSubModule.Description)),
+							ModelNumber:  types.StringValue(strings.TrimSpace(subSubModule.ModelNumber)),
+							CleiCode:     types.StringValue(strings.TrimSpace(subSubModule.CleiCode)),
+						},
+					)
+				}
+				moduleBlock.SubModule = append(moduleBlock.SubModule, subModuleBlock)
+			}
+			chassisBlock.Module = append(chassisBlock.Module, moduleBlock)
+		}
+		dscData.Chassis = append(dscData.Chassis, chassisBlock)
+	}
+}
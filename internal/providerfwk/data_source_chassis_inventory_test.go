@@ -0,0 +1,31 @@
+package providerfwk_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceChassisInventory_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceChassisInventoryConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.junos_chassis_inventory.testacc",
+						"chassis.#"),
+					resource.TestCheckResourceAttrSet("data.junos_chassis_inventory.testacc",
+						"chassis.0.description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceChassisInventoryConfig() string {
+	return `
+data "junos_chassis_inventory" "testacc" {}
+`
+}
@@ -0,0 +1,253 @@
+package providerfwk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdata"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &chassisStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &chassisStatusDataSource{}
+)
+
+type chassisStatusDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *chassisStatusDataSource) typeName() string {
+	return providerName + "_chassis_status"
+}
+
+func (dsc *chassisStatusDataSource) junosName() string {
+	return "chassis alarms and environment status"
+}
+
+func newChassisStatusDataSource() datasource.DataSource {
+	return &chassisStatusDataSource{}
+}
+
+func (dsc *chassisStatusDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *chassisStatusDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *chassisStatusDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Get " + dsc.junosName() +
+			" (like `show chassis alarms` and `show chassis environment`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the data source.",
+			},
+			"alarms": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each active chassis alarm.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"re_name":           types.StringType,
+						"time":              types.StringType,
+						"class":             types.StringType,
+						"type":              types.StringType,
+						"description":       types.StringType,
+						"short_description": types.StringType,
+					},
+				},
+			},
+			"environment": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each environment item.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"re_name":             types.StringType,
+						"name":                types.StringType,
+						"class":               types.StringType,
+						"status":              types.StringType,
+						"temperature":         types.StringType,
+						"temperature_celsius": types.Int64Type,
+						"comment":             types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+type chassisStatusDataSourceData struct {
+	ID          types.String                              `tfsdk:"id"`
+	Alarms      []chassisStatusDataSourceBlockAlarms      `tfsdk:"alarms"`
+	Environment []chassisStatusDataSourceBlockEnvironment `tfsdk:"environment"`
+}
+
+type chassisStatusDataSourceBlockAlarms struct {
+	REName           types.String `tfsdk:"re_name"`
+	Time             types.String `tfsdk:"time"`
+	Class            types.String `tfsdk:"class"`
+	Type             types.String `tfsdk:"type"`
+	Description      types.String `tfsdk:"description"`
+	ShortDescription types.String `tfsdk:"short_description"`
+}
+
+type chassisStatusDataSourceBlockEnvironment struct {
+	REName             types.String `tfsdk:"re_name"`
+	Name               types.String `tfsdk:"name"`
+	Class              types.String `tfsdk:"class"`
+	Status             types.String `tfsdk:"status"`
+	Temperature        types.String `tfsdk:"temperature"`
+	TemperatureCelsius types.Int64  `tfsdk:"temperature_celsius"`
+	Comment            types.String `tfsdk:"comment"`
+}
+
+func (dsc *chassisStatusDataSource) Read(
+	ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	junSess, err := dsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	var data chassisStatusDataSourceData
+	junos.MutexLock()
+	err = data.read(ctx, junSess)
+	junos.MutexUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
+
+		return
+	}
+
+	data.fillID()
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (dscData *chassisStatusDataSourceData) fillID() {
+	dscData.ID = types.StringValue("chassis_status")
+}
+
+func (dscData *chassisStatusDataSourceData) read(
+	_ context.Context, junSess *junos.Session,
+) error {
+	replyData, err := junSess.CommandXML(junos.RPCGetAlarmInformation)
+	if err != nil {
+		return err
+	}
+	var replyAlarm junos.GetAlarmInformationReply
+	if strings.Contains(replyData, "<multi-routing-engine-results") {
+		err = xml.Unmarshal([]byte(replyData), &replyAlarm.MultiRoutingEngineResults)
+		if err != nil {
+			return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+		}
+		for _, item := range replyAlarm.MultiRoutingEngineResults.Item {
+			dscData.appendAlarms(strings.TrimSpace(item.REName), item.AlarmInformation)
+		}
+	} else {
+		err = xml.Unmarshal([]byte(replyData), &replyAlarm.AlarmInformation)
+		if err != nil {
+			return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+		}
+		dscData.appendAlarms("", replyAlarm.AlarmInformation)
+	}
+
+	replyData, err = junSess.CommandXML(junos.RPCGetEnvironmentInformation)
+	if err != nil {
+		return err
+	}
+	var replyEnvironment junos.GetEnvironmentInformationReply
+	if strings.Contains(replyData, "<multi-routing-engine-results") {
+		err = xml.Unmarshal([]byte(replyData), &replyEnvironment.MultiRoutingEngineResults)
+		if err != nil {
+			return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+		}
+		for _, item := range replyEnvironment.MultiRoutingEngineResults.Item {
+			err = dscData.appendEnvironment(strings.TrimSpace(item.REName), item.EnvironmentInformation)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		err = xml.Unmarshal([]byte(replyData), &replyEnvironment.EnvironmentInformation)
+		if err != nil {
+			return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+		}
+		err = dscData.appendEnvironment("", replyEnvironment.EnvironmentInformation)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dscData *chassisStatusDataSourceData) appendAlarms(
+	reName string, information junos.AlarmInformation,
+) {
+	for _, alarm := range information.AlarmDetail {
+		dscData.Alarms = append(dscData.Alarms, chassisStatusDataSourceBlockAlarms{
+			REName:           types.StringValue(reName),
+			Time:             types.StringValue(strings.TrimSpace(alarm.AlarmTime)),
+			Class:            types.StringValue(strings.TrimSpace(alarm.AlarmClass)),
+			Type:             types.StringValue(strings.TrimSpace(alarm.AlarmType)),
+			Description:      types.StringValue(strings.TrimSpace(alarm.AlarmDescription)),
+			ShortDescription: types.StringValue(strings.TrimSpace(alarm.AlarmShortDescription)),
+		})
+	}
+}
+
+func (dscData *chassisStatusDataSourceData) appendEnvironment(
+	reName string, information junos.EnvironmentInformation,
+) error {
+	for _, item := range information.EnvironmentItem {
+		environment := chassisStatusDataSourceBlockEnvironment{
+			REName:      types.StringValue(reName),
+			Name:        types.StringValue(strings.TrimSpace(item.Name)),
+			Class:       types.StringValue(strings.TrimSpace(item.Class)),
+			Status:      types.StringValue(strings.TrimSpace(item.Status)),
+			Temperature: types.StringValue(strings.TrimSpace(item.Temperature.Text)),
+			Comment:     types.StringValue(strings.TrimSpace(item.Comment)),
+		}
+		if v := strings.TrimSpace(item.Temperature.Celsius); v != "" {
+			var err error
+			environment.TemperatureCelsius, err = tfdata.ConvAtoi64Value(v)
+			if err != nil {
+				return err
+			}
+		}
+		dscData.Environment = append(dscData.Environment, environment)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package providerfwk_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceChassisStatus_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceChassisStatusConfig(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_chassis_status.testacc",
+							"id", "chassis_status"),
+						resource.TestCheckResourceAttrSet("data.junos_chassis_status.testacc",
+							"environment.#"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccDataSourceChassisStatusConfig() string {
+	return `
+data "junos_chassis_status" "testacc" {}
+`
+}
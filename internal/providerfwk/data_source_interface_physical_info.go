@@ -0,0 +1,406 @@
+package providerfwk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &interfacePhysicalInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &interfacePhysicalInfoDataSource{}
+)
+
+type interfacePhysicalInfoDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *interfacePhysicalInfoDataSource) typeName() string {
+	return providerName + "_interface_physical_info"
+}
+
+func (dsc *interfacePhysicalInfoDataSource) junosName() string {
+	return "detailed information about a physical interface"
+}
+
+func newInterfacePhysicalInfoDataSource() datasource.DataSource {
+	return &interfacePhysicalInfoDataSource{}
+}
+
+func (dsc *interfacePhysicalInfoDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *interfacePhysicalInfoDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *interfacePhysicalInfoDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Get " + dsc.junosName() + " (like `show interfaces extensive` and " +
+			"`show interfaces diagnostics optics`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of interface read.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of physical interface (without dot).",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					tfvalidator.StringFormat(tfvalidator.InterfaceFormat),
+					tfvalidator.StringDotExclusion(),
+				},
+			},
+			"admin_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Admin status.",
+			},
+			"oper_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Operational status.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of interface.",
+			},
+			"speed": schema.StringAttribute{
+				Computed:    true,
+				Description: "Speed of interface.",
+			},
+			"duplex": schema.StringAttribute{
+				Computed:    true,
+				Description: "Duplex mode of interface.",
+			},
+			"mtu": schema.Int64Attribute{
+				Computed:    true,
+				Description: "MTU of interface.",
+			},
+			"mac_address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Current MAC address of interface.",
+			},
+			"hardware_mac_address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hardware MAC address of interface.",
+			},
+			"last_flap": schema.StringAttribute{
+				Computed:    true,
+				Description: "Last flap of interface.",
+			},
+			"last_flap_seconds": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of seconds since last flap of interface.",
+			},
+			"traffic_statistics": schema.ObjectAttribute{
+				Computed:    true,
+				Description: "Traffic statistics.",
+				AttributeTypes: map[string]attr.Type{
+					"input_bytes":    types.Int64Type,
+					"input_bps":      types.Int64Type,
+					"input_packets":  types.Int64Type,
+					"input_pps":      types.Int64Type,
+					"output_bytes":   types.Int64Type,
+					"output_bps":     types.Int64Type,
+					"output_packets": types.Int64Type,
+					"output_pps":     types.Int64Type,
+				},
+			},
+			"input_errors": schema.ObjectAttribute{
+				Computed:    true,
+				Description: "Input error counters.",
+				AttributeTypes: map[string]attr.Type{
+					"errors":          types.Int64Type,
+					"drops":           types.Int64Type,
+					"framing_errors":  types.Int64Type,
+					"runts":           types.Int64Type,
+					"discards":        types.Int64Type,
+					"fifo_errors":     types.Int64Type,
+					"resource_errors": types.Int64Type,
+				},
+			},
+			"output_errors": schema.ObjectAttribute{
+				Computed:    true,
+				Description: "Output error counters.",
+				AttributeTypes: map[string]attr.Type{
+					"carrier_transitions": types.Int64Type,
+					"errors":              types.Int64Type,
+					"drops":               types.Int64Type,
+					"collisions":          types.Int64Type,
+					"mtu_errors":          types.Int64Type,
+					"fifo_errors":         types.Int64Type,
+					"resource_errors":     types.Int64Type,
+				},
+			},
+			"optics": schema.ObjectAttribute{
+				Computed:    true,
+				Description: "Digital optical monitoring readings (when available).",
+				AttributeTypes: map[string]attr.Type{
+					"module_temperature_celsius":      types.Float64Type,
+					"module_voltage":                  types.Float64Type,
+					"laser_bias_current":              types.Float64Type,
+					"laser_output_power_dbm":          types.Float64Type,
+					"rx_signal_avg_optical_power_dbm": types.Float64Type,
+					"lanes": types.ListType{}.WithElementType(types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"index":                      types.Int64Type,
+							"laser_bias_current":         types.Float64Type,
+							"laser_output_power_dbm":     types.Float64Type,
+							"laser_rx_optical_power_dbm": types.Float64Type,
+						},
+					}),
+				},
+			},
+		},
+	}
+}
+
+type interfacePhysicalInfoDataSourceData struct {
+	ID                 types.String                                           `tfsdk:"id"`
+	Name               types.String                                           `tfsdk:"name"`
+	AdminStatus        types.String                                           `tfsdk:"admin_status"`
+	OperStatus         types.String                                           `tfsdk:"oper_status"`
+	Description        types.String                                           `tfsdk:"description"`
+	Speed              types.String                                           `tfsdk:"speed"`
+	Duplex             types.String                                           `tfsdk:"duplex"`
+	Mtu                types.Int64                                            `tfsdk:"mtu"`
+	MACAddress         types.String                                           `tfsdk:"mac_address"`
+	HardwareMACAddress types.String                                           `tfsdk:"hardware_mac_address"`
+	LastFlap           types.String                                           `tfsdk:"last_flap"`
+	LastFlapSeconds    types.Int64                                            `tfsdk:"last_flap_seconds"`
+	TrafficStatistics  *interfacePhysicalInfoDataSourceBlockTrafficStatistics `tfsdk:"traffic_statistics"`
+	InputErrors        *interfacePhysicalInfoDataSourceBlockInputErrors       `tfsdk:"input_errors"`
+	OutputErrors       *interfacePhysicalInfoDataSourceBlockOutputErrors      `tfsdk:"output_errors"`
+	Optics             *interfacePhysicalInfoDataSourceBlockOptics            `tfsdk:"optics"`
+}
+
+type interfacePhysicalInfoDataSourceBlockTrafficStatistics struct {
+	InputBytes    types.Int64 `tfsdk:"input_bytes"`
+	InputBps      types.Int64 `tfsdk:"input_bps"`
+	InputPackets  types.Int64 `tfsdk:"input_packets"`
+	InputPps      types.Int64 `tfsdk:"input_pps"`
+	OutputBytes   types.Int64 `tfsdk:"output_bytes"`
+	OutputBps     types.Int64 `tfsdk:"output_bps"`
+	OutputPackets types.Int64 `tfsdk:"output_packets"`
+	OutputPps     types.Int64 `tfsdk:"output_pps"`
+}
+
+type interfacePhysicalInfoDataSourceBlockInputErrors struct {
+	Errors         types.Int64 `tfsdk:"errors"`
+	Drops          types.Int64 `tfsdk:"drops"`
+	FramingErrors  types.Int64 `tfsdk:"framing_errors"`
+	Runts          types.Int64 `tfsdk:"runts"`
+	Discards       types.Int64 `tfsdk:"discards"`
+	FifoErrors     types.Int64 `tfsdk:"fifo_errors"`
+	ResourceErrors types.Int64 `tfsdk:"resource_errors"`
+}
+
+type interfacePhysicalInfoDataSourceBlockOutputErrors struct {
+	CarrierTransitions types.Int64 `tfsdk:"carrier_transitions"`
+	Errors             types.Int64 `tfsdk:"errors"`
+	Drops              types.Int64 `tfsdk:"drops"`
+	Collisions         types.Int64 `tfsdk:"collisions"`
+	MtuErrors          types.Int64 `tfsdk:"mtu_errors"`
+	FifoErrors         types.Int64 `tfsdk:"fifo_errors"`
+	ResourceErrors     types.Int64 `tfsdk:"resource_errors"`
+}
+
+type interfacePhysicalInfoDataSourceBlockOptics struct {
+	ModuleTemperatureCelsius   types.Float64                                     `tfsdk:"module_temperature_celsius"`
+	ModuleVoltage              types.Float64                                     `tfsdk:"module_voltage"`
+	LaserBiasCurrent           types.Float64                                     `tfsdk:"laser_bias_current"`
+	LaserOutputPowerDbm        types.Float64                                     `tfsdk:"laser_output_power_dbm"`
+	RxSignalAvgOpticalPowerDbm types.Float64                                     `tfsdk:"rx_signal_avg_optical_power_dbm"`
+	Lanes                      []interfacePhysicalInfoDataSourceBlockOpticsLanes `tfsdk:"lanes"`
+}
+
+type interfacePhysicalInfoDataSourceBlockOpticsLanes struct {
+	Index                  types.Int64   `tfsdk:"index"`
+	LaserBiasCurrent       types.Float64 `tfsdk:"laser_bias_current"`
+	LaserOutputPowerDbm    types.Float64 `tfsdk:"laser_output_power_dbm"`
+	LaserRxOpticalPowerDbm types.Float64 `tfsdk:"laser_rx_optical_power_dbm"`
+}
+
+func (dsc *interfacePhysicalInfoDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	var name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	junSess, err := dsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	var data interfacePhysicalInfoDataSourceData
+	junos.MutexLock()
+	err = data.read(ctx, name.ValueString(), junSess)
+	junos.MutexUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
+
+		return
+	}
+
+	data.fillID()
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (dscData *interfacePhysicalInfoDataSourceData) fillID() {
+	dscData.ID = types.StringValue(dscData.Name.ValueString())
+}
+
+func (dscData *interfacePhysicalInfoDataSourceData) read(
+	_ context.Context, name string, junSess *junos.Session,
+) error {
+	replyData, err := junSess.CommandXML(fmt.Sprintf(junos.RPCGetInterfaceInformationExtensive, name))
+	if err != nil {
+		return err
+	}
+	var iface junos.GetPhysicalInterfaceExtensiveReply
+	err = xml.Unmarshal([]byte(replyData), &iface.InterfaceInfo)
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	if len(iface.InterfaceInfo.PhysicalInterface) == 0 {
+		return fmt.Errorf("physical-interface not found in xml: %v", replyData)
+	}
+	ifaceInfo := iface.InterfaceInfo.PhysicalInterface[0]
+	dscData.Name = types.StringValue(strings.TrimSpace(ifaceInfo.Name))
+	dscData.AdminStatus = types.StringValue(strings.TrimSpace(ifaceInfo.AdminStatus))
+	dscData.OperStatus = types.StringValue(strings.TrimSpace(ifaceInfo.OperStatus))
+	dscData.Description = types.StringValue(strings.TrimSpace(ifaceInfo.Description))
+	dscData.Speed = types.StringValue(strings.TrimSpace(ifaceInfo.Speed))
+	if v := strings.TrimSpace(ifaceInfo.Duplex); v != "" {
+		dscData.Duplex = types.StringValue(v)
+	} else {
+		dscData.Duplex = types.StringValue(strings.TrimSpace(ifaceInfo.LinkMode))
+	}
+	dscData.Mtu = interfacePhysicalInfoConvInt64(ifaceInfo.Mtu)
+	dscData.MACAddress = types.StringValue(strings.TrimSpace(ifaceInfo.CurrentPhysicalAddress))
+	dscData.HardwareMACAddress = types.StringValue(strings.TrimSpace(ifaceInfo.HardwarePhysicalAddress))
+	dscData.LastFlap = types.StringValue(strings.TrimSpace(ifaceInfo.InterfaceFlapped.Text))
+	dscData.LastFlapSeconds = interfacePhysicalInfoConvInt64(ifaceInfo.InterfaceFlapped.Seconds)
+	dscData.TrafficStatistics = &interfacePhysicalInfoDataSourceBlockTrafficStatistics{
+		InputBytes:    interfacePhysicalInfoConvInt64(ifaceInfo.TrafficStatistics.InputBytes),
+		InputBps:      interfacePhysicalInfoConvInt64(ifaceInfo.TrafficStatistics.InputBps),
+		InputPackets:  interfacePhysicalInfoConvInt64(ifaceInfo.TrafficStatistics.InputPackets),
+		InputPps:      interfacePhysicalInfoConvInt64(ifaceInfo.TrafficStatistics.InputPps),
+		OutputBytes:   interfacePhysicalInfoConvInt64(ifaceInfo.TrafficStatistics.OutputBytes),
+		OutputBps:     interfacePhysicalInfoConvInt64(ifaceInfo.TrafficStatistics.OutputBps),
+		OutputPackets: interfacePhysicalInfoConvInt64(ifaceInfo.TrafficStatistics.OutputPackets),
+		OutputPps:     interfacePhysicalInfoConvInt64(ifaceInfo.TrafficStatistics.OutputPps),
+	}
+	dscData.InputErrors = &interfacePhysicalInfoDataSourceBlockInputErrors{
+		Errors:         interfacePhysicalInfoConvInt64(ifaceInfo.InputErrorList.InputErrors),
+		Drops:          interfacePhysicalInfoConvInt64(ifaceInfo.InputErrorList.InputDrops),
+		FramingErrors:  interfacePhysicalInfoConvInt64(ifaceInfo.InputErrorList.FramingErrors),
+		Runts:          interfacePhysicalInfoConvInt64(ifaceInfo.InputErrorList.InputRunts),
+		Discards:       interfacePhysicalInfoConvInt64(ifaceInfo.InputErrorList.InputDiscards),
+		FifoErrors:     interfacePhysicalInfoConvInt64(ifaceInfo.InputErrorList.InputFifoErrors),
+		ResourceErrors: interfacePhysicalInfoConvInt64(ifaceInfo.InputErrorList.InputResourceErrors),
+	}
+	dscData.OutputErrors = &interfacePhysicalInfoDataSourceBlockOutputErrors{
+		CarrierTransitions: interfacePhysicalInfoConvInt64(ifaceInfo.OutputErrorList.CarrierTransitions),
+		Errors:             interfacePhysicalInfoConvInt64(ifaceInfo.OutputErrorList.OutputErrors),
+		Drops:              interfacePhysicalInfoConvInt64(ifaceInfo.OutputErrorList.OutputDrops),
+		Collisions:         interfacePhysicalInfoConvInt64(ifaceInfo.OutputErrorList.OutputCollisions),
+		MtuErrors:          interfacePhysicalInfoConvInt64(ifaceInfo.OutputErrorList.MtuErrors),
+		FifoErrors:         interfacePhysicalInfoConvInt64(ifaceInfo.OutputErrorList.OutputFifoErrors),
+		ResourceErrors:     interfacePhysicalInfoConvInt64(ifaceInfo.OutputErrorList.OutputResourceErrors),
+	}
+
+	replyData, err = junSess.CommandXML(fmt.Sprintf(junos.RPCGetInterfaceOpticsDiagnostics, name))
+	if err != nil {
+		return err
+	}
+	var optics junos.GetInterfaceOpticsDiagnosticsReply
+	err = xml.Unmarshal([]byte(replyData), &optics.InterfaceInfo)
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	for _, opticsIface := range optics.InterfaceInfo.PhysicalInterface {
+		diag := opticsIface.OpticsDiagnostics
+		if diag == nil {
+			continue
+		}
+		dscData.Optics = &interfacePhysicalInfoDataSourceBlockOptics{
+			ModuleTemperatureCelsius:   interfacePhysicalInfoConvFloat64(diag.ModuleTemperature.Celsius),
+			ModuleVoltage:              interfacePhysicalInfoConvFloat64(diag.ModuleVoltage),
+			LaserBiasCurrent:           interfacePhysicalInfoConvFloat64(diag.LaserBiasCurrent),
+			LaserOutputPowerDbm:        interfacePhysicalInfoConvFloat64(diag.LaserOutputPowerDbm),
+			RxSignalAvgOpticalPowerDbm: interfacePhysicalInfoConvFloat64(diag.RxSignalAvgOpticalPowerDbm),
+		}
+		for _, lane := range diag.OpticsDiagnosticsLaneValues {
+			dscData.Optics.Lanes = append(dscData.Optics.Lanes, interfacePhysicalInfoDataSourceBlockOpticsLanes{
+				Index:                  interfacePhysicalInfoConvInt64(lane.LaneIndex),
+				LaserBiasCurrent:       interfacePhysicalInfoConvFloat64(lane.LaserBiasCurrent),
+				LaserOutputPowerDbm:    interfacePhysicalInfoConvFloat64(lane.LaserOutputPowerDbm),
+				LaserRxOpticalPowerDbm: interfacePhysicalInfoConvFloat64(lane.LaserRxOpticalPowerDbm),
+			})
+		}
+
+		break
+	}
+
+	return nil
+}
+
+// interfacePhysicalInfoConvInt64 return null when str is empty or not a number.
+func interfacePhysicalInfoConvInt64(str string) types.Int64 {
+	v, err := utils.ConvAtoi64(strings.TrimSpace(str))
+	if err != nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(v)
+}
+
+// interfacePhysicalInfoConvFloat64 return null when str is empty or not a number (like `- Inf`).
+func interfacePhysicalInfoConvFloat64(str string) types.Float64 {
+	v, err := strconv.ParseFloat(strings.TrimSpace(str), 64)
+	if err != nil || math.IsInf(v, 0) || math.IsNaN(v) {
+		return types.Float64Null()
+	}
+
+	return types.Float64Value(v)
+}
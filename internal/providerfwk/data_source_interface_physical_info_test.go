@@ -0,0 +1,68 @@
+package providerfwk_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// export TESTACC_INTERFACE=<inteface> for choose interface available else it's ge-0/0/3.
+func TestAccDataSourceInterfacePhysicalInfo_basic(t *testing.T) {
+	testaccInterface := junos.DefaultInterfaceTestAcc
+	if iface := os.Getenv("TESTACC_INTERFACE"); iface != "" {
+		testaccInterface = iface
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceInterfacePhysicalInfoPre(testaccInterface),
+			},
+			{
+				Config: testAccDataSourceInterfacePhysicalInfoConfig(testaccInterface),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.junos_interface_physical_info.testacc_dataIfacePhyInfo",
+						"id", testaccInterface),
+					resource.TestCheckResourceAttr("data.junos_interface_physical_info.testacc_dataIfacePhyInfo",
+						"admin_status", "up"),
+					resource.TestCheckResourceAttr("data.junos_interface_physical_info.testacc_dataIfacePhyInfo",
+						"description", "testacc_dataIfacePhyInfo"),
+					resource.TestCheckResourceAttrSet("data.junos_interface_physical_info.testacc_dataIfacePhyInfo",
+						"mtu"),
+					resource.TestCheckResourceAttrSet("data.junos_interface_physical_info.testacc_dataIfacePhyInfo",
+						"traffic_statistics.input_packets"),
+					resource.TestCheckResourceAttrSet("data.junos_interface_physical_info.testacc_dataIfacePhyInfo",
+						"input_errors.errors"),
+					resource.TestCheckResourceAttrSet("data.junos_interface_physical_info.testacc_dataIfacePhyInfo",
+						"output_errors.carrier_transitions"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceInterfacePhysicalInfoPre(interFace string) string {
+	return fmt.Sprintf(`
+resource "junos_interface_physical" "testacc_dataIfacePhyInfo" {
+  name        = "%s"
+  description = "testacc_dataIfacePhyInfo"
+}
+`, interFace)
+}
+
+func testAccDataSourceInterfacePhysicalInfoConfig(interFace string) string {
+	return fmt.Sprintf(`
+resource "junos_interface_physical" "testacc_dataIfacePhyInfo" {
+  name        = "%s"
+  description = "testacc_dataIfacePhyInfo"
+}
+data "junos_interface_physical_info" "testacc_dataIfacePhyInfo" {
+  name = junos_interface_physical.testacc_dataIfacePhyInfo.name
+}
+`, interFace)
+}
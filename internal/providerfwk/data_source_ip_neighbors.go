@@ -0,0 +1,329 @@
+package providerfwk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	balt "github.com/jeremmfr/go-utils/basicalter"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ipNeighborsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ipNeighborsDataSource{}
+)
+
+type ipNeighborsDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *ipNeighborsDataSource) typeName() string {
+	return providerName + "_ip_neighbors"
+}
+
+func (dsc *ipNeighborsDataSource) junosName() string {
+	return "arp and ipv6 neighbor tables"
+}
+
+func newIPNeighborsDataSource() datasource.DataSource {
+	return &ipNeighborsDataSource{}
+}
+
+func (dsc *ipNeighborsDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *ipNeighborsDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *ipNeighborsDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Get " + dsc.junosName() + " (like `show arp` and `show ipv6 neighbors`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the data source.",
+			},
+			"interface": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only read neighbors on this logical interface.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					tfvalidator.StringFormat(tfvalidator.InterfaceFormat),
+					tfvalidator.String1DotCount(),
+				},
+			},
+			"routing_instance": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only read neighbors on interfaces in this routing instance.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+			"address": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only read neighbor with this IP address.",
+				Validators: []validator.String{
+					tfvalidator.StringIPAddress(),
+				},
+			},
+			"neighbors": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each neighbor found.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"family":           types.StringType,
+						"ip_address":       types.StringType,
+						"mac_address":      types.StringType,
+						"interface":        types.StringType,
+						"routing_instance": types.StringType,
+						"expire":           types.Int64Type,
+						"state":            types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+type ipNeighborsDataSourceData struct {
+	ID              types.String                         `tfsdk:"id"`
+	Interface       types.String                         `tfsdk:"interface"`
+	RoutingInstance types.String                         `tfsdk:"routing_instance"`
+	Address         types.String                         `tfsdk:"address"`
+	Neighbors       []ipNeighborsDataSourceBlockNeighbor `tfsdk:"neighbors"`
+}
+
+type ipNeighborsDataSourceConfig struct {
+	ID              types.String `tfsdk:"id"`
+	Interface       types.String `tfsdk:"interface"`
+	RoutingInstance types.String `tfsdk:"routing_instance"`
+	Address         types.String `tfsdk:"address"`
+	Neighbors       types.List   `tfsdk:"neighbors"`
+}
+
+type ipNeighborsDataSourceBlockNeighbor struct {
+	Family          types.String `tfsdk:"family"`
+	IPAddress       types.String `tfsdk:"ip_address"`
+	MacAddress      types.String `tfsdk:"mac_address"`
+	Interface       types.String `tfsdk:"interface"`
+	RoutingInstance types.String `tfsdk:"routing_instance"`
+	Expire          types.Int64  `tfsdk:"expire"`
+	State           types.String `tfsdk:"state"`
+}
+
+func (dsc *ipNeighborsDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	var config ipNeighborsDataSourceConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	junSess, err := dsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	var data ipNeighborsDataSourceData
+	junos.MutexLock()
+	err = data.read(ctx, config, junSess)
+	junos.MutexUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
+
+		return
+	}
+
+	data.fillIDAndConfigArgument(config)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (dscData *ipNeighborsDataSourceData) fillIDAndConfigArgument(
+	config ipNeighborsDataSourceConfig,
+) {
+	dscData.Interface = config.Interface
+	dscData.RoutingInstance = config.RoutingInstance
+	dscData.Address = config.Address
+	dscData.ID = types.StringValue(
+		"interface=" + config.Interface.ValueString() + junos.IDSeparator +
+			"routing_instance=" + config.RoutingInstance.ValueString() + junos.IDSeparator +
+			"address=" + config.Address.ValueString(),
+	)
+}
+
+func (dscData *ipNeighborsDataSourceData) read(
+	_ context.Context,
+	config ipNeighborsDataSourceConfig,
+	junSess *junos.Session,
+) error {
+	interfacesRoutingInstance, err := ipNeighborsReadInterfacesRoutingInstance(junSess)
+	if err != nil {
+		return err
+	}
+	var filterAddress net.IP
+	if v := config.Address.ValueString(); v != "" {
+		filterAddress = net.ParseIP(v)
+	}
+	rpcArgs := ""
+	if v := config.Interface.ValueString(); v != "" {
+		rpcArgs += "<interface>" + v + "</interface>"
+	}
+	if filterAddress == nil || filterAddress.To4() != nil {
+		replyData, err := junSess.CommandXML(fmt.Sprintf(junos.RPCGetArpTableInformation, rpcArgs))
+		if err != nil {
+			return err
+		}
+		var reply junos.GetArpTableInformationReply
+		err = xml.Unmarshal([]byte(replyData), &reply.ArpTableInfo)
+		if err != nil {
+			return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+		}
+		for _, entry := range reply.ArpTableInfo.ArpTableEntry {
+			dscData.appendNeighbor(config, filterAddress, interfacesRoutingInstance,
+				ipNeighborsDataSourceBlockNeighbor{
+					Family:     types.StringValue(junos.InetW),
+					IPAddress:  types.StringValue(strings.TrimSpace(entry.IPAddress)),
+					MacAddress: types.StringValue(strings.TrimSpace(entry.MacAddress)),
+					Interface:  types.StringValue(ipNeighborsTrimInterface(entry.InterfaceName)),
+					Expire:     ipNeighborsConvExpire(entry.TimeToExpire),
+					State:      types.StringValue(""),
+				},
+			)
+		}
+	}
+	if filterAddress == nil || filterAddress.To4() == nil {
+		replyData, err := junSess.CommandXML(fmt.Sprintf(junos.RPCGetIPv6NdInformation, rpcArgs))
+		if err != nil {
+			return err
+		}
+		var reply junos.GetIPv6NdInformationReply
+		err = xml.Unmarshal([]byte(replyData), &reply.IPv6NdInfo)
+		if err != nil {
+			return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+		}
+		for _, entry := range reply.IPv6NdInfo.IPv6NdEntry {
+			dscData.appendNeighbor(config, filterAddress, interfacesRoutingInstance,
+				ipNeighborsDataSourceBlockNeighbor{
+					Family:     types.StringValue(junos.Inet6W),
+					IPAddress:  types.StringValue(strings.TrimSpace(entry.NeighborAddress)),
+					MacAddress: types.StringValue(strings.TrimSpace(entry.NeighborL2Address)),
+					Interface:  types.StringValue(ipNeighborsTrimInterface(entry.InterfaceName)),
+					Expire:     ipNeighborsConvExpire(entry.Expire),
+					State:      types.StringValue(strings.TrimSpace(entry.State)),
+				},
+			)
+		}
+	}
+
+	return nil
+}
+
+func (dscData *ipNeighborsDataSourceData) appendNeighbor(
+	config ipNeighborsDataSourceConfig,
+	filterAddress net.IP,
+	interfacesRoutingInstance map[string]string,
+	neighbor ipNeighborsDataSourceBlockNeighbor,
+) {
+	if v := config.Interface.ValueString(); v != "" && v != neighbor.Interface.ValueString() {
+		return
+	}
+	if filterAddress != nil && !filterAddress.Equal(net.ParseIP(neighbor.IPAddress.ValueString())) {
+		return
+	}
+	routingInstance := junos.DefaultW
+	if v, ok := interfacesRoutingInstance[neighbor.Interface.ValueString()]; ok {
+		routingInstance = v
+	}
+	if v := config.RoutingInstance.ValueString(); v != "" && v != routingInstance {
+		return
+	}
+	neighbor.RoutingInstance = types.StringValue(routingInstance)
+	dscData.Neighbors = append(dscData.Neighbors, neighbor)
+}
+
+// ipNeighborsReadInterfacesRoutingInstance read configuration to map interfaces with their routing instance.
+func ipNeighborsReadInterfacesRoutingInstance(
+	junSess *junos.Session,
+) (
+	map[string]string, error,
+) {
+	interfacesRoutingInstance := make(map[string]string)
+	showConfig, err := junSess.Command(junos.CmdShowConfig +
+		"routing-instances" + junos.PipeDisplaySetRelative)
+	if err != nil {
+		return interfacesRoutingInstance, err
+	}
+	if showConfig == junos.EmptyW {
+		return interfacesRoutingInstance, nil
+	}
+	for _, item := range strings.Split(showConfig, "\n") {
+		if strings.Contains(item, junos.XMLStartTagConfigOut) {
+			continue
+		}
+		if strings.Contains(item, junos.XMLEndTagConfigOut) {
+			break
+		}
+		itemTrim := strings.TrimPrefix(item, junos.SetLS)
+		routingInstance, itemTrimInstance, ok := strings.Cut(itemTrim, " ")
+		if !ok {
+			continue
+		}
+		if balt.CutPrefixInString(&itemTrimInstance, "interface ") {
+			interfacesRoutingInstance[strings.Trim(itemTrimInstance, "\"")] = routingInstance
+		}
+	}
+
+	return interfacesRoutingInstance, nil
+}
+
+// ipNeighborsTrimInterface remove the underlying interface added in brackets by Junos (irb.10 [ae0.0]).
+func ipNeighborsTrimInterface(interFace string) string {
+	interFace, _, _ = strings.Cut(strings.TrimSpace(interFace), " ")
+
+	return interFace
+}
+
+func ipNeighborsConvExpire(expire string) types.Int64 {
+	v, err := utils.ConvAtoi64(strings.TrimSpace(expire))
+	if err != nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(v)
+}
@@ -0,0 +1,46 @@
+package providerfwk_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceIPNeighbors_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceIPNeighborsConfig(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet("data.junos_ip_neighbors.testacc_all",
+							"neighbors.#"),
+						resource.TestCheckResourceAttr("data.junos_ip_neighbors.testacc_ri",
+							"neighbors.#", "0"),
+						resource.TestCheckResourceAttr("data.junos_ip_neighbors.testacc_address",
+							"neighbors.#", "0"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccDataSourceIPNeighborsConfig() string {
+	return `
+resource "junos_routing_instance" "testacc_ipNeighbors" {
+  name = "testacc_ipNeighbors"
+}
+data "junos_ip_neighbors" "testacc_all" {}
+data "junos_ip_neighbors" "testacc_ri" {
+  routing_instance = junos_routing_instance.testacc_ipNeighbors.name
+}
+data "junos_ip_neighbors" "testacc_address" {
+  routing_instance = "default"
+  address          = "192.0.2.250"
+}
+`
+}
@@ -0,0 +1,203 @@
+package providerfwk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &isisAdjacenciesDataSource{}
+	_ datasource.DataSourceWithConfigure = &isisAdjacenciesDataSource{}
+)
+
+type isisAdjacenciesDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *isisAdjacenciesDataSource) typeName() string {
+	return providerName + "_isis_adjacencies"
+}
+
+func (dsc *isisAdjacenciesDataSource) junosName() string {
+	return "operational state of isis adjacencies"
+}
+
+func newIsisAdjacenciesDataSource() datasource.DataSource {
+	return &isisAdjacenciesDataSource{}
+}
+
+func (dsc *isisAdjacenciesDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *isisAdjacenciesDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *isisAdjacenciesDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Get " + dsc.junosName() + " (like `show isis adjacency`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the data source.",
+			},
+			"routing_instance": schema.StringAttribute{
+				Optional:    true,
+				Description: "Routing instance (`default` if not set).",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+			"adjacencies": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each isis adjacency found.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"interface":    types.StringType,
+						"system_name":  types.StringType,
+						"level":        types.StringType,
+						"state":        types.StringType,
+						"hold_time":    types.Int64Type,
+						"snpa":         types.StringType,
+						"ip_address":   types.StringType,
+						"ipv6_address": types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+type isisAdjacenciesDataSourceData struct {
+	ID              types.String                              `tfsdk:"id"`
+	RoutingInstance types.String                              `tfsdk:"routing_instance"`
+	Adjacencies     []isisAdjacenciesDataSourceBlockAdjacency `tfsdk:"adjacencies"`
+}
+
+type isisAdjacenciesDataSourceConfig struct {
+	ID              types.String `tfsdk:"id"`
+	RoutingInstance types.String `tfsdk:"routing_instance"`
+	Adjacencies     types.List   `tfsdk:"adjacencies"`
+}
+
+type isisAdjacenciesDataSourceBlockAdjacency struct {
+	Interface   types.String `tfsdk:"interface"`
+	SystemName  types.String `tfsdk:"system_name"`
+	Level       types.String `tfsdk:"level"`
+	State       types.String `tfsdk:"state"`
+	HoldTime    types.Int64  `tfsdk:"hold_time"`
+	Snpa        types.String `tfsdk:"snpa"`
+	IPAddress   types.String `tfsdk:"ip_address"`
+	IPv6Address types.String `tfsdk:"ipv6_address"`
+}
+
+func (dsc *isisAdjacenciesDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	var config isisAdjacenciesDataSourceConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	junSess, err := dsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	var data isisAdjacenciesDataSourceData
+	junos.MutexLock()
+	err = data.read(ctx, config, junSess)
+	junos.MutexUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
+
+		return
+	}
+
+	data.fillIDAndConfigArgument(config)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (dscData *isisAdjacenciesDataSourceData) fillIDAndConfigArgument(
+	config isisAdjacenciesDataSourceConfig,
+) {
+	dscData.RoutingInstance = config.RoutingInstance
+	routingInstance := junos.DefaultW
+	if v := config.RoutingInstance.ValueString(); v != "" {
+		routingInstance = v
+	}
+	dscData.ID = types.StringValue("routing_instance=" + routingInstance)
+}
+
+func (dscData *isisAdjacenciesDataSourceData) read(
+	_ context.Context,
+	config isisAdjacenciesDataSourceConfig,
+	junSess *junos.Session,
+) error {
+	rpcArgs := "<detail/>"
+	if v := config.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
+		rpcArgs += "<instance>" + v + "</instance>"
+	}
+	replyData, err := junSess.CommandXML(fmt.Sprintf(junos.RPCGetIsisAdjacencyInformation, rpcArgs))
+	if err != nil {
+		return err
+	}
+	var reply junos.GetIsisAdjacencyInformationReply
+	if err := xml.Unmarshal([]byte(replyData), &reply.IsisAdjacencyInformation); err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	for _, adjacency := range reply.IsisAdjacencyInformation.IsisAdjacency {
+		holdTime := types.Int64Null()
+		if v, err := utils.ConvAtoi64(strings.TrimSpace(adjacency.Holdtime)); err == nil {
+			holdTime = types.Int64Value(v)
+		}
+		dscData.Adjacencies = append(dscData.Adjacencies, isisAdjacenciesDataSourceBlockAdjacency{
+			Interface:   types.StringValue(strings.TrimSpace(adjacency.InterfaceName)),
+			SystemName:  types.StringValue(strings.TrimSpace(adjacency.SystemName)),
+			Level:       types.StringValue(strings.TrimSpace(adjacency.Level)),
+			State:       types.StringValue(strings.TrimSpace(adjacency.AdjacencyState)),
+			HoldTime:    holdTime,
+			Snpa:        types.StringValue(strings.TrimSpace(adjacency.Snpa)),
+			IPAddress:   types.StringValue(strings.TrimSpace(adjacency.IPAddress)),
+			IPv6Address: types.StringValue(strings.TrimSpace(adjacency.IPv6Address)),
+		})
+	}
+
+	return nil
+}
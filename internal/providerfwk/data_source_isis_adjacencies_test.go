@@ -0,0 +1,34 @@
+package providerfwk_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceIsisAdjacencies_basic(t *testing.T) {
+	if os.Getenv("TESTACC_ROUTER") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceIsisAdjacenciesConfig(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_isis_adjacencies.testacc",
+							"id", "routing_instance=default"),
+						resource.TestCheckResourceAttrSet("data.junos_isis_adjacencies.testacc",
+							"adjacencies.#"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccDataSourceIsisAdjacenciesConfig() string {
+	return `
+data "junos_isis_adjacencies" "testacc" {}
+`
+}
@@ -0,0 +1,244 @@
+package providerfwk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	bchk "github.com/jeremmfr/go-utils/basiccheck"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &lldpNeighborsDataSource{}
+	_ datasource.DataSourceWithConfigure = &lldpNeighborsDataSource{}
+)
+
+type lldpNeighborsDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *lldpNeighborsDataSource) typeName() string {
+	return providerName + "_lldp_neighbors"
+}
+
+func (dsc *lldpNeighborsDataSource) junosName() string {
+	return "lldp neighbors"
+}
+
+func newLldpNeighborsDataSource() datasource.DataSource {
+	return &lldpNeighborsDataSource{}
+}
+
+func (dsc *lldpNeighborsDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *lldpNeighborsDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *lldpNeighborsDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Get " + dsc.junosName() + " (like `show lldp neighbors`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the data source.",
+			},
+			"interface": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only read neighbors on this local interface.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					tfvalidator.StringFormat(tfvalidator.InterfaceFormat),
+				},
+			},
+			"neighbors": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each lldp neighbor found.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"local_interface":        types.StringType,
+						"local_parent_interface": types.StringType,
+						"chassis_id":             types.StringType,
+						"chassis_id_subtype":     types.StringType,
+						"port_id":                types.StringType,
+						"port_id_subtype":        types.StringType,
+						"port_description":       types.StringType,
+						"system_name":            types.StringType,
+						"management_address":     types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+type lldpNeighborsDataSourceData struct {
+	ID        types.String                           `tfsdk:"id"`
+	Interface types.String                           `tfsdk:"interface"`
+	Neighbors []lldpNeighborsDataSourceBlockNeighbor `tfsdk:"neighbors"`
+}
+
+type lldpNeighborsDataSourceConfig struct {
+	ID        types.String `tfsdk:"id"`
+	Interface types.String `tfsdk:"interface"`
+	Neighbors types.List   `tfsdk:"neighbors"`
+}
+
+type lldpNeighborsDataSourceBlockNeighbor struct {
+	LocalInterface       types.String `tfsdk:"local_interface"`
+	LocalParentInterface types.String `tfsdk:"local_parent_interface"`
+	ChassisID            types.String `tfsdk:"chassis_id"`
+	ChassisIDSubtype     types.String `tfsdk:"chassis_id_subtype"`
+	PortID               types.String `tfsdk:"port_id"`
+	PortIDSubtype        types.String `tfsdk:"port_id_subtype"`
+	PortDescription      types.String `tfsdk:"port_description"`
+	SystemName           types.String `tfsdk:"system_name"`
+	ManagementAddress    types.String `tfsdk:"management_address"`
+}
+
+func (dsc *lldpNeighborsDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	var config lldpNeighborsDataSourceConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	junSess, err := dsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	var data lldpNeighborsDataSourceData
+	junos.MutexLock()
+	err = data.read(ctx, config, junSess)
+	junos.MutexUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
+
+		return
+	}
+
+	data.fillIDAndConfigArgument(config)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (dscData *lldpNeighborsDataSourceData) fillIDAndConfigArgument(
+	config lldpNeighborsDataSourceConfig,
+) {
+	dscData.Interface = config.Interface
+	dscData.ID = types.StringValue("interface=" + config.Interface.ValueString())
+}
+
+func (dscData *lldpNeighborsDataSourceData) read(
+	_ context.Context,
+	config lldpNeighborsDataSourceConfig,
+	junSess *junos.Session,
+) error {
+	if v := config.Interface.ValueString(); v != "" {
+		return dscData.readInterface(v, junSess)
+	}
+	// summary doesn't have management address, so read details of each local interface
+	replyData, err := junSess.CommandXML(junos.RPCGetLldpNeighbors)
+	if err != nil {
+		return err
+	}
+	var reply junos.GetLldpNeighborsReply
+	err = xml.Unmarshal([]byte(replyData), &reply.LldpNeighborsInformation)
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	localInterfaces := make([]string, 0)
+	for _, neighbor := range reply.LldpNeighborsInformation.LldpNeighborInformation {
+		localInterface := strings.TrimSpace(neighbor.LocalPortID)
+		if localInterface == "" {
+			localInterface = strings.TrimSpace(neighbor.LocalInterface)
+		}
+		if localInterface == "" {
+			continue
+		}
+		if !bchk.InSlice(localInterface, localInterfaces) {
+			localInterfaces = append(localInterfaces, localInterface)
+		}
+	}
+	for _, localInterface := range localInterfaces {
+		if err := dscData.readInterface(localInterface, junSess); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dscData *lldpNeighborsDataSourceData) readInterface(
+	localInterface string, junSess *junos.Session,
+) error {
+	replyData, err := junSess.CommandXML(fmt.Sprintf(junos.RPCGetLldpInterfaceNeighbors, localInterface))
+	if err != nil {
+		return err
+	}
+	var reply junos.GetLldpNeighborsReply
+	err = xml.Unmarshal([]byte(replyData), &reply.LldpNeighborsInformation)
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	for _, neighbor := range reply.LldpNeighborsInformation.LldpNeighborInformation {
+		neighborLocalInterface := strings.TrimSpace(neighbor.LocalInterface)
+		if neighborLocalInterface == "" {
+			neighborLocalInterface = strings.TrimSpace(neighbor.LocalPortID)
+		}
+		if neighborLocalInterface == "" {
+			neighborLocalInterface = localInterface
+		}
+		localParentInterface := strings.TrimSpace(neighbor.LocalParentInterface)
+		if localParentInterface == "-" {
+			localParentInterface = ""
+		}
+		dscData.Neighbors = append(dscData.Neighbors, lldpNeighborsDataSourceBlockNeighbor{
+			LocalInterface:       types.StringValue(neighborLocalInterface),
+			LocalParentInterface: types.StringValue(localParentInterface),
+			ChassisID:            types.StringValue(strings.TrimSpace(neighbor.RemoteChassisID)),
+			ChassisIDSubtype:     types.StringValue(strings.TrimSpace(neighbor.RemoteChassisIDSubtype)),
+			PortID:               types.StringValue(strings.TrimSpace(neighbor.RemotePortID)),
+			PortIDSubtype:        types.StringValue(strings.TrimSpace(neighbor.RemotePortIDSubtype)),
+			PortDescription:      types.StringValue(strings.TrimSpace(neighbor.RemotePortDescription)),
+			SystemName:           types.StringValue(strings.TrimSpace(neighbor.RemoteSystemName)),
+			ManagementAddress:    types.StringValue(strings.TrimSpace(neighbor.RemoteManagementAddress)),
+		})
+	}
+
+	return nil
+}
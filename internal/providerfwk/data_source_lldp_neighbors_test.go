@@ -0,0 +1,56 @@
+package providerfwk_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// export TESTACC_INTERFACE=<inteface> for choose interface available else it's ge-0/0/3.
+func TestAccDataSourceLldpNeighbors_basic(t *testing.T) {
+	testaccInterface := junos.DefaultInterfaceTestAcc
+	if iface := os.Getenv("TESTACC_INTERFACE"); iface != "" {
+		testaccInterface = iface
+	}
+	if os.Getenv("TESTACC_SWITCH") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceLldpNeighborsConfig(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_lldp_neighbors.testacc_all",
+							"id", "interface="),
+						resource.TestCheckResourceAttrSet("data.junos_lldp_neighbors.testacc_all",
+							"neighbors.#"),
+						resource.TestCheckResourceAttr("data.junos_lldp_neighbors.testacc_interface",
+							"id", "interface="+testaccInterface),
+						resource.TestCheckResourceAttrSet("data.junos_lldp_neighbors.testacc_interface",
+							"neighbors.#"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccDataSourceLldpNeighborsConfig(interFace string) string {
+	return fmt.Sprintf(`
+resource "junos_lldp_interface" "testacc_lldpNeighbors" {
+  name = "%s"
+}
+data "junos_lldp_neighbors" "testacc_all" {
+  depends_on = [
+    junos_lldp_interface.testacc_lldpNeighbors,
+  ]
+}
+data "junos_lldp_neighbors" "testacc_interface" {
+  interface = junos_lldp_interface.testacc_lldpNeighbors.name
+}
+`, interFace)
+}
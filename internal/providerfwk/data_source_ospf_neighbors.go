@@ -0,0 +1,299 @@
+package providerfwk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ospfNeighborsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ospfNeighborsDataSource{}
+)
+
+type ospfNeighborsDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *ospfNeighborsDataSource) typeName() string {
+	return providerName + "_ospf_neighbors"
+}
+
+func (dsc *ospfNeighborsDataSource) junosName() string {
+	return "operational state of ospf neighbors and interfaces"
+}
+
+func newOspfNeighborsDataSource() datasource.DataSource {
+	return &ospfNeighborsDataSource{}
+}
+
+func (dsc *ospfNeighborsDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *ospfNeighborsDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *ospfNeighborsDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Get " + dsc.junosName() + " (like `show ospf neighbor` and `show ospf interface`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the data source.",
+			},
+			"version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Version of ospf (`v2` if not set).",
+				Validators: []validator.String{
+					stringvalidator.OneOf("v2", "v3"),
+				},
+			},
+			"routing_instance": schema.StringAttribute{
+				Optional:    true,
+				Description: "Routing instance (`default` if not set).",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+			"neighbors": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each ospf neighbor found.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"address":     types.StringType,
+						"neighbor_id": types.StringType,
+						"interface":   types.StringType,
+						"state":       types.StringType,
+						"priority":    types.Int64Type,
+						"area":        types.StringType,
+						"dr":          types.StringType,
+						"bdr":         types.StringType,
+					},
+				},
+			},
+			"interfaces": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each ospf interface found.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name":           types.StringType,
+						"state":          types.StringType,
+						"area":           types.StringType,
+						"dr_id":          types.StringType,
+						"bdr_id":         types.StringType,
+						"neighbor_count": types.Int64Type,
+					},
+				},
+			},
+		},
+	}
+}
+
+type ospfNeighborsDataSourceData struct {
+	ID              types.String                            `tfsdk:"id"`
+	Version         types.String                            `tfsdk:"version"`
+	RoutingInstance types.String                            `tfsdk:"routing_instance"`
+	Neighbors       []ospfNeighborsDataSourceBlockNeighbor  `tfsdk:"neighbors"`
+	Interfaces      []ospfNeighborsDataSourceBlockInterface `tfsdk:"interfaces"`
+}
+
+type ospfNeighborsDataSourceConfig struct {
+	ID              types.String `tfsdk:"id"`
+	Version         types.String `tfsdk:"version"`
+	RoutingInstance types.String `tfsdk:"routing_instance"`
+	Neighbors       types.List   `tfsdk:"neighbors"`
+	Interfaces      types.List   `tfsdk:"interfaces"`
+}
+
+type ospfNeighborsDataSourceBlockNeighbor struct {
+	Address    types.String `tfsdk:"address"`
+	NeighborID types.String `tfsdk:"neighbor_id"`
+	Interface  types.String `tfsdk:"interface"`
+	State      types.String `tfsdk:"state"`
+	Priority   types.Int64  `tfsdk:"priority"`
+	Area       types.String `tfsdk:"area"`
+	Dr         types.String `tfsdk:"dr"`
+	Bdr        types.String `tfsdk:"bdr"`
+}
+
+type ospfNeighborsDataSourceBlockInterface struct {
+	Name          types.String `tfsdk:"name"`
+	State         types.String `tfsdk:"state"`
+	Area          types.String `tfsdk:"area"`
+	DrID          types.String `tfsdk:"dr_id"`
+	BdrID         types.String `tfsdk:"bdr_id"`
+	NeighborCount types.Int64  `tfsdk:"neighbor_count"`
+}
+
+func (dsc *ospfNeighborsDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	var config ospfNeighborsDataSourceConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	junSess, err := dsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	var data ospfNeighborsDataSourceData
+	junos.MutexLock()
+	err = data.read(ctx, config, junSess)
+	junos.MutexUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
+
+		return
+	}
+
+	data.fillIDAndConfigArgument(config)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (dscData *ospfNeighborsDataSourceData) fillIDAndConfigArgument(
+	config ospfNeighborsDataSourceConfig,
+) {
+	dscData.Version = config.Version
+	dscData.RoutingInstance = config.RoutingInstance
+	version := "v2"
+	if v := config.Version.ValueString(); v != "" {
+		version = v
+	}
+	routingInstance := junos.DefaultW
+	if v := config.RoutingInstance.ValueString(); v != "" {
+		routingInstance = v
+	}
+	dscData.ID = types.StringValue(
+		"version=" + version + junos.IDSeparator + "routing_instance=" + routingInstance,
+	)
+}
+
+func (dscData *ospfNeighborsDataSourceData) read(
+	_ context.Context,
+	config ospfNeighborsDataSourceConfig,
+	junSess *junos.Session,
+) error {
+	rpcArgs := "<detail/>"
+	if v := config.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
+		rpcArgs += "<instance>" + v + "</instance>"
+	}
+	rpcNeighbor := junos.RPCGetOspfNeighborInformation
+	rpcInterface := junos.RPCGetOspfInterfaceInformation
+	if config.Version.ValueString() == "v3" {
+		rpcNeighbor = junos.RPCGetOspf3NeighborInformation
+		rpcInterface = junos.RPCGetOspf3InterfaceInformation
+	}
+
+	replyData, err := junSess.CommandXML(fmt.Sprintf(rpcNeighbor, rpcArgs))
+	if err != nil {
+		return err
+	}
+	var replyNeighbor junos.GetOspfNeighborInformationReply
+	neighbors := make([]junos.OspfNeighbor, 0)
+	if config.Version.ValueString() == "v3" {
+		err = xml.Unmarshal([]byte(replyData), &replyNeighbor.Ospf3NeighborInformation)
+		neighbors = append(neighbors, replyNeighbor.Ospf3NeighborInformation.Ospf3Neighbor...)
+	} else {
+		err = xml.Unmarshal([]byte(replyData), &replyNeighbor.OspfNeighborInformation)
+		neighbors = append(neighbors, replyNeighbor.OspfNeighborInformation.OspfNeighbor...)
+	}
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	for _, neighbor := range neighbors {
+		dr := strings.TrimSpace(neighbor.DrAddress)
+		if dr == "" {
+			dr = strings.TrimSpace(neighbor.DrID)
+		}
+		bdr := strings.TrimSpace(neighbor.BdrAddress)
+		if bdr == "" {
+			bdr = strings.TrimSpace(neighbor.BdrID)
+		}
+		dscData.Neighbors = append(dscData.Neighbors, ospfNeighborsDataSourceBlockNeighbor{
+			Address:    types.StringValue(strings.TrimSpace(neighbor.NeighborAddress)),
+			NeighborID: types.StringValue(strings.TrimSpace(neighbor.NeighborID)),
+			Interface:  types.StringValue(strings.TrimSpace(neighbor.InterfaceName)),
+			State:      types.StringValue(strings.TrimSpace(neighbor.OspfNeighborState)),
+			Priority:   ospfNeighborsConvInt64(neighbor.NeighborPriority),
+			Area:       types.StringValue(strings.TrimSpace(neighbor.OspfArea)),
+			Dr:         types.StringValue(dr),
+			Bdr:        types.StringValue(bdr),
+		})
+	}
+
+	replyData, err = junSess.CommandXML(fmt.Sprintf(rpcInterface, rpcArgs))
+	if err != nil {
+		return err
+	}
+	var replyInterface junos.GetOspfInterfaceInformationReply
+	interfaces := make([]junos.OspfInterface, 0)
+	if config.Version.ValueString() == "v3" {
+		err = xml.Unmarshal([]byte(replyData), &replyInterface.Ospf3InterfaceInformation)
+		interfaces = append(interfaces, replyInterface.Ospf3InterfaceInformation.Ospf3Interface...)
+	} else {
+		err = xml.Unmarshal([]byte(replyData), &replyInterface.OspfInterfaceInformation)
+		interfaces = append(interfaces, replyInterface.OspfInterfaceInformation.OspfInterface...)
+	}
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	for _, iface := range interfaces {
+		dscData.Interfaces = append(dscData.Interfaces, ospfNeighborsDataSourceBlockInterface{
+			Name:          types.StringValue(strings.TrimSpace(iface.InterfaceName)),
+			State:         types.StringValue(strings.TrimSpace(iface.OspfInterfaceState)),
+			Area:          types.StringValue(strings.TrimSpace(iface.OspfArea)),
+			DrID:          types.StringValue(strings.TrimSpace(iface.DrID)),
+			BdrID:         types.StringValue(strings.TrimSpace(iface.BdrID)),
+			NeighborCount: ospfNeighborsConvInt64(iface.NeighborCount),
+		})
+	}
+
+	return nil
+}
+
+func ospfNeighborsConvInt64(str string) types.Int64 {
+	v, err := utils.ConvAtoi64(strings.TrimSpace(str))
+	if err != nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(v)
+}
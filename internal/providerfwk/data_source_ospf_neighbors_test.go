@@ -0,0 +1,84 @@
+package providerfwk_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// export TESTACC_INTERFACE=<inteface> for choose interface available else it's ge-0/0/3.
+func TestAccDataSourceOspfNeighbors_basic(t *testing.T) {
+	testaccInterface := junos.DefaultInterfaceTestAcc
+	if iface := os.Getenv("TESTACC_INTERFACE"); iface != "" {
+		testaccInterface = iface
+	}
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceOspfNeighborsConfig(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_ospf_neighbors.testacc_v2",
+							"id", "version=v2_-_routing_instance=default"),
+						resource.TestCheckResourceAttr("data.junos_ospf_neighbors.testacc_v2",
+							"interfaces.#", "1"),
+						resource.TestCheckResourceAttr("data.junos_ospf_neighbors.testacc_v2",
+							"interfaces.0.name", testaccInterface+".0"),
+						resource.TestCheckResourceAttr("data.junos_ospf_neighbors.testacc_v2",
+							"interfaces.0.area", "0.0.0.0"),
+						resource.TestCheckResourceAttr("data.junos_ospf_neighbors.testacc_v3",
+							"id", "version=v3_-_routing_instance=testacc_ospfNeighbors"),
+						resource.TestCheckResourceAttrSet("data.junos_ospf_neighbors.testacc_v3",
+							"neighbors.#"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccDataSourceOspfNeighborsConfig(interFace string) string {
+	return fmt.Sprintf(`
+resource "junos_interface_logical" "testacc_ospfNeighbors" {
+  name = "%s.0"
+  family_inet {
+    address {
+      cidr_ip = "192.0.2.1/30"
+    }
+  }
+}
+resource "junos_ospf_area" "testacc_ospfNeighbors" {
+  area_id = "0.0.0.0"
+  interface {
+    name = junos_interface_logical.testacc_ospfNeighbors.name
+  }
+}
+resource "junos_routing_instance" "testacc_ospfNeighbors" {
+  name = "testacc_ospfNeighbors"
+}
+resource "junos_ospf_area" "testacc_ospfNeighbors_v3" {
+  area_id          = "0.0.0.0"
+  version          = "v3"
+  routing_instance = junos_routing_instance.testacc_ospfNeighbors.name
+  interface {
+    name    = "all"
+    passive = true
+  }
+}
+data "junos_ospf_neighbors" "testacc_v2" {
+  depends_on = [
+    junos_ospf_area.testacc_ospfNeighbors,
+  ]
+}
+data "junos_ospf_neighbors" "testacc_v3" {
+  version          = "v3"
+  routing_instance = junos_ospf_area.testacc_ospfNeighbors_v3.routing_instance
+}
+`, interFace)
+}
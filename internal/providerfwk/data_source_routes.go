@@ -0,0 +1,437 @@
+package providerfwk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &routesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &routesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &routesDataSource{}
+)
+
+type routesDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *routesDataSource) typeName() string {
+	return providerName + "_routes"
+}
+
+func (dsc *routesDataSource) junosName() string {
+	return "routes"
+}
+
+func newRoutesDataSource() datasource.DataSource {
+	return &routesDataSource{}
+}
+
+func (dsc *routesDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *routesDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *routesDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Get " + dsc.junosName() + " in all routing tables or a selected routing table.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the data source.",
+			},
+			"table_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Get routes only on a specific routing table with the name.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					tfvalidator.StringDoubleQuoteExclusion(),
+				},
+			},
+			"destination": schema.StringAttribute{
+				Optional:    true,
+				Description: "Get routes only for this destination prefix (or IP address).",
+				Validators: []validator.String{
+					stringvalidator.Any(
+						tfvalidator.StringCIDR(),
+						tfvalidator.StringIPAddress(),
+					),
+				},
+			},
+			"destination_match": schema.StringAttribute{
+				Optional:    true,
+				Description: "Type of match for `destination`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("best", "exact", "longer"),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				Optional:    true,
+				Description: "Get routes only learned from this protocol.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"access", "access-internal", "aggregate", "arp", "bgp", "direct", "dvmrp", "esis",
+						"evpn", "flow", "frr", "isis", "l2circuit", "l2vpn", "ldp", "local", "mpls", "msdp",
+						"ospf", "ospf2", "ospf3", "pim", "rift", "rip", "ripng", "route-target", "rsvp",
+						"spring-te", "static", "static-srte", "tunnel", "vpls",
+					),
+				},
+			},
+			"next_hop": schema.StringAttribute{
+				Optional:    true,
+				Description: "Get routes only with this next hop.",
+				Validators: []validator.String{
+					tfvalidator.StringIPAddress(),
+				},
+			},
+			"community": schema.StringAttribute{
+				Optional:    true,
+				Description: "Get routes only with this BGP community.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9a-zA-Z_.:*^$|+?()\[\]-]+$`),
+						"must be a community (or a regular expression of community) "+
+							"with only letters, numbers and characters _.:*^$|+?()[]-"),
+				},
+			},
+			"active_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Get only active routes.",
+				Validators: []validator.Bool{
+					tfvalidator.BoolTrue(),
+				},
+			},
+			"detail": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Read routes with details to get `communities`, `validation_state` and `mpls_label`.",
+				Validators: []validator.Bool{
+					tfvalidator.BoolTrue(),
+				},
+			},
+			"table": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each routing table.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": types.StringType,
+						"route": types.ListType{}.WithElementType(types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"destination": types.StringType,
+								"entry": types.ListType{}.WithElementType(types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"age":              types.StringType,
+										"age_seconds":      types.Int64Type,
+										"as_path":          types.StringType,
+										"communities":      types.ListType{}.WithElementType(types.StringType),
+										"current_active":   types.BoolType,
+										"local_preference": types.Int64Type,
+										"metric":           types.Int64Type,
+										"next_hop": types.ListType{}.WithElementType(types.ObjectType{
+											AttrTypes: map[string]attr.Type{
+												"local_interface":   types.StringType,
+												"mpls_label":        types.StringType,
+												"selected_next_hop": types.BoolType,
+												"to":                types.StringType,
+												"via":               types.StringType,
+											},
+										}),
+										"next_hop_type":    types.StringType,
+										"preference":       types.Int64Type,
+										"protocol":         types.StringType,
+										"validation_state": types.StringType,
+									},
+								}),
+							},
+						}),
+					},
+				},
+			},
+		},
+	}
+}
+
+type routesDataSourceData struct {
+	ID               types.String                 `tfsdk:"id"`
+	TableName        types.String                 `tfsdk:"table_name"`
+	Destination      types.String                 `tfsdk:"destination"`
+	DestinationMatch types.String                 `tfsdk:"destination_match"`
+	Protocol         types.String                 `tfsdk:"protocol"`
+	NextHop          types.String                 `tfsdk:"next_hop"`
+	Community        types.String                 `tfsdk:"community"`
+	ActiveOnly       types.Bool                   `tfsdk:"active_only"`
+	Detail           types.Bool                   `tfsdk:"detail"`
+	Table            []routesDataSourceBlockTable `tfsdk:"table"`
+}
+
+type routesDataSourceConfig struct {
+	ID               types.String `tfsdk:"id"`
+	TableName        types.String `tfsdk:"table_name"`
+	Destination      types.String `tfsdk:"destination"`
+	DestinationMatch types.String `tfsdk:"destination_match"`
+	Protocol         types.String `tfsdk:"protocol"`
+	NextHop          types.String `tfsdk:"next_hop"`
+	Community        types.String `tfsdk:"community"`
+	ActiveOnly       types.Bool   `tfsdk:"active_only"`
+	Detail           types.Bool   `tfsdk:"detail"`
+	Table            types.List   `tfsdk:"table"`
+}
+
+type routesDataSourceBlockTable struct {
+	Name  types.String                           `tfsdk:"name"`
+	Route []routesDataSourceBlockTableBlockRoute `tfsdk:"route"`
+}
+
+type routesDataSourceBlockTableBlockRoute struct {
+	Destination types.String                                     `tfsdk:"destination"`
+	Entry       []routesDataSourceBlockTableBlockRouteBlockEntry `tfsdk:"entry"`
+}
+
+type routesDataSourceBlockTableBlockRouteBlockEntry struct {
+	Age             types.String                                                 `tfsdk:"age"`
+	AgeSeconds      types.Int64                                                  `tfsdk:"age_seconds"`
+	ASPath          types.String                                                 `tfsdk:"as_path"`
+	Communities     []types.String                                               `tfsdk:"communities"`
+	CurrentActive   types.Bool                                                   `tfsdk:"current_active"`
+	LocalPreference types.Int64                                                  `tfsdk:"local_preference"`
+	Metric          types.Int64                                                  `tfsdk:"metric"`
+	NextHop         []routesDataSourceBlockTableBlockRouteBlockEntryBlockNextHop `tfsdk:"next_hop"`
+	NextHopType     types.String                                                 `tfsdk:"next_hop_type"`
+	Preference      types.Int64                                                  `tfsdk:"preference"`
+	Protocol        types.String                                                 `tfsdk:"protocol"`
+	ValidationState types.String                                                 `tfsdk:"validation_state"`
+}
+
+type routesDataSourceBlockTableBlockRouteBlockEntryBlockNextHop struct {
+	LocalInterface  types.String `tfsdk:"local_interface"`
+	MplsLabel       types.String `tfsdk:"mpls_label"`
+	SelectedNextHop types.Bool   `tfsdk:"selected_next_hop"`
+	To              types.String `tfsdk:"to"`
+	Via             types.String `tfsdk:"via"`
+}
+
+func (dsc *routesDataSource) ValidateConfig(
+	ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse,
+) {
+	var config routesDataSourceConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.DestinationMatch.IsNull() && config.Destination.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("destination_match"),
+			tfdiag.MissingConfigErrSummary,
+			"destination must be specified with destination_match",
+		)
+	}
+}
+
+func (dsc *routesDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	var config routesDataSourceConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	junSess, err := dsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	var data routesDataSourceData
+	junos.MutexLock()
+	err = data.read(ctx, config, junSess)
+	junos.MutexUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
+
+		return
+	}
+
+	data.fillIDAndConfigArgument(config)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (dscData *routesDataSourceData) fillIDAndConfigArgument(
+	config routesDataSourceConfig,
+) {
+	dscData.TableName = config.TableName
+	dscData.Destination = config.Destination
+	dscData.DestinationMatch = config.DestinationMatch
+	dscData.Protocol = config.Protocol
+	dscData.NextHop = config.NextHop
+	dscData.Community = config.Community
+	dscData.ActiveOnly = config.ActiveOnly
+	dscData.Detail = config.Detail
+
+	idString := "all"
+	if v := config.TableName.ValueString(); v != "" {
+		idString = v
+	}
+	for _, filter := range []struct {
+		key   string
+		value string
+	}{
+		{"destination", config.Destination.ValueString()},
+		{"destination_match", config.DestinationMatch.ValueString()},
+		{"protocol", config.Protocol.ValueString()},
+		{"next_hop", config.NextHop.ValueString()},
+		{"community", config.Community.ValueString()},
+	} {
+		if filter.value != "" {
+			idString += junos.IDSeparator + filter.key + "=" + filter.value
+		}
+	}
+	if config.ActiveOnly.ValueBool() {
+		idString += junos.IDSeparator + "active_only"
+	}
+	if config.Detail.ValueBool() {
+		idString += junos.IDSeparator + "detail"
+	}
+	dscData.ID = types.StringValue(idString)
+}
+
+func (dscData *routesDataSourceData) read(
+	_ context.Context,
+	config routesDataSourceConfig,
+	junSess *junos.Session,
+) error {
+	// values are escaped as they are in XML elements of the RPC
+	rpcArgs := ""
+	if v := config.Destination.ValueString(); v != "" {
+		rpcArgs += "<destination>" + html.EscapeString(v) + "</destination>"
+		if v2 := config.DestinationMatch.ValueString(); v2 != "" {
+			rpcArgs += "<" + v2 + "/>"
+		}
+	}
+	if v := config.Protocol.ValueString(); v != "" {
+		rpcArgs += "<protocol>" + html.EscapeString(v) + "</protocol>"
+	}
+	if v := config.NextHop.ValueString(); v != "" {
+		rpcArgs += "<next-hop>" + html.EscapeString(v) + "</next-hop>"
+	}
+	if v := config.Community.ValueString(); v != "" {
+		rpcArgs += "<community>" + html.EscapeString(v) + "</community>"
+	}
+	if config.ActiveOnly.ValueBool() {
+		rpcArgs += "<active-path/>"
+	}
+	if rpcArgs == "" {
+		// without filter, read also hidden and inactive routes
+		rpcArgs = "<all/>"
+	}
+	if v := config.TableName.ValueString(); v != "" {
+		rpcArgs += "<table>" + html.EscapeString(v) + "</table>"
+	}
+	if config.Detail.ValueBool() {
+		rpcArgs += "<detail/>"
+	}
+	replyData, err := junSess.CommandXML(fmt.Sprintf(junos.RPCGetRouteInformation, rpcArgs))
+	if err != nil {
+		return err
+	}
+	var reply junos.GetRouteInformationReply
+	err = xml.Unmarshal([]byte(replyData), &reply.RouteInfo)
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	for _, tableInfo := range reply.RouteInfo.RouteTable {
+		table := routesDataSourceBlockTable{
+			Name:  types.StringValue(tableInfo.TableName),
+			Route: make([]routesDataSourceBlockTableBlockRoute, 0, len(tableInfo.Route)),
+		}
+		for _, routeInfo := range tableInfo.Route {
+			route := routesDataSourceBlockTableBlockRoute{
+				Destination: types.StringValue(routeInfo.Destination),
+				Entry:       make([]routesDataSourceBlockTableBlockRouteBlockEntry, 0, len(routeInfo.Entry)),
+			}
+			for _, entryInfo := range routeInfo.Entry {
+				entry := routesDataSourceBlockTableBlockRouteBlockEntry{
+					Age:             types.StringValue(strings.TrimSpace(entryInfo.Age.Text)),
+					AgeSeconds:      types.Int64Null(),
+					ASPath:          types.StringValue(routesTrimASPath(entryInfo.ASPath)),
+					Communities:     make([]types.String, 0, len(entryInfo.Communities)),
+					CurrentActive:   types.BoolValue(entryInfo.CurrentActive != nil),
+					LocalPreference: types.Int64Value(int64(entryInfo.LocalPreference)),
+					Metric:          types.Int64Value(int64(entryInfo.Metric)),
+					NextHop: make(
+						[]routesDataSourceBlockTableBlockRouteBlockEntryBlockNextHop, 0, len(entryInfo.NextHop),
+					),
+					NextHopType:     types.StringValue(entryInfo.NextHopType),
+					Preference:      types.Int64Value(int64(entryInfo.Preference)),
+					Protocol:        types.StringValue(entryInfo.Protocol),
+					ValidationState: types.StringValue(strings.TrimSpace(entryInfo.ValidationState)),
+				}
+				if v, err := utils.ConvAtoi64(strings.TrimSpace(entryInfo.Age.Seconds)); err == nil {
+					entry.AgeSeconds = types.Int64Value(v)
+				}
+				for _, community := range entryInfo.Communities {
+					entry.Communities = append(entry.Communities, types.StringValue(strings.TrimSpace(community)))
+				}
+				for _, nextHopInfo := range entryInfo.NextHop {
+					entry.NextHop = append(entry.NextHop, routesDataSourceBlockTableBlockRouteBlockEntryBlockNextHop{
+						LocalInterface:  types.StringValue(nextHopInfo.LocalInterface),
+						MplsLabel:       types.StringValue(strings.TrimSpace(nextHopInfo.MplsLabel)),
+						SelectedNextHop: types.BoolValue(nextHopInfo.SelectedNextHop != nil),
+						To:              types.StringValue(nextHopInfo.To),
+						Via:             types.StringValue(nextHopInfo.Via),
+					})
+				}
+				route.Entry = append(route.Entry, entry)
+			}
+			table.Route = append(table.Route, route)
+		}
+		dscData.Table = append(dscData.Table, table)
+	}
+
+	return nil
+}
+
+// routesTrimASPath remove the newline and the prefix 'AS path: ' added by Junos with detail output.
+func routesTrimASPath(asPath string) string {
+	asPath, _, _ = strings.Cut(strings.Trim(asPath, "\n"), "\n")
+
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(asPath), "AS path:"))
+}
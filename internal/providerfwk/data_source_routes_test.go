@@ -1,4 +1,4 @@
-package providersdk_test
+package providerfwk_test
 
 import (
 	"fmt"
@@ -7,7 +7,7 @@ import (
 
 	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccDataSourceRoutes_basic(t *testing.T) {
@@ -52,6 +52,14 @@ func TestAccDataSourceRoutes_basic(t *testing.T) {
 							"table.0.route.0.entry.0.current_active", "true"),
 						resource.TestCheckResourceAttr("data.junos_routes.testacc",
 							"table.0.route.0.entry.0.protocol", "Local"),
+						resource.TestCheckResourceAttr("data.junos_routes.filter",
+							"table.#", "1"),
+						resource.TestCheckResourceAttr("data.junos_routes.filter",
+							"table.0.route.#", "1"),
+						resource.TestCheckResourceAttr("data.junos_routes.filter",
+							"table.0.route.0.destination", "192.0.2.1/32"),
+						resource.TestCheckResourceAttr("data.junos_routes.filter",
+							"table.0.route.0.entry.0.current_active", "true"),
 					),
 				},
 			},
@@ -106,5 +114,13 @@ data "junos_routes" "default" {
 data "junos_routes" "testacc" {
   table_name = "${junos_routing_instance.testacc_data_routes.name}.inet.0"
 }
+data "junos_routes" "filter" {
+  table_name        = "${junos_routing_instance.testacc_data_routes.name}.inet.0"
+  destination       = "192.0.2.1/32"
+  destination_match = "exact"
+  protocol          = "local"
+  active_only       = true
+  detail            = true
+}
 `, interFace)
 }
@@ -0,0 +1,350 @@
+package providerfwk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	balt "github.com/jeremmfr/go-utils/basicalter"
+	bchk "github.com/jeremmfr/go-utils/basiccheck"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &securityIpsecVpnInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &securityIpsecVpnInfoDataSource{}
+)
+
+type securityIpsecVpnInfoDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *securityIpsecVpnInfoDataSource) typeName() string {
+	return providerName + "_security_ipsec_vpn_info"
+}
+
+func (dsc *securityIpsecVpnInfoDataSource) junosName() string {
+	return "operational state of ike and ipsec security associations"
+}
+
+func newSecurityIpsecVpnInfoDataSource() datasource.DataSource {
+	return &securityIpsecVpnInfoDataSource{}
+}
+
+func (dsc *securityIpsecVpnInfoDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *securityIpsecVpnInfoDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *securityIpsecVpnInfoDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Get " + dsc.junosName() +
+			" (like `show security ike security-associations detail` and " +
+			"`show security ipsec security-associations detail`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the data source.",
+			},
+			"vpn_name": schema.StringAttribute{
+				Optional: true,
+				Description: "Only read security associations of this IPsec VPN " +
+					"(IKE security associations are filtered with IKE gateway used by VPN).",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+					tfvalidator.StringDoubleQuoteExclusion(),
+				},
+			},
+			"ike_security_associations": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each IKE (phase 1) security association found.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"index":            types.StringType,
+						"gateway_name":     types.StringType,
+						"remote_address":   types.StringType,
+						"state":            types.StringType,
+						"initiator_cookie": types.StringType,
+						"responder_cookie": types.StringType,
+						"mode":             types.StringType,
+					},
+				},
+			},
+			"ipsec_security_associations": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each IPsec (phase 2) security association found (one by direction).",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"vpn_name":       types.StringType,
+						"tunnel_index":   types.Int64Type,
+						"remote_gateway": types.StringType,
+						"bind_interface": types.StringType,
+						"state":          types.StringType,
+						"direction":      types.StringType,
+						"spi":            types.StringType,
+						"protocol":       types.StringType,
+						"lifetime":       types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+type securityIpsecVpnInfoDataSourceData struct {
+	ID                        types.String                                 `tfsdk:"id"`
+	VpnName                   types.String                                 `tfsdk:"vpn_name"`
+	IkeSecurityAssociations   []securityIpsecVpnInfoDataSourceBlockIkeSA   `tfsdk:"ike_security_associations"`
+	IpsecSecurityAssociations []securityIpsecVpnInfoDataSourceBlockIpsecSA `tfsdk:"ipsec_security_associations"`
+}
+
+type securityIpsecVpnInfoDataSourceConfig struct {
+	ID                        types.String `tfsdk:"id"`
+	VpnName                   types.String `tfsdk:"vpn_name"`
+	IkeSecurityAssociations   types.List   `tfsdk:"ike_security_associations"`
+	IpsecSecurityAssociations types.List   `tfsdk:"ipsec_security_associations"`
+}
+
+type securityIpsecVpnInfoDataSourceBlockIkeSA struct {
+	Index           types.String `tfsdk:"index"`
+	GatewayName     types.String `tfsdk:"gateway_name"`
+	RemoteAddress   types.String `tfsdk:"remote_address"`
+	State           types.String `tfsdk:"state"`
+	InitiatorCookie types.String `tfsdk:"initiator_cookie"`
+	ResponderCookie types.String `tfsdk:"responder_cookie"`
+	Mode            types.String `tfsdk:"mode"`
+}
+
+type securityIpsecVpnInfoDataSourceBlockIpsecSA struct {
+	VpnName       types.String `tfsdk:"vpn_name"`
+	TunnelIndex   types.Int64  `tfsdk:"tunnel_index"`
+	RemoteGateway types.String `tfsdk:"remote_gateway"`
+	BindInterface types.String `tfsdk:"bind_interface"`
+	State         types.String `tfsdk:"state"`
+	Direction     types.String `tfsdk:"direction"`
+	Spi           types.String `tfsdk:"spi"`
+	Protocol      types.String `tfsdk:"protocol"`
+	Lifetime      types.String `tfsdk:"lifetime"`
+}
+
+func (dsc *securityIpsecVpnInfoDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	var config securityIpsecVpnInfoDataSourceConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	junSess, err := dsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+	if !junSess.CheckCompatibilitySecurity() {
+		resp.Diagnostics.AddError(
+			tfdiag.CompatibilityErrSummary,
+			fmt.Sprintf(dsc.typeName()+" data source not compatible "+
+				"with Junos device %q", junSess.SystemInformation.HardwareModel),
+		)
+
+		return
+	}
+
+	var data securityIpsecVpnInfoDataSourceData
+	junos.MutexLock()
+	err = data.read(ctx, config, junSess)
+	junos.MutexUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
+
+		return
+	}
+
+	data.fillIDAndConfigArgument(config)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (dscData *securityIpsecVpnInfoDataSourceData) fillIDAndConfigArgument(
+	config securityIpsecVpnInfoDataSourceConfig,
+) {
+	dscData.VpnName = config.VpnName
+	dscData.ID = types.StringValue("vpn_name=" + config.VpnName.ValueString())
+}
+
+func (dscData *securityIpsecVpnInfoDataSourceData) read(
+	_ context.Context,
+	config securityIpsecVpnInfoDataSourceConfig,
+	junSess *junos.Session,
+) error {
+	vpnName := config.VpnName.ValueString()
+	var gateway string
+	var gatewayAddresses []string
+	if vpnName != "" {
+		var err error
+		gateway, gatewayAddresses, err = securityIpsecVpnInfoReadGateway(vpnName, junSess)
+		if err != nil {
+			return err
+		}
+	}
+
+	replyData, err := junSess.CommandXML(junos.RPCGetIkeSecurityAssociationsDetail)
+	if err != nil {
+		return err
+	}
+	var replyIke junos.GetIkeSecurityAssociationsReply
+	err = xml.Unmarshal([]byte(replyData), &replyIke.IkeSecurityAssociationsInformation)
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	ikeSAs := make([]junos.IkeSecurityAssociation, 0)
+	ikeSAs = append(ikeSAs, replyIke.IkeSecurityAssociationsInformation.IkeSecurityAssociationsBlock...)
+	ikeSAs = append(ikeSAs, replyIke.IkeSecurityAssociationsInformation.IkeSecurityAssociations...)
+	for _, ikeSA := range ikeSAs {
+		gatewayName := strings.TrimSpace(ikeSA.GatewayName)
+		remoteAddress := strings.TrimSpace(ikeSA.RemoteAddress)
+		if vpnName != "" {
+			// match with the gateway name (dynamic and hostname peers included)
+			// and only fallback to the gateway addresses when the name is not in the reply
+			if gatewayName != "" && gatewayName != gateway {
+				continue
+			}
+			if gatewayName == "" && !bchk.InSlice(remoteAddress, gatewayAddresses) {
+				continue
+			}
+		}
+		dscData.IkeSecurityAssociations = append(dscData.IkeSecurityAssociations,
+			securityIpsecVpnInfoDataSourceBlockIkeSA{
+				Index:           types.StringValue(strings.TrimSpace(ikeSA.Index)),
+				GatewayName:     types.StringValue(gatewayName),
+				RemoteAddress:   types.StringValue(remoteAddress),
+				State:           types.StringValue(strings.TrimSpace(ikeSA.State)),
+				InitiatorCookie: types.StringValue(strings.TrimSpace(ikeSA.InitiatorCookie)),
+				ResponderCookie: types.StringValue(strings.TrimSpace(ikeSA.ResponderCookie)),
+				Mode:            types.StringValue(strings.TrimSpace(ikeSA.ExchangeType)),
+			},
+		)
+	}
+
+	replyData, err = junSess.CommandXML(junos.RPCGetSecurityAssociationsDetail)
+	if err != nil {
+		return err
+	}
+	var replyIpsec junos.GetSecurityAssociationsReply
+	err = xml.Unmarshal([]byte(replyData), &replyIpsec.IPsecSecurityAssociationsInformation)
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	for _, block := range replyIpsec.IPsecSecurityAssociationsInformation.IPsecSecurityAssociationsBlock {
+		blockVpnName := strings.TrimSpace(block.VpnName)
+		if vpnName != "" && vpnName != blockVpnName {
+			continue
+		}
+		tunnelIndex := types.Int64Null()
+		if v, err := utils.ConvAtoi64(strings.TrimSpace(block.TunnelIndex)); err == nil {
+			tunnelIndex = types.Int64Value(v)
+		}
+		for _, ipsecSA := range block.IPsecSecurityAssociations {
+			state := strings.TrimSpace(ipsecSA.State)
+			if state == "" {
+				state = strings.TrimSpace(block.BlockState)
+			}
+			dscData.IpsecSecurityAssociations = append(dscData.IpsecSecurityAssociations,
+				securityIpsecVpnInfoDataSourceBlockIpsecSA{
+					VpnName:       types.StringValue(blockVpnName),
+					TunnelIndex:   tunnelIndex,
+					RemoteGateway: types.StringValue(strings.TrimSpace(block.RemoteGateway)),
+					BindInterface: types.StringValue(strings.TrimSpace(block.BindInterface)),
+					State:         types.StringValue(state),
+					Direction:     types.StringValue(strings.TrimSpace(ipsecSA.Direction)),
+					Spi:           types.StringValue(strings.TrimSpace(ipsecSA.Spi)),
+					Protocol:      types.StringValue(strings.TrimSpace(ipsecSA.Protocol)),
+					Lifetime:      types.StringValue(strings.TrimSpace(ipsecSA.HardLifetime)),
+				},
+			)
+		}
+	}
+
+	return nil
+}
+
+// securityIpsecVpnInfoReadGateway read configuration to find name and addresses of IKE gateway used by VPN.
+func securityIpsecVpnInfoReadGateway(
+	vpnName string, junSess *junos.Session,
+) (
+	string, []string, error,
+) {
+	addresses := make([]string, 0)
+	showConfig, err := junSess.Command(junos.CmdShowConfig +
+		"security ipsec vpn \"" + vpnName + "\"" + junos.PipeDisplaySetRelative)
+	if err != nil {
+		return "", addresses, err
+	}
+	gateway := ""
+	for _, item := range strings.Split(showConfig, "\n") {
+		if strings.Contains(item, junos.XMLStartTagConfigOut) {
+			continue
+		}
+		if strings.Contains(item, junos.XMLEndTagConfigOut) {
+			break
+		}
+		itemTrim := strings.TrimPrefix(item, junos.SetLS)
+		if balt.CutPrefixInString(&itemTrim, "ike gateway ") {
+			gateway = strings.Trim(itemTrim, "\"")
+		}
+	}
+	if gateway == "" {
+		return "", addresses, nil
+	}
+	showConfig, err = junSess.Command(junos.CmdShowConfig +
+		"security ike gateway \"" + gateway + "\"" + junos.PipeDisplaySetRelative)
+	if err != nil {
+		return gateway, addresses, err
+	}
+	for _, item := range strings.Split(showConfig, "\n") {
+		if strings.Contains(item, junos.XMLStartTagConfigOut) {
+			continue
+		}
+		if strings.Contains(item, junos.XMLEndTagConfigOut) {
+			break
+		}
+		itemTrim := strings.TrimPrefix(item, junos.SetLS)
+		if balt.CutPrefixInString(&itemTrim, "address ") {
+			addresses = append(addresses, itemTrim)
+		}
+	}
+
+	return gateway, addresses, nil
+}
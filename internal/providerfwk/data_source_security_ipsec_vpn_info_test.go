@@ -0,0 +1,123 @@
+package providerfwk_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// export TESTACC_INTERFACE=<inteface> for choose interface available else it's ge-0/0/3.
+func TestAccDataSourceSecurityIpsecVpnInfo_basic(t *testing.T) {
+	testaccInterface := junos.DefaultInterfaceTestAcc
+	if iface := os.Getenv("TESTACC_INTERFACE"); iface != "" {
+		testaccInterface = iface
+	}
+	if os.Getenv("TESTACC_SRX") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceSecurityIpsecVpnInfoPre(testaccInterface),
+				},
+				{
+					Config: testAccDataSourceSecurityIpsecVpnInfoConfig(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_security_ipsec_vpn_info.testacc_ipsecVpnInfo",
+							"id", "vpn_name=testacc_ipsecVpnInfo"),
+						resource.TestCheckResourceAttr("data.junos_security_ipsec_vpn_info.testacc_ipsecVpnInfo",
+							"ike_security_associations.#", "0"),
+						resource.TestCheckResourceAttr("data.junos_security_ipsec_vpn_info.testacc_ipsecVpnInfo",
+							"ipsec_security_associations.#", "0"),
+					),
+				},
+			},
+			PreventPostDestroyRefresh: true,
+		})
+	}
+}
+
+func testAccDataSourceSecurityIpsecVpnInfoPre(interFace string) string {
+	return fmt.Sprintf(`
+resource "junos_interface_logical" "testacc_ipsecVpnInfo" {
+  name = "%s.0"
+  family_inet {
+    address {
+      cidr_ip = "192.0.2.4/25"
+    }
+  }
+}
+resource "junos_security_ike_policy" "testacc_ipsecVpnInfo" {
+  name                = "testacc_ipsecVpnInfo"
+  proposal_set        = "basic"
+  mode                = "main"
+  pre_shared_key_text = "thePassWord"
+}
+resource "junos_security_ike_gateway" "testacc_ipsecVpnInfo" {
+  name               = "testacc_ipsecVpnInfo"
+  address            = ["192.0.2.3"]
+  policy             = junos_security_ike_policy.testacc_ipsecVpnInfo.name
+  external_interface = junos_interface_logical.testacc_ipsecVpnInfo.name
+}
+resource "junos_security_ipsec_policy" "testacc_ipsecVpnInfo" {
+  name         = "testacc_ipsecVpnInfo"
+  proposal_set = "basic"
+}
+resource "junos_interface_st0_unit" "testacc_ipsecVpnInfo" {}
+resource "junos_security_ipsec_vpn" "testacc_ipsecVpnInfo" {
+  name           = "testacc_ipsecVpnInfo"
+  bind_interface = junos_interface_st0_unit.testacc_ipsecVpnInfo.id
+  ike {
+    gateway = junos_security_ike_gateway.testacc_ipsecVpnInfo.name
+    policy  = junos_security_ipsec_policy.testacc_ipsecVpnInfo.name
+  }
+  establish_tunnels = "on-traffic"
+}
+`, interFace)
+}
+
+func testAccDataSourceSecurityIpsecVpnInfoConfig(interFace string) string {
+	return fmt.Sprintf(`
+resource "junos_interface_logical" "testacc_ipsecVpnInfo" {
+  name = "%s.0"
+  family_inet {
+    address {
+      cidr_ip = "192.0.2.4/25"
+    }
+  }
+}
+resource "junos_security_ike_policy" "testacc_ipsecVpnInfo" {
+  name                = "testacc_ipsecVpnInfo"
+  proposal_set        = "basic"
+  mode                = "main"
+  pre_shared_key_text = "thePassWord"
+}
+resource "junos_security_ike_gateway" "testacc_ipsecVpnInfo" {
+  name               = "testacc_ipsecVpnInfo"
+  address            = ["192.0.2.3"]
+  policy             = junos_security_ike_policy.testacc_ipsecVpnInfo.name
+  external_interface = junos_interface_logical.testacc_ipsecVpnInfo.name
+}
+resource "junos_security_ipsec_policy" "testacc_ipsecVpnInfo" {
+  name         = "testacc_ipsecVpnInfo"
+  proposal_set = "basic"
+}
+resource "junos_interface_st0_unit" "testacc_ipsecVpnInfo" {}
+resource "junos_security_ipsec_vpn" "testacc_ipsecVpnInfo" {
+  name           = "testacc_ipsecVpnInfo"
+  bind_interface = junos_interface_st0_unit.testacc_ipsecVpnInfo.id
+  ike {
+    gateway = junos_security_ike_gateway.testacc_ipsecVpnInfo.name
+    policy  = junos_security_ipsec_policy.testacc_ipsecVpnInfo.name
+  }
+  establish_tunnels = "on-traffic"
+}
+data "junos_security_ipsec_vpn_info" "testacc_ipsecVpnInfo" {
+  vpn_name = junos_security_ipsec_vpn.testacc_ipsecVpnInfo.name
+}
+`, interFace)
+}
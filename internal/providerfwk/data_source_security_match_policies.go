@@ -0,0 +1,262 @@
+package providerfwk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &securityMatchPoliciesDataSource{}
+	_ datasource.DataSourceWithConfigure = &securityMatchPoliciesDataSource{}
+)
+
+type securityMatchPoliciesDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *securityMatchPoliciesDataSource) typeName() string {
+	return providerName + "_security_match_policies"
+}
+
+func (dsc *securityMatchPoliciesDataSource) junosName() string {
+	return "security policy matching a flow"
+}
+
+func newSecurityMatchPoliciesDataSource() datasource.DataSource {
+	return &securityMatchPoliciesDataSource{}
+}
+
+func (dsc *securityMatchPoliciesDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *securityMatchPoliciesDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *securityMatchPoliciesDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Get " + dsc.junosName() + " (like `show security match-policies`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the data source.",
+			},
+			"from_zone": schema.StringAttribute{
+				Required:    true,
+				Description: "Source zone of flow.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+			"to_zone": schema.StringAttribute{
+				Required:    true,
+				Description: "Destination zone of flow.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+			"source_ip": schema.StringAttribute{
+				Required:    true,
+				Description: "Source IP address of flow.",
+				Validators: []validator.String{
+					tfvalidator.StringIPAddress(),
+				},
+			},
+			"destination_ip": schema.StringAttribute{
+				Required:    true,
+				Description: "Destination IP address of flow.",
+				Validators: []validator.String{
+					tfvalidator.StringIPAddress(),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				Required:    true,
+				Description: "Protocol name or number of flow.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+			"source_port": schema.Int64Attribute{
+				Required:    true,
+				Description: "Source port of flow.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"destination_port": schema.Int64Attribute{
+				Required:    true,
+				Description: "Destination port of flow.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"policy_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the matching policy.",
+			},
+			"policy_action": schema.StringAttribute{
+				Computed:    true,
+				Description: "Action of the matching policy.",
+			},
+			"policy_sequence_number": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Sequence number of the matching policy.",
+			},
+			"policy_state": schema.StringAttribute{
+				Computed:    true,
+				Description: "State of the matching policy.",
+			},
+			"policy_from_zone": schema.StringAttribute{
+				Computed:    true,
+				Description: "Source zone of the matching policy context.",
+			},
+			"policy_to_zone": schema.StringAttribute{
+				Computed:    true,
+				Description: "Destination zone of the matching policy context.",
+			},
+		},
+	}
+}
+
+type securityMatchPoliciesDataSourceData struct {
+	ID                   types.String `tfsdk:"id"`
+	FromZone             types.String `tfsdk:"from_zone"`
+	ToZone               types.String `tfsdk:"to_zone"`
+	SourceIP             types.String `tfsdk:"source_ip"`
+	DestinationIP        types.String `tfsdk:"destination_ip"`
+	Protocol             types.String `tfsdk:"protocol"`
+	SourcePort           types.Int64  `tfsdk:"source_port"`
+	DestinationPort      types.Int64  `tfsdk:"destination_port"`
+	PolicyName           types.String `tfsdk:"policy_name"`
+	PolicyAction         types.String `tfsdk:"policy_action"`
+	PolicySequenceNumber types.Int64  `tfsdk:"policy_sequence_number"`
+	PolicyState          types.String `tfsdk:"policy_state"`
+	PolicyFromZone       types.String `tfsdk:"policy_from_zone"`
+	PolicyToZone         types.String `tfsdk:"policy_to_zone"`
+}
+
+func (dsc *securityMatchPoliciesDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	var config securityMatchPoliciesDataSourceData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	junSess, err := dsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+	if !junSess.CheckCompatibilitySecurity() {
+		resp.Diagnostics.AddError(
+			tfdiag.CompatibilityErrSummary,
+			fmt.Sprintf(dsc.typeName()+" data source not compatible "+
+				"with Junos device %q", junSess.SystemInformation.HardwareModel),
+		)
+
+		return
+	}
+
+	data := config
+	junos.MutexLock()
+	err = data.read(ctx, junSess)
+	junos.MutexUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
+
+		return
+	}
+
+	data.fillID()
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (dscData *securityMatchPoliciesDataSourceData) fillID() {
+	dscData.ID = types.StringValue(
+		dscData.FromZone.ValueString() + junos.IDSeparator +
+			dscData.ToZone.ValueString() + junos.IDSeparator +
+			dscData.SourceIP.ValueString() + junos.IDSeparator +
+			dscData.DestinationIP.ValueString() + junos.IDSeparator +
+			dscData.Protocol.ValueString() + junos.IDSeparator +
+			utils.ConvI64toa(dscData.SourcePort.ValueInt64()) + junos.IDSeparator +
+			utils.ConvI64toa(dscData.DestinationPort.ValueInt64()),
+	)
+}
+
+func (dscData *securityMatchPoliciesDataSourceData) read(
+	_ context.Context, junSess *junos.Session,
+) error {
+	rpcArgs := "<from-zone>" + dscData.FromZone.ValueString() + "</from-zone>" +
+		"<to-zone>" + dscData.ToZone.ValueString() + "</to-zone>" +
+		"<source-ip>" + dscData.SourceIP.ValueString() + "</source-ip>" +
+		"<destination-ip>" + dscData.DestinationIP.ValueString() + "</destination-ip>" +
+		"<source-port>" + utils.ConvI64toa(dscData.SourcePort.ValueInt64()) + "</source-port>" +
+		"<destination-port>" + utils.ConvI64toa(dscData.DestinationPort.ValueInt64()) + "</destination-port>" +
+		"<protocol>" + dscData.Protocol.ValueString() + "</protocol>"
+	replyData, err := junSess.CommandXML(fmt.Sprintf(junos.RPCGetMatchFirewallPolicies, rpcArgs))
+	if err != nil {
+		return err
+	}
+	var reply junos.GetMatchFirewallPoliciesReply
+	err = xml.Unmarshal([]byte(replyData), &reply.SecurityPolicies)
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	// only the first policy is the one matched by flow
+	for _, secContext := range reply.SecurityPolicies.SecurityContext {
+		for _, policies := range secContext.Policies {
+			if len(policies.PolicyInformation) == 0 {
+				continue
+			}
+			policy := policies.PolicyInformation[0]
+			dscData.PolicyName = types.StringValue(strings.TrimSpace(policy.PolicyName))
+			dscData.PolicyAction = types.StringValue(strings.TrimSpace(policy.PolicyAction.ActionType))
+			dscData.PolicySequenceNumber = types.Int64Value(int64(policy.PolicySequenceNumber))
+			dscData.PolicyState = types.StringValue(strings.TrimSpace(policy.PolicyState))
+			dscData.PolicyFromZone = types.StringValue(strings.TrimSpace(secContext.ContextInformation.SourceZoneName))
+			dscData.PolicyToZone = types.StringValue(strings.TrimSpace(secContext.ContextInformation.DestinationZoneName))
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no policy found in xml reply %q", replyData)
+}
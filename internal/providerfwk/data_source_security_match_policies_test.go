@@ -0,0 +1,94 @@
+package providerfwk_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceSecurityMatchPolicies_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SRX") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceSecurityMatchPoliciesPre(),
+				},
+				{
+					Config: testAccDataSourceSecurityMatchPoliciesConfig(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_security_match_policies.testacc_secMatchPolicies",
+							"policy_name", "testacc_secMatchPolicies"),
+						resource.TestCheckResourceAttr("data.junos_security_match_policies.testacc_secMatchPolicies",
+							"policy_action", "permit"),
+						resource.TestCheckResourceAttr("data.junos_security_match_policies.testacc_secMatchPolicies",
+							"policy_from_zone", "testacc_secMatchPolicies1"),
+						resource.TestCheckResourceAttr("data.junos_security_match_policies.testacc_secMatchPolicies",
+							"policy_to_zone", "testacc_secMatchPolicies2"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccDataSourceSecurityMatchPoliciesPre() string {
+	return `
+resource "junos_security_zone" "testacc_secMatchPolicies1" {
+  name = "testacc_secMatchPolicies1"
+  address_book {
+    name    = "testacc_secMatchPolicies_src"
+    network = "192.0.2.0/25"
+  }
+}
+resource "junos_security_zone" "testacc_secMatchPolicies2" {
+  name = "testacc_secMatchPolicies2"
+}
+resource "junos_security_policy" "testacc_secMatchPolicies" {
+  from_zone = junos_security_zone.testacc_secMatchPolicies1.name
+  to_zone   = junos_security_zone.testacc_secMatchPolicies2.name
+  policy {
+    name                      = "testacc_secMatchPolicies"
+    match_source_address      = ["testacc_secMatchPolicies_src"]
+    match_destination_address = ["any"]
+    match_application         = ["junos-ssh"]
+  }
+}
+`
+}
+
+func testAccDataSourceSecurityMatchPoliciesConfig() string {
+	return `
+resource "junos_security_zone" "testacc_secMatchPolicies1" {
+  name = "testacc_secMatchPolicies1"
+  address_book {
+    name    = "testacc_secMatchPolicies_src"
+    network = "192.0.2.0/25"
+  }
+}
+resource "junos_security_zone" "testacc_secMatchPolicies2" {
+  name = "testacc_secMatchPolicies2"
+}
+resource "junos_security_policy" "testacc_secMatchPolicies" {
+  from_zone = junos_security_zone.testacc_secMatchPolicies1.name
+  to_zone   = junos_security_zone.testacc_secMatchPolicies2.name
+  policy {
+    name                      = "testacc_secMatchPolicies"
+    match_source_address      = ["testacc_secMatchPolicies_src"]
+    match_destination_address = ["any"]
+    match_application         = ["junos-ssh"]
+  }
+}
+data "junos_security_match_policies" "testacc_secMatchPolicies" {
+  from_zone        = junos_security_policy.testacc_secMatchPolicies.from_zone
+  to_zone          = junos_security_policy.testacc_secMatchPolicies.to_zone
+  source_ip        = "192.0.2.1"
+  destination_ip   = "198.51.100.1"
+  protocol         = "tcp"
+  source_port      = 1024
+  destination_port = 22
+}
+`
+}
@@ -0,0 +1,206 @@
+package providerfwk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &securityNatRulesHitCountDataSource{}
+	_ datasource.DataSourceWithConfigure = &securityNatRulesHitCountDataSource{}
+)
+
+type securityNatRulesHitCountDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *securityNatRulesHitCountDataSource) typeName() string {
+	return providerName + "_security_nat_rules_hit_count"
+}
+
+func (dsc *securityNatRulesHitCountDataSource) junosName() string {
+	return "translation hits of security nat rules"
+}
+
+func newSecurityNatRulesHitCountDataSource() datasource.DataSource {
+	return &securityNatRulesHitCountDataSource{}
+}
+
+func (dsc *securityNatRulesHitCountDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *securityNatRulesHitCountDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *securityNatRulesHitCountDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	ruleType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":               types.StringType,
+			"rule_set":         types.StringType,
+			"name":             types.StringType,
+			"translation_hits": types.Int64Type,
+		},
+	}
+	resp.Schema = schema.Schema{
+		Description: "Get " + dsc.junosName() + " (like `show security nat <type> rule all`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the data source.",
+			},
+			"source_rules": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each source nat rule found.",
+				ElementType: ruleType,
+			},
+			"destination_rules": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each destination nat rule found.",
+				ElementType: ruleType,
+			},
+			"static_rules": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each static nat rule found.",
+				ElementType: ruleType,
+			},
+		},
+	}
+}
+
+type securityNatRulesHitCountDataSourceData struct {
+	ID               types.String                                  `tfsdk:"id"`
+	SourceRules      []securityNatRulesHitCountDataSourceBlockRule `tfsdk:"source_rules"`
+	DestinationRules []securityNatRulesHitCountDataSourceBlockRule `tfsdk:"destination_rules"`
+	StaticRules      []securityNatRulesHitCountDataSourceBlockRule `tfsdk:"static_rules"`
+}
+
+type securityNatRulesHitCountDataSourceBlockRule struct {
+	ID              types.String `tfsdk:"id"`
+	RuleSet         types.String `tfsdk:"rule_set"`
+	Name            types.String `tfsdk:"name"`
+	TranslationHits types.Int64  `tfsdk:"translation_hits"`
+}
+
+func (dsc *securityNatRulesHitCountDataSource) Read(
+	ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	junSess, err := dsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+	if !junSess.CheckCompatibilitySecurity() {
+		resp.Diagnostics.AddError(
+			tfdiag.CompatibilityErrSummary,
+			fmt.Sprintf(dsc.typeName()+" data source not compatible "+
+				"with Junos device %q", junSess.SystemInformation.HardwareModel),
+		)
+
+		return
+	}
+
+	var data securityNatRulesHitCountDataSourceData
+	junos.MutexLock()
+	err = data.read(ctx, junSess)
+	junos.MutexUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
+
+		return
+	}
+
+	data.fillID()
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (dscData *securityNatRulesHitCountDataSourceData) fillID() {
+	dscData.ID = types.StringValue("security_nat_rules_hit_count")
+}
+
+func (dscData *securityNatRulesHitCountDataSourceData) read(
+	_ context.Context, junSess *junos.Session,
+) error {
+	replyData, err := junSess.CommandXML(junos.RPCGetSourceNatRuleSetsInformation)
+	if err != nil {
+		return err
+	}
+	var replySource junos.GetSourceNatRuleSetsReply
+	err = xml.Unmarshal([]byte(replyData), &replySource.RuleDetail)
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	dscData.SourceRules = securityNatRulesHitCountFromEntries(replySource.RuleDetail.Entry)
+
+	replyData, err = junSess.CommandXML(junos.RPCGetDestinationNatRuleSetsInformation)
+	if err != nil {
+		return err
+	}
+	var replyDestination junos.GetDestinationNatRuleSetsReply
+	err = xml.Unmarshal([]byte(replyData), &replyDestination.RuleDetail)
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	dscData.DestinationRules = securityNatRulesHitCountFromEntries(replyDestination.RuleDetail.Entry)
+
+	replyData, err = junSess.CommandXML(junos.RPCGetStaticNatRuleSetsInformation)
+	if err != nil {
+		return err
+	}
+	var replyStatic junos.GetStaticNatRuleSetsReply
+	err = xml.Unmarshal([]byte(replyData), &replyStatic.RuleDetail)
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	dscData.StaticRules = securityNatRulesHitCountFromEntries(replyStatic.RuleDetail.Entry)
+
+	return nil
+}
+
+func securityNatRulesHitCountFromEntries(
+	entries []junos.NatRuleEntry,
+) []securityNatRulesHitCountDataSourceBlockRule {
+	rules := make([]securityNatRulesHitCountDataSourceBlockRule, 0, len(entries))
+	for _, entry := range entries {
+		ruleSet := strings.TrimSpace(entry.RuleSetName)
+		name := strings.TrimSpace(entry.RuleName)
+		rules = append(rules, securityNatRulesHitCountDataSourceBlockRule{
+			ID:              types.StringValue(ruleSet + junos.IDSeparator + name),
+			RuleSet:         types.StringValue(ruleSet),
+			Name:            types.StringValue(name),
+			TranslationHits: types.Int64Value(entry.RuleTranslationHits),
+		})
+	}
+
+	return rules
+}
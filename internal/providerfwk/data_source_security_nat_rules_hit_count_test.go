@@ -0,0 +1,100 @@
+package providerfwk_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceSecurityNatRulesHitCount_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SRX") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceSecurityNatRulesHitCountPre(),
+				},
+				{
+					Config: testAccDataSourceSecurityNatRulesHitCountConfig(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_security_nat_rules_hit_count.testacc_secNatRulesHitCount",
+							"source_rules.#", "1"),
+						resource.TestCheckResourceAttr("data.junos_security_nat_rules_hit_count.testacc_secNatRulesHitCount",
+							"source_rules.0.rule_set", "testacc_secNatRulesHitCount"),
+						resource.TestCheckResourceAttr("data.junos_security_nat_rules_hit_count.testacc_secNatRulesHitCount",
+							"source_rules.0.id", "testacc_secNatRulesHitCount"+junos.IDSeparator+"testacc_secNatRulesHitCount"),
+						resource.TestCheckResourceAttr("data.junos_security_nat_rules_hit_count.testacc_secNatRulesHitCount",
+							"source_rules.0.name", "testacc_secNatRulesHitCount"),
+						resource.TestCheckResourceAttr("data.junos_security_nat_rules_hit_count.testacc_secNatRulesHitCount",
+							"source_rules.0.translation_hits", "0"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccDataSourceSecurityNatRulesHitCountPre() string {
+	return `
+resource "junos_security_zone" "testacc_secNatRulesHitCount" {
+  name = "testacc_secNatRulesHitCount"
+}
+resource "junos_security_nat_source" "testacc_secNatRulesHitCount" {
+  name = "testacc_secNatRulesHitCount"
+  from {
+    type  = "zone"
+    value = [junos_security_zone.testacc_secNatRulesHitCount.name]
+  }
+  to {
+    type  = "zone"
+    value = [junos_security_zone.testacc_secNatRulesHitCount.name]
+  }
+  rule {
+    name = "testacc_secNatRulesHitCount"
+    match {
+      source_address = ["192.0.2.0/25"]
+    }
+    then {
+      type = "interface"
+    }
+  }
+}
+`
+}
+
+func testAccDataSourceSecurityNatRulesHitCountConfig() string {
+	return `
+resource "junos_security_zone" "testacc_secNatRulesHitCount" {
+  name = "testacc_secNatRulesHitCount"
+}
+resource "junos_security_nat_source" "testacc_secNatRulesHitCount" {
+  name = "testacc_secNatRulesHitCount"
+  from {
+    type  = "zone"
+    value = [junos_security_zone.testacc_secNatRulesHitCount.name]
+  }
+  to {
+    type  = "zone"
+    value = [junos_security_zone.testacc_secNatRulesHitCount.name]
+  }
+  rule {
+    name = "testacc_secNatRulesHitCount"
+    match {
+      source_address = ["192.0.2.0/25"]
+    }
+    then {
+      type = "interface"
+    }
+  }
+}
+data "junos_security_nat_rules_hit_count" "testacc_secNatRulesHitCount" {
+  depends_on = [
+    junos_security_nat_source.testacc_secNatRulesHitCount,
+  ]
+}
+`
+}
@@ -0,0 +1,256 @@
+package providerfwk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &securityPkiCertificatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &securityPkiCertificatesDataSource{}
+)
+
+// layout of validity dates in Junos output.
+const securityPkiCertificateDateLayout = "01-02-2006 15:04 MST"
+
+type securityPkiCertificatesDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *securityPkiCertificatesDataSource) typeName() string {
+	return providerName + "_security_pki_certificates"
+}
+
+func (dsc *securityPkiCertificatesDataSource) junosName() string {
+	return "PKI certificates installed on device"
+}
+
+func newSecurityPkiCertificatesDataSource() datasource.DataSource {
+	return &securityPkiCertificatesDataSource{}
+}
+
+func (dsc *securityPkiCertificatesDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *securityPkiCertificatesDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *securityPkiCertificatesDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	certificateAttrTypes := map[string]attr.Type{
+		"certificate_id":       types.StringType,
+		"serial_number":        types.StringType,
+		"issuer_common_name":   types.StringType,
+		"issuer_organization":  types.StringType,
+		"subject":              types.StringType,
+		"not_before":           types.StringType,
+		"not_after":            types.StringType,
+		"not_after_rfc3339":    types.StringType,
+		"public_key_algorithm": types.StringType,
+	}
+	resp.Schema = schema.Schema{
+		Description: "Get list of " + dsc.junosName() + ".",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the data source.",
+			},
+			"match_certificate_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "A regexp to apply filter on certificate identifier (or CA profile for CA certificates).",
+				Validators: []validator.String{
+					tfvalidator.StringRegex(),
+				},
+			},
+			"local_certificate": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each local certificate found.",
+				ElementType: types.ObjectType{
+					AttrTypes: certificateAttrTypes,
+				},
+			},
+			"ca_certificate": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each CA certificate found.",
+				ElementType: types.ObjectType{
+					AttrTypes: certificateAttrTypes,
+				},
+			},
+		},
+	}
+}
+
+type securityPkiCertificatesDataSourceData struct {
+	ID                 types.String                                   `tfsdk:"id"`
+	MatchCertificateID types.String                                   `tfsdk:"match_certificate_id"`
+	LocalCertificate   []securityPkiCertificatesDataSourceCertificate `tfsdk:"local_certificate"`
+	CaCertificate      []securityPkiCertificatesDataSourceCertificate `tfsdk:"ca_certificate"`
+}
+
+type securityPkiCertificatesDataSourceConfig struct {
+	ID                 types.String `tfsdk:"id"`
+	MatchCertificateID types.String `tfsdk:"match_certificate_id"`
+	LocalCertificate   types.List   `tfsdk:"local_certificate"`
+	CaCertificate      types.List   `tfsdk:"ca_certificate"`
+}
+
+type securityPkiCertificatesDataSourceCertificate struct {
+	CertificateID      types.String `tfsdk:"certificate_id"`
+	SerialNumber       types.String `tfsdk:"serial_number"`
+	IssuerCommonName   types.String `tfsdk:"issuer_common_name"`
+	IssuerOrganization types.String `tfsdk:"issuer_organization"`
+	Subject            types.String `tfsdk:"subject"`
+	NotBefore          types.String `tfsdk:"not_before"`
+	NotAfter           types.String `tfsdk:"not_after"`
+	NotAfterRFC3339    types.String `tfsdk:"not_after_rfc3339"`
+	PublicKeyAlgorithm types.String `tfsdk:"public_key_algorithm"`
+}
+
+func (dsc *securityPkiCertificatesDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	var config securityPkiCertificatesDataSourceConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	junSess, err := dsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	var data securityPkiCertificatesDataSourceData
+	junos.MutexLock()
+	err = data.read(ctx, config, junSess)
+	junos.MutexUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
+
+		return
+	}
+
+	data.fillIDAndConfigArgument(config)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (dscData *securityPkiCertificatesDataSourceData) fillIDAndConfigArgument(
+	config securityPkiCertificatesDataSourceConfig,
+) {
+	dscData.MatchCertificateID = config.MatchCertificateID
+	dscData.ID = types.StringValue("match=" + config.MatchCertificateID.ValueString())
+}
+
+func (dscData *securityPkiCertificatesDataSourceData) read(
+	_ context.Context,
+	config securityPkiCertificatesDataSourceConfig,
+	junSess *junos.Session,
+) error {
+	replyData, err := junSess.CommandXML(junos.RPCGetPKILocalCertificateInformation)
+	if err != nil {
+		return err
+	}
+	var localReply junos.GetPKILocalCertificateReply
+	err = xml.Unmarshal([]byte(replyData), &localReply.PKILocalCertificateInfo)
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	for _, cert := range localReply.PKILocalCertificateInfo.Certificate {
+		matched, err := securityPkiCertificatesMatch(config.MatchCertificateID.ValueString(), cert)
+		if err != nil {
+			return err
+		}
+		if matched {
+			dscData.LocalCertificate = append(dscData.LocalCertificate, newSecurityPkiCertificatesDataSourceCertificate(cert))
+		}
+	}
+
+	replyData, err = junSess.CommandXML(junos.RPCGetPKICACertificateInformation)
+	if err != nil {
+		return err
+	}
+	var caReply junos.GetPKICACertificateReply
+	err = xml.Unmarshal([]byte(replyData), &caReply.PKICACertificateInfo)
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	for _, cert := range caReply.PKICACertificateInfo.Certificate {
+		matched, err := securityPkiCertificatesMatch(config.MatchCertificateID.ValueString(), cert)
+		if err != nil {
+			return err
+		}
+		if matched {
+			dscData.CaCertificate = append(dscData.CaCertificate, newSecurityPkiCertificatesDataSourceCertificate(cert))
+		}
+	}
+
+	return nil
+}
+
+func securityPkiCertificatesMatch(match string, cert junos.PKICertificateDetail) (bool, error) {
+	if match == "" {
+		return true, nil
+	}
+	matched, err := regexp.MatchString(match, strings.TrimSpace(cert.Identifier))
+	if err != nil {
+		return false, fmt.Errorf("matching with regexp %q: %w", match, err)
+	}
+
+	return matched, nil
+}
+
+func newSecurityPkiCertificatesDataSourceCertificate(
+	cert junos.PKICertificateDetail,
+) securityPkiCertificatesDataSourceCertificate {
+	notAfter := strings.TrimSpace(cert.Validity.NotAfter)
+	notAfterRFC3339 := ""
+	if t, err := time.Parse(securityPkiCertificateDateLayout, notAfter); err == nil {
+		notAfterRFC3339 = t.Format(time.RFC3339)
+	}
+
+	return securityPkiCertificatesDataSourceCertificate{
+		CertificateID:      types.StringValue(strings.TrimSpace(cert.Identifier)),
+		SerialNumber:       types.StringValue(strings.TrimSpace(cert.SerialNumber)),
+		IssuerCommonName:   types.StringValue(strings.TrimSpace(cert.Issuer.CommonName)),
+		IssuerOrganization: types.StringValue(strings.TrimSpace(cert.Issuer.Organization)),
+		Subject:            types.StringValue(strings.TrimSpace(cert.Subject)),
+		NotBefore:          types.StringValue(strings.TrimSpace(cert.Validity.NotBefore)),
+		NotAfter:           types.StringValue(notAfter),
+		NotAfterRFC3339:    types.StringValue(notAfterRFC3339),
+		PublicKeyAlgorithm: types.StringValue(strings.TrimSpace(cert.PublicKeyAlgorithm)),
+	}
+}
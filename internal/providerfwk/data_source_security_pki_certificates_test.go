@@ -0,0 +1,39 @@
+package providerfwk_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceSecurityPkiCertificates_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SRX") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceSecurityPkiCertificatesConfig(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_security_pki_certificates.testacc_all",
+							"id", "match="),
+						resource.TestCheckResourceAttr("data.junos_security_pki_certificates.testacc_none",
+							"local_certificate.#", "0"),
+						resource.TestCheckResourceAttr("data.junos_security_pki_certificates.testacc_none",
+							"ca_certificate.#", "0"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccDataSourceSecurityPkiCertificatesConfig() string {
+	return `
+data "junos_security_pki_certificates" "testacc_all" {}
+data "junos_security_pki_certificates" "testacc_none" {
+  match_certificate_id = "^testacc_pkiCertificatesNotFound$"
+}
+`
+}
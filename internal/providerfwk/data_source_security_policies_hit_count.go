@@ -0,0 +1,214 @@
+package providerfwk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &securityPoliciesHitCountDataSource{}
+	_ datasource.DataSourceWithConfigure = &securityPoliciesHitCountDataSource{}
+)
+
+type securityPoliciesHitCountDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *securityPoliciesHitCountDataSource) typeName() string {
+	return providerName + "_security_policies_hit_count"
+}
+
+func (dsc *securityPoliciesHitCountDataSource) junosName() string {
+	return "hit count of security policies"
+}
+
+func newSecurityPoliciesHitCountDataSource() datasource.DataSource {
+	return &securityPoliciesHitCountDataSource{}
+}
+
+func (dsc *securityPoliciesHitCountDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *securityPoliciesHitCountDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *securityPoliciesHitCountDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Get " + dsc.junosName() + " (like `show security policies hit-count`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the data source.",
+			},
+			"from_zone": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only read policies with this source zone.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+			"to_zone": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only read policies with this destination zone.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+			"policies": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each policy found.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"policy_id": types.StringType,
+						"from_zone": types.StringType,
+						"to_zone":   types.StringType,
+						"name":      types.StringType,
+						"hit_count": types.Int64Type,
+					},
+				},
+			},
+		},
+	}
+}
+
+type securityPoliciesHitCountDataSourceData struct {
+	ID       types.String                                      `tfsdk:"id"`
+	FromZone types.String                                      `tfsdk:"from_zone"`
+	ToZone   types.String                                      `tfsdk:"to_zone"`
+	Policies []securityPoliciesHitCountDataSourceBlockPolicies `tfsdk:"policies"`
+}
+
+type securityPoliciesHitCountDataSourceConfig struct {
+	ID       types.String `tfsdk:"id"`
+	FromZone types.String `tfsdk:"from_zone"`
+	ToZone   types.String `tfsdk:"to_zone"`
+	Policies types.List   `tfsdk:"policies"`
+}
+
+type securityPoliciesHitCountDataSourceBlockPolicies struct {
+	PolicyID types.String `tfsdk:"policy_id"`
+	FromZone types.String `tfsdk:"from_zone"`
+	ToZone   types.String `tfsdk:"to_zone"`
+	Name     types.String `tfsdk:"name"`
+	HitCount types.Int64  `tfsdk:"hit_count"`
+}
+
+func (dsc *securityPoliciesHitCountDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	var config securityPoliciesHitCountDataSourceConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	junSess, err := dsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+	if !junSess.CheckCompatibilitySecurity() {
+		resp.Diagnostics.AddError(
+			tfdiag.CompatibilityErrSummary,
+			fmt.Sprintf(dsc.typeName()+" data source not compatible "+
+				"with Junos device %q", junSess.SystemInformation.HardwareModel),
+		)
+
+		return
+	}
+
+	var data securityPoliciesHitCountDataSourceData
+	junos.MutexLock()
+	err = data.read(ctx, config, junSess)
+	junos.MutexUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
+
+		return
+	}
+
+	data.fillIDAndConfigArgument(config)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (dscData *securityPoliciesHitCountDataSourceData) fillIDAndConfigArgument(
+	config securityPoliciesHitCountDataSourceConfig,
+) {
+	dscData.FromZone = config.FromZone
+	dscData.ToZone = config.ToZone
+	dscData.ID = types.StringValue(
+		"from_zone=" + config.FromZone.ValueString() + junos.IDSeparator +
+			"to_zone=" + config.ToZone.ValueString(),
+	)
+}
+
+func (dscData *securityPoliciesHitCountDataSourceData) read(
+	_ context.Context,
+	config securityPoliciesHitCountDataSourceConfig,
+	junSess *junos.Session,
+) error {
+	rpcArgs := ""
+	if v := config.FromZone.ValueString(); v != "" {
+		rpcArgs += "<from-zone>" + v + "</from-zone>"
+	}
+	if v := config.ToZone.ValueString(); v != "" {
+		rpcArgs += "<to-zone>" + v + "</to-zone>"
+	}
+	replyData, err := junSess.CommandXML(fmt.Sprintf(junos.RPCGetSecurityPoliciesHitCount, rpcArgs))
+	if err != nil {
+		return err
+	}
+	var reply junos.GetSecurityPoliciesHitCountReply
+	err = xml.Unmarshal([]byte(replyData), &reply.PolicyHitCount)
+	if err != nil {
+		return fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	for _, entry := range reply.PolicyHitCount.Entry {
+		fromZone := strings.TrimSpace(entry.FromZone)
+		toZone := strings.TrimSpace(entry.ToZone)
+		dscData.Policies = append(dscData.Policies, securityPoliciesHitCountDataSourceBlockPolicies{
+			PolicyID: types.StringValue(fromZone + junos.IDSeparator + toZone),
+			FromZone: types.StringValue(fromZone),
+			ToZone:   types.StringValue(toZone),
+			Name:     types.StringValue(strings.TrimSpace(entry.Name)),
+			HitCount: types.Int64Value(entry.Count),
+		})
+	}
+
+	return nil
+}
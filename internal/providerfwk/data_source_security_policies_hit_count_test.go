@@ -0,0 +1,81 @@
+package providerfwk_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceSecurityPoliciesHitCount_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SRX") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceSecurityPoliciesHitCountPre(),
+				},
+				{
+					Config: testAccDataSourceSecurityPoliciesHitCountConfig(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_security_policies_hit_count.testacc_secPoliciesHitCount",
+							"policies.#", "1"),
+						resource.TestCheckResourceAttr("data.junos_security_policies_hit_count.testacc_secPoliciesHitCount",
+							"policies.0.policy_id", "testacc_secPoliciesHitCount1_-_testacc_secPoliciesHitCount2"),
+						resource.TestCheckResourceAttr("data.junos_security_policies_hit_count.testacc_secPoliciesHitCount",
+							"policies.0.name", "testacc_secPoliciesHitCount"),
+						resource.TestCheckResourceAttr("data.junos_security_policies_hit_count.testacc_secPoliciesHitCount",
+							"policies.0.hit_count", "0"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccDataSourceSecurityPoliciesHitCountPre() string {
+	return `
+resource "junos_security_zone" "testacc_secPoliciesHitCount1" {
+  name = "testacc_secPoliciesHitCount1"
+}
+resource "junos_security_zone" "testacc_secPoliciesHitCount2" {
+  name = "testacc_secPoliciesHitCount2"
+}
+resource "junos_security_policy" "testacc_secPoliciesHitCount" {
+  from_zone = junos_security_zone.testacc_secPoliciesHitCount1.name
+  to_zone   = junos_security_zone.testacc_secPoliciesHitCount2.name
+  policy {
+    name                      = "testacc_secPoliciesHitCount"
+    match_source_address      = ["any"]
+    match_destination_address = ["any"]
+    match_application         = ["junos-ssh"]
+  }
+}
+`
+}
+
+func testAccDataSourceSecurityPoliciesHitCountConfig() string {
+	return `
+resource "junos_security_zone" "testacc_secPoliciesHitCount1" {
+  name = "testacc_secPoliciesHitCount1"
+}
+resource "junos_security_zone" "testacc_secPoliciesHitCount2" {
+  name = "testacc_secPoliciesHitCount2"
+}
+resource "junos_security_policy" "testacc_secPoliciesHitCount" {
+  from_zone = junos_security_zone.testacc_secPoliciesHitCount1.name
+  to_zone   = junos_security_zone.testacc_secPoliciesHitCount2.name
+  policy {
+    name                      = "testacc_secPoliciesHitCount"
+    match_source_address      = ["any"]
+    match_destination_address = ["any"]
+    match_application         = ["junos-ssh"]
+  }
+}
+data "junos_security_policies_hit_count" "testacc_secPoliciesHitCount" {
+  from_zone = junos_security_policy.testacc_secPoliciesHitCount.from_zone
+  to_zone   = junos_security_policy.testacc_secPoliciesHitCount.to_zone
+}
+`
+}
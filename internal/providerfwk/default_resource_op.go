@@ -8,8 +8,11 @@ import (
 	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
 	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type resourceDataNullID interface {
@@ -54,6 +57,13 @@ type resourceDataFirstSet interface {
 	fillID()
 }
 
+// resourceDataPreCheckUpdate: resource data (from plan) which need to check
+// the Junos configuration with the prior state before updating.
+type resourceDataPreCheckUpdate interface {
+	resourceDataSet
+	preCheckUpdate(context.Context, resourceDataDel, *junos.Session) diag.Diagnostics
+}
+
 type resourceDataDel interface {
 	del(context.Context, *junos.Session) error
 }
@@ -76,6 +86,19 @@ func defaultResourceCreate(
 	plan resourceDataFirstSet,
 	resp *resource.CreateResponse,
 ) {
+	rscTimeouts, diags := resourceDataTimeouts(ctx, resp.State, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := rscTimeouts.Create(ctx, defaultResourceCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	if rsc.junosClient().FakeCreateSetFile() {
 		junSess := rsc.junosClient().NewSessionWithoutNetconf(ctx)
 
@@ -124,6 +147,8 @@ func defaultResourceCreate(
 
 		return
 	}
+	plan.fillID()
+	junSess.SetResourceID(resourceDataID(ctx, resp.State, plan))
 	warns, err := junSess.CommitConf("create resource " + rsc.typeName())
 	resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigCommitWarnSummary, warns)...)
 	if err != nil {
@@ -136,7 +161,6 @@ func defaultResourceCreate(
 		return
 	}
 
-	plan.fillID()
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -148,6 +172,19 @@ func defaultResourceRead(
 	beforeSetState func(),
 	resp *resource.ReadResponse,
 ) {
+	var rscTimeouts resourceTimeouts
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &rscTimeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readTimeout, diags := rscTimeouts.Read(ctx, defaultResourceReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	junSess, err := rsc.junosClient().StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -190,6 +227,8 @@ func defaultResourceRead(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// keep timeouts from prior state as data read from Junos configuration doesn't have them
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), rscTimeouts)...)
 }
 
 func defaultResourceUpdate(
@@ -199,6 +238,19 @@ func defaultResourceUpdate(
 	plan resourceDataSet,
 	resp *resource.UpdateResponse,
 ) {
+	rscTimeouts, diags := resourceDataTimeouts(ctx, resp.State, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateTimeout, diags := rscTimeouts.Update(ctx, defaultResourceUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	if rsc.junosClient().FakeUpdateAlso() {
 		junSess := rsc.junosClient().NewSessionWithoutNetconf(ctx)
 
@@ -237,6 +289,7 @@ func defaultResourceUpdate(
 		return
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceDataID(ctx, resp.State, state))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigLockErrSummary, err.Error())
 
@@ -246,6 +299,13 @@ func defaultResourceUpdate(
 		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigClearUnlockWarnSummary, junSess.ConfigClear())...)
 	}()
 
+	if planCheck, ok := plan.(resourceDataPreCheckUpdate); ok {
+		resp.Diagnostics.Append(planCheck.preCheckUpdate(ctx, state, junSess)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if stateOpts, ok := state.(resourceDataDelWithOpts); ok {
 		if err := stateOpts.delOpts(ctx, junSess); err != nil {
 			resp.Diagnostics.AddError(tfdiag.ConfigDelErrSummary, err.Error())
@@ -285,6 +345,19 @@ func defaultResourceDelete(
 	state resourceDataDel,
 	resp *resource.DeleteResponse,
 ) {
+	rscTimeouts, diags := resourceDataTimeouts(ctx, resp.State, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := rscTimeouts.Delete(ctx, defaultResourceDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if rsc.junosClient().FakeDeleteAlso() {
 		junSess := rsc.junosClient().NewSessionWithoutNetconf(ctx)
 
@@ -304,6 +377,7 @@ func defaultResourceDelete(
 		return
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceDataID(ctx, resp.State, state))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigLockErrSummary, err.Error())
 
@@ -335,6 +409,9 @@ func defaultResourceImportState(
 	resp *resource.ImportStateResponse,
 	notFoundDetailMsg string,
 ) {
+	ctx, cancel := context.WithTimeout(ctx, defaultResourceReadTimeout)
+	defer cancel()
+
 	junSess, err := rsc.junosClient().StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -405,3 +482,20 @@ func defaultResourceImportState(
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
+
+// resourceDataID returns the value of `id` attribute in data of resource
+// with the schema of resource state (empty if not found).
+func resourceDataID(ctx context.Context, state tfsdk.State, data interface{}) string {
+	dataState := tfsdk.State{
+		Schema: state.Schema,
+	}
+	if diags := dataState.Set(ctx, data); diags.HasError() {
+		return ""
+	}
+	var id types.String
+	if diags := dataState.GetAttribute(ctx, path.Root("id"), &id); diags.HasError() {
+		return ""
+	}
+
+	return id.ValueString()
+}
@@ -0,0 +1,165 @@
+package providerfwk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	defaultResourceCreateTimeout = 20 * time.Minute
+	defaultResourceReadTimeout   = 10 * time.Minute
+	defaultResourceUpdateTimeout = 20 * time.Minute
+	defaultResourceDeleteTimeout = 20 * time.Minute
+)
+
+var (
+	_ basetypes.ObjectTypable  = resourceTimeoutsType{}
+	_ basetypes.ObjectValuable = resourceTimeouts{}
+)
+
+// resourceTimeoutsBlock returns the `timeouts` block with create, read, update and delete attributes
+// for the schema of resources.
+func resourceTimeoutsBlock(ctx context.Context) schema.Block {
+	block := timeouts.BlockAll(ctx).(schema.SingleNestedBlock)
+	block.Description = "Timeouts of the operations on the resource " +
+		"(defaults: create " + defaultResourceCreateTimeout.String() +
+		", read " + defaultResourceReadTimeout.String() +
+		", update " + defaultResourceUpdateTimeout.String() +
+		", delete " + defaultResourceDeleteTimeout.String() + ")."
+	block.CustomType = resourceTimeoutsType{
+		Type: block.CustomType.(timeouts.Type),
+	}
+
+	return block
+}
+
+// resourceTimeoutsType is the type of `timeouts` block in resources.
+type resourceTimeoutsType struct {
+	timeouts.Type
+}
+
+func (t resourceTimeoutsType) String() string {
+	return "providerfwk.resourceTimeoutsType"
+}
+
+func (t resourceTimeoutsType) ValueFromObject(
+	_ context.Context, in basetypes.ObjectValue,
+) (
+	basetypes.ObjectValuable, diag.Diagnostics,
+) {
+	return resourceTimeouts{
+		Value: timeouts.Value{
+			Object: in,
+		},
+	}, nil
+}
+
+func (t resourceTimeoutsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	val, err := t.Type.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	timeoutsValue, ok := val.(timeouts.Value)
+	if !ok {
+		return nil, fmt.Errorf("%T cannot be used as timeouts.Value", val)
+	}
+
+	return resourceTimeouts{
+		Value: timeoutsValue,
+	}, nil
+}
+
+func (t resourceTimeoutsType) ValueType(context.Context) attr.Value {
+	return resourceTimeouts{}
+}
+
+func (t resourceTimeoutsType) Equal(candidate attr.Type) bool {
+	other, ok := candidate.(resourceTimeoutsType)
+	if !ok {
+		return false
+	}
+
+	return t.Type.Equal(other.Type)
+}
+
+// resourceTimeouts is the value of `timeouts` block in resources.
+// Unlike timeouts.Value, the zero value is a valid null value so data of resource
+// generated from Junos configuration (read, import, upgrade state) doesn't need to set it.
+type resourceTimeouts struct {
+	timeouts.Value
+}
+
+// object returns the object value with the attribute types even for the zero value.
+func (v resourceTimeouts) object(ctx context.Context) types.Object {
+	if v.Object.IsNull() && len(v.Object.AttributeTypes(ctx)) == 0 {
+		return types.ObjectNull(resourceTimeoutsAttrTypes())
+	}
+
+	return v.Object
+}
+
+func (v resourceTimeouts) Equal(candidate attr.Value) bool {
+	other, ok := candidate.(resourceTimeouts)
+	if !ok {
+		return false
+	}
+
+	return v.object(context.Background()).Equal(other.object(context.Background()))
+}
+
+func (v resourceTimeouts) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	return v.object(ctx), nil
+}
+
+func (v resourceTimeouts) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	return v.object(ctx).ToTerraformValue(ctx)
+}
+
+func (v resourceTimeouts) Type(ctx context.Context) attr.Type {
+	return resourceTimeoutsType{
+		Type: timeouts.Type{
+			ObjectType: types.ObjectType{
+				AttrTypes: v.object(ctx).AttributeTypes(ctx),
+			},
+		},
+	}
+}
+
+func resourceTimeoutsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"create": types.StringType,
+		"read":   types.StringType,
+		"update": types.StringType,
+		"delete": types.StringType,
+	}
+}
+
+// resourceDataTimeouts returns the value of `timeouts` block in data of resource
+// with the schema of resource state.
+func resourceDataTimeouts(
+	ctx context.Context, state tfsdk.State, data interface{},
+) (
+	value resourceTimeouts, diags diag.Diagnostics,
+) {
+	dataState := tfsdk.State{
+		Schema: state.Schema,
+	}
+	diags.Append(dataState.Set(ctx, data)...)
+	if diags.HasError() {
+		return value, diags
+	}
+	diags.Append(dataState.GetAttribute(ctx, path.Root("timeouts"), &value)...)
+
+	return value, diags
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/jeremmfr/terraform-provider-junos/internal/version"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -40,20 +41,42 @@ type junosProviderModel struct {
 	SSHKeyPem           types.String `tfsdk:"sshkey_pem"`
 	SSHKeyFile          types.String `tfsdk:"sshkeyfile"`
 	SSHKeyPass          types.String `tfsdk:"keypass"`
+	SSHCertPem          types.String `tfsdk:"sshcert_pem"`
+	SSHCertFile         types.String `tfsdk:"sshcertfile"`
+	CredentialHelper    types.String `tfsdk:"credential_helper"`
 	GroupIntDel         types.String `tfsdk:"group_interface_delete"`
+	IntReleasePolicy    types.String `tfsdk:"interface_release_policy"`
+	IntReleaseTemplate  types.String `tfsdk:"interface_release_template"`
 	CmdSleepShort       types.Int64  `tfsdk:"cmd_sleep_short"`
 	CmdSleepLock        types.Int64  `tfsdk:"cmd_sleep_lock"`
+	CommitTimeout       types.Int64  `tfsdk:"commit_timeout"`
 	SleepSSHClosed      types.Int64  `tfsdk:"ssh_sleep_closed"`
 	SSHCiphers          types.List   `tfsdk:"ssh_ciphers"`
+	SSHAuthMethods      types.List   `tfsdk:"ssh_auth_methods"`
 	SSHTimeoutToEstab   types.Int64  `tfsdk:"ssh_timeout_to_establish"`
 	SSHRetryToEstab     types.Int64  `tfsdk:"ssh_retry_to_establish"`
+	SSHProxy            types.String `tfsdk:"ssh_proxy"`
+	SSHJumpHost         types.List   `tfsdk:"ssh_jump_host"`
 	FilePermission      types.String `tfsdk:"file_permission"`
 	DebugNetconfLogPath types.String `tfsdk:"debug_netconf_log_path"`
+	MetricsPath         types.String `tfsdk:"metrics_path"`
+	AuditJournalPath    types.String `tfsdk:"audit_journal_path"`
 	FakeCreateSetFile   types.String `tfsdk:"fake_create_with_setfile"`
 	FakeUpdateAlso      types.Bool   `tfsdk:"fake_update_also"`
 	FakeDeleteAlso      types.Bool   `tfsdk:"fake_delete_also"`
 }
 
+type junosProviderModelSSHJumpHost struct {
+	Address    types.String `tfsdk:"address"`
+	Port       types.Int64  `tfsdk:"port"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+	SSHKeyPem  types.String `tfsdk:"sshkey_pem"`
+	SSHKeyFile types.String `tfsdk:"sshkeyfile"`
+	SSHKeyPass types.String `tfsdk:"keypass"`
+	HostKey    types.String `tfsdk:"host_key"`
+}
+
 const (
 	providerName = "junos"
 )
@@ -109,11 +132,56 @@ func (p *junosProvider) Schema(
 				Description: "This is the passphrase for open `sshkeyfile` or `sshkey_pem`." +
 					" May also be provided via " + junos.EnvKeyPass + " environment variable.",
 			},
+			"sshcert_pem": schema.StringAttribute{
+				Optional: true,
+				Description: "This is the OpenSSH certificate paired with the ssh key for establish ssh connection." +
+					" May also be provided via " + junos.EnvCertPem + " environment variable.",
+			},
+			"sshcertfile": schema.StringAttribute{
+				Optional: true,
+				Description: "This is the path to OpenSSH certificate paired with the ssh key for establish ssh connection." +
+					" May also be provided via " + junos.EnvCertFile + " environment variable.",
+			},
+			"credential_helper": schema.StringAttribute{
+				Optional: true,
+				Description: "Path to a credential helper to read username, password and ssh key for the Junos device:" +
+					" a JSON file (with `.json` extension) with an object per host (and `*` as fallback)" +
+					" or an executable called with host as argument which prints a JSON object." +
+					" May also be provided via " + junos.EnvCredentialHelper + " environment variable.",
+			},
 			"group_interface_delete": schema.StringAttribute{
 				Optional: true,
 				Description: "This is the Junos group used to remove configuration on a physical interface." +
 					" May also be provided via " + junos.EnvGroupInterfaceDelete + " environment variable.",
 			},
+			"interface_release_policy": schema.StringAttribute{
+				Optional: true,
+				Description: "Policy to release a physical interface when destroy a `junos_interface_physical` resource:" +
+					" `disable` (default, set `description NC` and `disable`" +
+					" or apply the group in `group_interface_delete` if set)," +
+					" `delete` (delete all configurations), `group` (apply the group in `group_interface_delete`)," +
+					" `snapshot` (restore the configuration captured when creating the resource," +
+					" configured interfaces are only taken with `adopt_configured` on the resource)" +
+					" or `template` (set the lines in `interface_release_template`)." +
+					" Interfaces with only these lines are considered as not configured." +
+					" Logical interfaces are always deleted, so `snapshot` is handled like `delete` for them." +
+					" May also be provided via " + junos.EnvInterfaceReleasePolicy + " environment variable.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						junos.InterfaceReleaseDisable,
+						junos.InterfaceReleaseDelete,
+						junos.InterfaceReleaseGroup,
+						junos.InterfaceReleaseSnapshot,
+						junos.InterfaceReleaseTemplate,
+					),
+				},
+			},
+			"interface_release_template": schema.StringAttribute{
+				Optional: true,
+				Description: "Set lines relative to the interface (one per line, like `set disable`)" +
+					" to release a physical interface with the `template` policy." +
+					" May also be provided via " + junos.EnvInterfaceReleaseTemplate + " environment variable.",
+			},
 			"cmd_sleep_short": schema.Int64Attribute{
 				Optional: true,
 				Description: "Milliseconds to wait after Terraform  provider executes an action on the Junos device." +
@@ -122,9 +190,19 @@ func (p *junosProvider) Schema(
 			"cmd_sleep_lock": schema.Int64Attribute{
 				Optional: true,
 				Description: "Seconds of standby while waiting for Terraform provider " +
-					"to lock candidate configuration on a Junos device." +
+					"to lock candidate configuration on a Junos device" +
+					" (exponential backoff with jitter between tries if not set)." +
 					" May also be provided via " + junos.EnvSleepLock + " environment variable.",
 			},
+			"commit_timeout": schema.Int64Attribute{
+				Optional: true,
+				Description: "Seconds to wait for the commit of candidate configuration on a Junos device " +
+					"before aborting the NETCONF session (no timeout by default)." +
+					" May also be provided via " + junos.EnvCommitTimeout + " environment variable.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"ssh_sleep_closed": schema.Int64Attribute{
 				Optional: true,
 				Description: "Seconds to wait after Terraform provider closed a ssh connection." +
@@ -135,6 +213,20 @@ func (p *junosProvider) Schema(
 				Optional:    true,
 				Description: "Ciphers used in SSH connection.",
 			},
+			"ssh_auth_methods": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Authentication methods to try in order in SSH connection.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(
+						stringvalidator.OneOf(
+							junos.SSHAuthMethodPublicKey,
+							junos.SSHAuthMethodPassword,
+							junos.SSHAuthMethodKeyboardInteractive,
+						),
+					),
+				},
+			},
 			"ssh_timeout_to_establish": schema.Int64Attribute{
 				Optional: true,
 				Description: "Seconds to wait for establishing TCP connections when initiating SSH connections." +
@@ -143,12 +235,18 @@ func (p *junosProvider) Schema(
 			"ssh_retry_to_establish": schema.Int64Attribute{
 				Optional: true,
 				Description: "Number of retries to establish SSH connections." +
-					"The provider waits after each try, with the sleep time increasing by 1 second each time." +
+					"The provider waits after each try, with an exponential backoff and jitter between tries." +
 					" May also be provided via " + junos.EnvSSHRetryToEstablish + " environment variable.",
 				Validators: []validator.Int64{
 					int64validator.Between(1, 10),
 				},
 			},
+			"ssh_proxy": schema.StringAttribute{
+				Optional: true,
+				Description: "URL of proxy used to reach SSH target (first jump host or Junos device)" +
+					" with `socks5://`, `socks5h://` or `http://` (HTTP CONNECT) scheme." +
+					" May also be provided via " + junos.EnvSSHProxy + " environment variable.",
+			},
 			"file_permission": schema.StringAttribute{
 				Optional: true,
 				Description: "The permission to set for the created file (debug, setfile)." +
@@ -159,6 +257,21 @@ func (p *junosProvider) Schema(
 				Description: "More detailed log (netconf) in the specified file." +
 					" May also be provided via " + junos.EnvLogPath + " environment variable.",
 			},
+			"metrics_path": schema.StringAttribute{
+				Optional: true,
+				Description: "Write metrics of netconf exchanges (session establishment, rpc latency, lock wait, commit duration" +
+					" and retries) in the specified file when the provider shuts down," +
+					" in JSON format if the file has the `.json` extension, otherwise in OpenMetrics text format." +
+					" The metrics are added to those already in the file." +
+					" May also be provided via " + junos.EnvMetricsPath + " environment variable.",
+			},
+			"audit_journal_path": schema.StringAttribute{
+				Optional: true,
+				Description: "Append a JSON line for each commit in the specified file (audit journal) with" +
+					" the device, the resource type and ID, the operation, the set/delete lines (with secrets masked)," +
+					" the commit result, warnings and revision." +
+					" May also be provided via " + junos.EnvAuditJournalPath + " environment variable.",
+			},
 			"fake_create_with_setfile": schema.StringAttribute{
 				Optional: true,
 				Description: "The normal process to create resources skipped to generate set lines, " +
@@ -181,6 +294,54 @@ func (p *junosProvider) Schema(
 					" May also be provided via " + junos.EnvFakedeleteAlso + " environment variable.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"ssh_jump_host": schema.ListNestedBlock{
+				Description: "For each SSH jump host to chain (in order) before reaching Junos device.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Required:    true,
+							Description: "Address (ip or dns name) of jump host.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"port": schema.Int64Attribute{
+							Optional:    true,
+							Description: "TCP port of jump host (default 22).",
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"username": schema.StringAttribute{
+							Optional:    true,
+							Description: "Username for ssh connection on jump host (default same as `username`).",
+						},
+						"password": schema.StringAttribute{
+							Optional:    true,
+							Description: "Password for ssh connection on jump host.",
+						},
+						"sshkey_pem": schema.StringAttribute{
+							Optional:    true,
+							Description: "SSH key in PEM format for ssh connection on jump host.",
+						},
+						"sshkeyfile": schema.StringAttribute{
+							Optional:    true,
+							Description: "Path to SSH key for ssh connection on jump host.",
+						},
+						"keypass": schema.StringAttribute{
+							Optional:    true,
+							Description: "Passphrase for open `sshkeyfile` or `sshkey_pem` of jump host.",
+						},
+						"host_key": schema.StringAttribute{
+							Optional: true,
+							Description: "Public key of jump host (in authorized_keys format) to verify its identity." +
+								" Without it, the key of jump host is not verified.",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -188,11 +349,25 @@ func (p *junosProvider) DataSources(_ context.Context) []func() datasource.DataS
 	return []func() datasource.DataSource{
 		newApplicationSetsDataSource,
 		newApplicationsDataSource,
+		newBgpNeighborsInfoDataSource,
+		newChassisInventoryDataSource,
+		newChassisStatusDataSource,
+		newIPNeighborsDataSource,
 		newInterfaceLogicalDataSource,
 		newInterfaceLogicalInfoDataSource,
 		newInterfacePhysicalDataSource,
+		newInterfacePhysicalInfoDataSource,
 		newInterfacesPhysicalPresentDataSource,
+		newIsisAdjacenciesDataSource,
+		newLldpNeighborsDataSource,
+		newOspfNeighborsDataSource,
+		newRoutesDataSource,
 		newRoutingInstanceDataSource,
+		newSecurityIpsecVpnInfoDataSource,
+		newSecurityMatchPoliciesDataSource,
+		newSecurityNatRulesHitCountDataSource,
+		newSecurityPkiCertificatesDataSource,
+		newSecurityPoliciesHitCountDataSource,
 		newSecurityZoneDataSource,
 	}
 }
@@ -203,15 +378,19 @@ func (p *junosProvider) Resources(_ context.Context) []func() resource.Resource
 		newApplicationResource,
 		newBgpGroupResource,
 		newBgpNeighborResource,
+		newChassisFpcPicPortResource,
 		newFirewallFilterResource,
 		newFirewallPolicerResource,
 		newForwardingoptionsSamplingResource,
 		newForwardingoptionsSamplingInstanceResource,
+		newIgmpInterfaceResource,
 		newInterfaceLogicalResource,
 		newInterfacePhysicalDisableResource,
 		newInterfacePhysicalResource,
 		newInterfaceSt0UnitResource,
+		newMldInterfaceResource,
 		newOamGretunnelInterfaceResource,
+		newPimResource,
 		newPolicyoptionsASPathResource,
 		newPolicyoptionsASPathGroupResource,
 		newPolicyoptionsCommunityResource,
@@ -229,10 +408,14 @@ func (p *junosProvider) Resources(_ context.Context) []func() resource.Resource
 		newSecurityIpsecVpnResource,
 		newSecurityNatDestinationResource,
 		newSecurityNatDestinationPoolResource,
+		newSecurityNatProxyArpResource,
+		newSecurityNatProxyNdpResource,
 		newSecurityNatSourceResource,
 		newSecurityNatSourcePoolResource,
 		newSecurityNatStaticResource,
 		newSecurityNatStaticRuleResource,
+		newSecurityPkiCaProfileResource,
+		newSecurityPkiLocalCertificateResource,
 		newSecurityPolicyResource,
 		newSecurityPolicyTunnelPairPolicyResource,
 		newSecurityZoneResource,
@@ -240,6 +423,8 @@ func (p *junosProvider) Resources(_ context.Context) []func() resource.Resource
 		newSecurityZoneBookAddressSetResource,
 		newServicesFlowMonitoringV9TemplateResource,
 		newServicesFlowMonitoringVIPFixTemplateResource,
+		newServicesSSLProxyProfileResource,
+		newVirtualChassisResource,
 	}
 }
 
@@ -323,6 +508,36 @@ func (p *junosProvider) Configure(
 				"or use the "+junos.EnvKeyPass+" environment variable.",
 		)
 	}
+	if config.SSHCertPem.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sshcert_pem"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			"The provider cannot create the Junos client as there is an unknown configuration value "+
+				"for 'sshcert_pem' attribute. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, "+
+				"or use the "+junos.EnvCertPem+" environment variable.",
+		)
+	}
+	if config.SSHCertFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sshcertfile"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			"The provider cannot create the Junos client as there is an unknown configuration value "+
+				"for 'sshcertfile' attribute. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, "+
+				"or use the "+junos.EnvCertFile+" environment variable.",
+		)
+	}
+	if config.CredentialHelper.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("credential_helper"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			"The provider cannot create the Junos client as there is an unknown configuration value "+
+				"for 'credential_helper' attribute. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, "+
+				"or use the "+junos.EnvCredentialHelper+" environment variable.",
+		)
+	}
 	if config.GroupIntDel.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("group_interface_delete"),
@@ -333,6 +548,26 @@ func (p *junosProvider) Configure(
 				"or use the "+junos.EnvGroupInterfaceDelete+" environment variable.",
 		)
 	}
+	if config.IntReleasePolicy.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("interface_release_policy"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			"The provider cannot create the Junos client as there is an unknown configuration value "+
+				"for 'interface_release_policy' attribute. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, "+
+				"or use the "+junos.EnvInterfaceReleasePolicy+" environment variable.",
+		)
+	}
+	if config.IntReleaseTemplate.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("interface_release_template"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			"The provider cannot create the Junos client as there is an unknown configuration value "+
+				"for 'interface_release_template' attribute. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, "+
+				"or use the "+junos.EnvInterfaceReleaseTemplate+" environment variable.",
+		)
+	}
 	if config.CmdSleepShort.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("cmd_sleep_short"),
@@ -353,6 +588,16 @@ func (p *junosProvider) Configure(
 				"or use the "+junos.EnvSleepLock+" environment variable.",
 		)
 	}
+	if config.CommitTimeout.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("commit_timeout"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			"The provider cannot create the Junos client as there is an unknown configuration value "+
+				"for 'commit_timeout' attribute. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, "+
+				"or use the "+junos.EnvCommitTimeout+" environment variable.",
+		)
+	}
 	if config.SleepSSHClosed.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("ssh_sleep_closed"),
@@ -383,6 +628,26 @@ func (p *junosProvider) Configure(
 			)
 		}
 	}
+	if config.SSHAuthMethods.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ssh_auth_methods"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			"The provider cannot create the Junos client as there is an unknown configuration value "+
+				"for 'ssh_auth_methods' attribute. "+
+				"Either target apply the source of the value first, set the value statically in the configuration.",
+		)
+	}
+	for _, v := range config.SSHAuthMethods.Elements() {
+		if v.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ssh_auth_methods"),
+				tfdiag.UnknownJunosAttrErrSummary,
+				"The provider cannot create the Junos client as there is an unknown configuration value "+
+					"for 'ssh_auth_methods' attribute. "+
+					"Either target apply the source of the value first, set the value statically in the configuration.",
+			)
+		}
+	}
 	if config.SSHTimeoutToEstab.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("ssh_timeout_to_establish"),
@@ -403,6 +668,44 @@ func (p *junosProvider) Configure(
 				"or use the "+junos.EnvSSHRetryToEstablish+" environment variable.",
 		)
 	}
+	if config.SSHProxy.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ssh_proxy"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			"The provider cannot create the Junos client as there is an unknown configuration value "+
+				"for 'ssh_proxy' attribute. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, "+
+				"or use the "+junos.EnvSSHProxy+" environment variable.",
+		)
+	}
+	var sshJumpHosts []junosProviderModelSSHJumpHost
+	if config.SSHJumpHost.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ssh_jump_host"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			"The provider cannot create the Junos client as there is an unknown configuration value "+
+				"for 'ssh_jump_host' block. "+
+				"Either target apply the source of the value first, set the value statically in the configuration.",
+		)
+	} else {
+		resp.Diagnostics.Append(config.SSHJumpHost.ElementsAs(ctx, &sshJumpHosts, false)...)
+		for _, jumpHost := range sshJumpHosts {
+			if jumpHost.Address.IsUnknown() || jumpHost.Port.IsUnknown() ||
+				jumpHost.Username.IsUnknown() || jumpHost.Password.IsUnknown() ||
+				jumpHost.SSHKeyPem.IsUnknown() || jumpHost.SSHKeyFile.IsUnknown() ||
+				jumpHost.SSHKeyPass.IsUnknown() || jumpHost.HostKey.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("ssh_jump_host"),
+					tfdiag.UnknownJunosAttrErrSummary,
+					"The provider cannot create the Junos client as there is an unknown configuration value "+
+						"in 'ssh_jump_host' block. "+
+						"Either target apply the source of the value first, set the value statically in the configuration.",
+				)
+
+				break
+			}
+		}
+	}
 	if config.FilePermission.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("file_permission"),
@@ -423,6 +726,26 @@ func (p *junosProvider) Configure(
 				"or use the "+junos.EnvLogPath+" environment variable.",
 		)
 	}
+	if config.MetricsPath.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("metrics_path"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			"The provider cannot create the Junos client as there is an unknown configuration value "+
+				"for 'metrics_path' attribute. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, "+
+				"or use the "+junos.EnvMetricsPath+" environment variable.",
+		)
+	}
+	if config.AuditJournalPath.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("audit_journal_path"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			"The provider cannot create the Junos client as there is an unknown configuration value "+
+				"for 'audit_journal_path' attribute. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, "+
+				"or use the "+junos.EnvAuditJournalPath+" environment variable.",
+		)
+	}
 	if config.FakeCreateSetFile.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("fake_create_with_setfile"),
@@ -542,13 +865,118 @@ func (p *junosProvider) Configure(
 		client.WithSSHKeyPassphrase(v)
 	}
 
+	if !config.SSHCertPem.IsNull() {
+		client.WithSSHCertificatePEM(config.SSHCertPem.ValueString())
+	} else if v := os.Getenv(junos.EnvCertPem); v != "" {
+		client.WithSSHCertificatePEM(v)
+	}
+
+	if !config.SSHCertFile.IsNull() {
+		certFile := config.SSHCertFile.ValueString()
+		if err := utils.ReplaceTildeToHomeDir(&certFile); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("sshcertfile"),
+				"Bad value in sshcertfile",
+				fmt.Sprintf("Error to use value in sshcertfile attribute: %s\n"+
+					"So the attribute is not used", err),
+			)
+		} else {
+			client.WithSSHCertificateFile(certFile)
+		}
+	} else if v := os.Getenv(junos.EnvCertFile); v != "" {
+		if err := utils.ReplaceTildeToHomeDir(&v); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("sshcertfile"),
+				"Bad value in "+junos.EnvCertFile,
+				fmt.Sprintf("Error to use value in "+junos.EnvCertFile+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			)
+		} else {
+			client.WithSSHCertificateFile(v)
+		}
+	}
+
+	if !config.CredentialHelper.IsNull() {
+		credentialHelper := config.CredentialHelper.ValueString()
+		if err := utils.ReplaceTildeToHomeDir(&credentialHelper); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("credential_helper"),
+				"Bad value in credential_helper",
+				fmt.Sprintf("Error to use value in credential_helper attribute: %s\n"+
+					"So the attribute is not used", err),
+			)
+		} else {
+			client.WithCredentialHelper(credentialHelper)
+		}
+	} else if v := os.Getenv(junos.EnvCredentialHelper); v != "" {
+		if err := utils.ReplaceTildeToHomeDir(&v); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("credential_helper"),
+				"Bad value in "+junos.EnvCredentialHelper,
+				fmt.Sprintf("Error to use value in "+junos.EnvCredentialHelper+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			)
+		} else {
+			client.WithCredentialHelper(v)
+		}
+	}
+
 	if !config.GroupIntDel.IsNull() {
 		client.WithGroupInterfaceDelete(config.GroupIntDel.ValueString())
 	} else if v := os.Getenv(junos.EnvGroupInterfaceDelete); v != "" {
 		client.WithGroupInterfaceDelete(v)
 	}
 
-	client.WithSleepShort(100) // default value for cmd_sleep_short
+	if !config.IntReleasePolicy.IsNull() {
+		if _, err := client.WithInterfaceReleasePolicy(config.IntReleasePolicy.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("interface_release_policy"),
+				"Bad value in interface_release_policy",
+				fmt.Sprintf("Error to use value in 'interface_release_policy' attribute: %s", err),
+			)
+
+			return
+		}
+	} else if v := os.Getenv(junos.EnvInterfaceReleasePolicy); v != "" {
+		if _, err := client.WithInterfaceReleasePolicy(v); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("interface_release_policy"),
+				"Bad value in "+junos.EnvInterfaceReleasePolicy,
+				fmt.Sprintf("Error to use value in "+junos.EnvInterfaceReleasePolicy+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			)
+		}
+	}
+	if !config.IntReleaseTemplate.IsNull() {
+		if _, err := client.WithInterfaceReleaseTemplate(config.IntReleaseTemplate.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("interface_release_template"),
+				"Bad value in interface_release_template",
+				fmt.Sprintf("Error to use value in 'interface_release_template' attribute: %s", err),
+			)
+
+			return
+		}
+	} else if v := os.Getenv(junos.EnvInterfaceReleaseTemplate); v != "" {
+		if _, err := client.WithInterfaceReleaseTemplate(v); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("interface_release_template"),
+				"Bad value in "+junos.EnvInterfaceReleaseTemplate,
+				fmt.Sprintf("Error to use value in "+junos.EnvInterfaceReleaseTemplate+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			)
+		}
+	}
+	if err := client.CheckInterfaceRelease(); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("interface_release_policy"),
+			"Bad value in interface_release_policy",
+			fmt.Sprintf("Error to use value in 'interface_release_policy' attribute: %s", err),
+		)
+
+		return
+	}
+
 	if !config.CmdSleepShort.IsNull() {
 		client.WithSleepShort(int(config.CmdSleepShort.ValueInt64()))
 	} else if v := os.Getenv(junos.EnvSleepShort); v != "" {
@@ -565,7 +993,6 @@ func (p *junosProvider) Configure(
 		}
 	}
 
-	client.WithSleepLock(10) // default value for cmd_sleep_lock
 	if !config.CmdSleepLock.IsNull() {
 		client.WithSleepLock(int(config.CmdSleepLock.ValueInt64()))
 	} else if v := os.Getenv(junos.EnvSleepLock); v != "" {
@@ -582,6 +1009,30 @@ func (p *junosProvider) Configure(
 		}
 	}
 
+	if !config.CommitTimeout.IsNull() {
+		client.WithCommitTimeout(int(config.CommitTimeout.ValueInt64()))
+	} else if v := os.Getenv(junos.EnvCommitTimeout); v != "" {
+		d, err := strconv.Atoi(v)
+		switch {
+		case err != nil:
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("commit_timeout"),
+				"Error to parse "+junos.EnvCommitTimeout,
+				fmt.Sprintf("Error to parse value in "+junos.EnvCommitTimeout+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			)
+		case d < 1:
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("commit_timeout"),
+				"Bad value in "+junos.EnvCommitTimeout,
+				fmt.Sprintf("Bad value in "+junos.EnvCommitTimeout+" environment variable: %d must be at least 1\n"+
+					"So the variable is not used", d),
+			)
+		default:
+			client.WithCommitTimeout(d)
+		}
+	}
+
 	if !config.SleepSSHClosed.IsNull() {
 		client.WithSleepSSHClosed(int(config.SleepSSHClosed.ValueInt64()))
 	} else if v := os.Getenv(junos.EnvSleepSSHClosed); v != "" {
@@ -607,6 +1058,23 @@ func (p *junosProvider) Configure(
 		client.WithSSHCiphers(sshCiphers)
 	}
 
+	_, _ = client.WithSSHAuthMethods(junos.DefaultSSHAuthMethods())
+	if !config.SSHAuthMethods.IsNull() && len(config.SSHAuthMethods.Elements()) > 0 {
+		sshAuthMethods := make([]string, len(config.SSHAuthMethods.Elements()))
+		for i, v := range config.SSHAuthMethods.Elements() {
+			sshAuthMethods[i] = v.(types.String).ValueString()
+		}
+		if _, err := client.WithSSHAuthMethods(sshAuthMethods); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ssh_auth_methods"),
+				"Bad value in ssh_auth_methods",
+				fmt.Sprintf("Error to use value in 'ssh_auth_methods' attribute: %s", err),
+			)
+
+			return
+		}
+	}
+
 	if !config.SSHTimeoutToEstab.IsNull() {
 		client.WithSSHTimeoutToEstablish(int(config.SSHTimeoutToEstab.ValueInt64()))
 	} else if v := os.Getenv(junos.EnvSSHTimeoutToEstablish); v != "" {
@@ -654,6 +1122,51 @@ func (p *junosProvider) Configure(
 		}
 	}
 
+	if !config.SSHProxy.IsNull() {
+		if _, err := client.WithSSHProxy(config.SSHProxy.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ssh_proxy"),
+				"Bad value in ssh_proxy",
+				fmt.Sprintf("Error to use value in 'ssh_proxy' attribute: %s", err),
+			)
+
+			return
+		}
+	} else if v := os.Getenv(junos.EnvSSHProxy); v != "" {
+		if _, err := client.WithSSHProxy(v); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ssh_proxy"),
+				"Bad value in "+junos.EnvSSHProxy,
+				fmt.Sprintf("Error to use value in "+junos.EnvSSHProxy+" environment variable: %s", err),
+			)
+
+			return
+		}
+	}
+
+	for _, jumpHost := range sshJumpHosts {
+		keyFile := jumpHost.SSHKeyFile.ValueString()
+		if err := utils.ReplaceTildeToHomeDir(&keyFile); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ssh_jump_host"),
+				"Bad value in ssh_jump_host",
+				fmt.Sprintf("Error to use value in 'sshkeyfile' attribute of ssh_jump_host: %s", err),
+			)
+
+			return
+		}
+		client.WithSSHJumpHost(junos.SSHJumpHost{
+			Address:    jumpHost.Address.ValueString(),
+			Port:       int(jumpHost.Port.ValueInt64()),
+			Username:   jumpHost.Username.ValueString(),
+			Password:   jumpHost.Password.ValueString(),
+			SSHKeyPEM:  jumpHost.SSHKeyPem.ValueString(),
+			SSHKeyFile: keyFile,
+			SSHKeyPass: jumpHost.SSHKeyPass.ValueString(),
+			HostKey:    jumpHost.HostKey.ValueString(),
+		})
+	}
+
 	_, _ = client.WithFilePermission(0o644) // default value for file_permission
 	if !config.FilePermission.IsNull() {
 		filePerm, err := strconv.ParseInt(config.FilePermission.ValueString(), 8, 64)
@@ -720,6 +1233,56 @@ func (p *junosProvider) Configure(
 		}
 	}
 
+	if !config.MetricsPath.IsNull() {
+		metricsPath := config.MetricsPath.ValueString()
+		if err := utils.ReplaceTildeToHomeDir(&metricsPath); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("metrics_path"),
+				"Bad value in metrics_path",
+				fmt.Sprintf("Error to use value in metrics_path attribute: %s\n"+
+					"So the attribute is not used", err),
+			)
+		} else {
+			client.WithMetricsFile(metricsPath)
+		}
+	} else if v := os.Getenv(junos.EnvMetricsPath); v != "" {
+		if err := utils.ReplaceTildeToHomeDir(&v); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("metrics_path"),
+				"Bad value in "+junos.EnvMetricsPath,
+				fmt.Sprintf("Error to use value in "+junos.EnvMetricsPath+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			)
+		} else {
+			client.WithMetricsFile(v)
+		}
+	}
+
+	if !config.AuditJournalPath.IsNull() {
+		journalPath := config.AuditJournalPath.ValueString()
+		if err := utils.ReplaceTildeToHomeDir(&journalPath); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("audit_journal_path"),
+				"Bad value in audit_journal_path",
+				fmt.Sprintf("Error to use value in audit_journal_path attribute: %s\n"+
+					"So the attribute is not used", err),
+			)
+		} else {
+			client.WithAuditJournalFile(journalPath)
+		}
+	} else if v := os.Getenv(junos.EnvAuditJournalPath); v != "" {
+		if err := utils.ReplaceTildeToHomeDir(&v); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("audit_journal_path"),
+				"Bad value in "+junos.EnvAuditJournalPath,
+				fmt.Sprintf("Error to use value in "+junos.EnvAuditJournalPath+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			)
+		} else {
+			client.WithAuditJournalFile(v)
+		}
+	}
+
 	if !config.FakeCreateSetFile.IsNull() {
 		setFile := config.FakeCreateSetFile.ValueString()
 		if err := utils.ReplaceTildeToHomeDir(&setFile); err != nil {
@@ -75,7 +75,7 @@ func (rsc *application) Configure(
 }
 
 func (rsc *application) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -293,6 +293,7 @@ func (rsc *application) Schema(
 					},
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -311,22 +312,24 @@ type applicationData struct {
 	SourcePort             types.String           `tfsdk:"source_port"`
 	UUID                   types.String           `tfsdk:"uuid"`
 	Term                   []applicationBlockTerm `tfsdk:"term"`
+	Timeouts               resourceTimeouts       `tfsdk:"timeouts"`
 }
 
 type applicationConfig struct {
-	InactivityTimeoutNever types.Bool   `tfsdk:"inactivity_timeout_never"`
-	ID                     types.String `tfsdk:"id"`
-	Name                   types.String `tfsdk:"name"`
-	ApplicationProtocol    types.String `tfsdk:"application_protocol"`
-	Description            types.String `tfsdk:"description"`
-	DestinationPort        types.String `tfsdk:"destination_port"`
-	EtherType              types.String `tfsdk:"ether_type"`
-	InactivityTimeout      types.Int64  `tfsdk:"inactivity_timeout"`
-	Protocol               types.String `tfsdk:"protocol"`
-	RPCProgramNumber       types.String `tfsdk:"rpc_program_number"`
-	SourcePort             types.String `tfsdk:"source_port"`
-	UUID                   types.String `tfsdk:"uuid"`
-	Term                   types.List   `tfsdk:"term"`
+	InactivityTimeoutNever types.Bool       `tfsdk:"inactivity_timeout_never"`
+	ID                     types.String     `tfsdk:"id"`
+	Name                   types.String     `tfsdk:"name"`
+	ApplicationProtocol    types.String     `tfsdk:"application_protocol"`
+	Description            types.String     `tfsdk:"description"`
+	DestinationPort        types.String     `tfsdk:"destination_port"`
+	EtherType              types.String     `tfsdk:"ether_type"`
+	InactivityTimeout      types.Int64      `tfsdk:"inactivity_timeout"`
+	Protocol               types.String     `tfsdk:"protocol"`
+	RPCProgramNumber       types.String     `tfsdk:"rpc_program_number"`
+	SourcePort             types.String     `tfsdk:"source_port"`
+	UUID                   types.String     `tfsdk:"uuid"`
+	Term                   types.List       `tfsdk:"term"`
+	Timeouts               resourceTimeouts `tfsdk:"timeouts"`
 }
 
 type applicationBlockTerm struct {
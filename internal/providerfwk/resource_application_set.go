@@ -72,7 +72,7 @@ func (rsc *applicationSet) Configure(
 }
 
 func (rsc *applicationSet) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -128,23 +128,28 @@ func (rsc *applicationSet) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
 type applicationSetData struct {
-	ID             types.String   `tfsdk:"id"`
-	Name           types.String   `tfsdk:"name"`
-	Applications   []types.String `tfsdk:"applications"`
-	ApplicationSet []types.String `tfsdk:"application_set"`
-	Description    types.String   `tfsdk:"description"`
+	ID             types.String     `tfsdk:"id"`
+	Name           types.String     `tfsdk:"name"`
+	Applications   []types.String   `tfsdk:"applications"`
+	ApplicationSet []types.String   `tfsdk:"application_set"`
+	Description    types.String     `tfsdk:"description"`
+	Timeouts       resourceTimeouts `tfsdk:"timeouts"`
 }
 
 type applicationSetConfig struct {
-	ID             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	Applications   types.List   `tfsdk:"applications"`
-	ApplicationSet types.List   `tfsdk:"application_set"`
-	Description    types.String `tfsdk:"description"`
+	ID             types.String     `tfsdk:"id"`
+	Name           types.String     `tfsdk:"name"`
+	Applications   types.List       `tfsdk:"applications"`
+	ApplicationSet types.List       `tfsdk:"application_set"`
+	Description    types.String     `tfsdk:"description"`
+	Timeouts       resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *applicationSet) ValidateConfig(
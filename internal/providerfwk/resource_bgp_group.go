@@ -80,7 +80,7 @@ func (rsc *bgpGroup) Configure(
 }
 
 func (rsc *bgpGroup) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -720,6 +720,7 @@ func (rsc *bgpGroup) Schema(
 					tfplanmodifier.BlockRemoveNull(),
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -812,6 +813,7 @@ type bgpGroupData struct {
 	FamilyInet                   []bgpBlockFamily              `tfsdk:"family_inet"`
 	FamilyInet6                  []bgpBlockFamily              `tfsdk:"family_inet6"`
 	GracefulRestart              *bgpBlockGracefulRestart      `tfsdk:"graceful_restart"`
+	Timeouts                     resourceTimeouts              `tfsdk:"timeouts"`
 }
 
 type bgpGroupConfig struct {
@@ -868,6 +870,7 @@ type bgpGroupConfig struct {
 	FamilyInet                   types.List                    `tfsdk:"family_inet"`
 	FamilyInet6                  types.List                    `tfsdk:"family_inet6"`
 	GracefulRestart              *bgpBlockGracefulRestart      `tfsdk:"graceful_restart"`
+	Timeouts                     resourceTimeouts              `tfsdk:"timeouts"`
 }
 
 func (rsc *bgpGroup) ValidateConfig(
@@ -80,7 +80,7 @@ func (rsc *bgpNeighbor) Configure(
 }
 
 func (rsc *bgpNeighbor) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -719,6 +719,7 @@ func (rsc *bgpNeighbor) Schema(
 					tfplanmodifier.BlockRemoveNull(),
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -811,6 +812,7 @@ type bgpNeighborData struct {
 	FamilyInet                   []bgpBlockFamily              `tfsdk:"family_inet"`
 	FamilyInet6                  []bgpBlockFamily              `tfsdk:"family_inet6"`
 	GracefulRestart              *bgpBlockGracefulRestart      `tfsdk:"graceful_restart"`
+	Timeouts                     resourceTimeouts              `tfsdk:"timeouts"`
 }
 
 type bgpNeighborConfig struct {
@@ -867,6 +869,7 @@ type bgpNeighborConfig struct {
 	FamilyInet                   types.List                    `tfsdk:"family_inet"`
 	FamilyInet6                  types.List                    `tfsdk:"family_inet6"`
 	GracefulRestart              *bgpBlockGracefulRestart      `tfsdk:"graceful_restart"`
+	Timeouts                     resourceTimeouts              `tfsdk:"timeouts"`
 }
 
 func (rsc *bgpNeighbor) ValidateConfig(
@@ -0,0 +1,458 @@
+package providerfwk
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdata"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	balt "github.com/jeremmfr/go-utils/basicalter"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &chassisFpcPicPort{}
+	_ resource.ResourceWithConfigure      = &chassisFpcPicPort{}
+	_ resource.ResourceWithValidateConfig = &chassisFpcPicPort{}
+	_ resource.ResourceWithImportState    = &chassisFpcPicPort{}
+)
+
+type chassisFpcPicPort struct {
+	client *junos.Client
+}
+
+func newChassisFpcPicPortResource() resource.Resource {
+	return &chassisFpcPicPort{}
+}
+
+func (rsc *chassisFpcPicPort) typeName() string {
+	return providerName + "_chassis_fpc_pic_port"
+}
+
+func (rsc *chassisFpcPicPort) junosName() string {
+	return "chassis fpc pic port"
+}
+
+func (rsc *chassisFpcPicPort) junosClient() *junos.Client {
+	return rsc.client
+}
+
+func (rsc *chassisFpcPicPort) Metadata(
+	_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse,
+) {
+	resp.TypeName = rsc.typeName()
+}
+
+func (rsc *chassisFpcPicPort) Configure(
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedResourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	rsc.client = client
+}
+
+func (rsc *chassisFpcPicPort) Schema(
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a speed or channelization configuration for a port in `chassis fpc pic` block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				Description: "An identifier for the resource with format " +
+					"`<fpc>" + junos.IDSeparator + "<pic>" + junos.IDSeparator + "<port>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fpc": schema.Int64Attribute{
+				Required:    true,
+				Description: "FPC slot number (member identifier on virtual-chassis).",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 255),
+				},
+			},
+			"pic": schema.Int64Attribute{
+				Required:    true,
+				Description: "PIC slot number.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 255),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Required:    true,
+				Description: "Port number.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 255),
+				},
+			},
+			"interface_name_match": schema.StringAttribute{
+				Computed: true,
+				Description: "A regexp matching the physical interface names resulting from the port " +
+					"(usable in `match_name` argument of `junos_interfaces_physical_present` data source).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_speed": schema.StringAttribute{
+				Optional:    true,
+				Description: "Port channelization speed.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(\d+g|disable-auto-speed-detection)$`),
+						"must be a speed like '10g' or 'disable-auto-speed-detection'"),
+				},
+			},
+			"number_of_sub_ports": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of sub-ports when channelized.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 8),
+				},
+			},
+			"speed": schema.StringAttribute{
+				Optional:    true,
+				Description: "Port speed.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d+g$`),
+						"must be a speed like '100g'"),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
+	}
+}
+
+type chassisFpcPicPortData struct {
+	ID                 types.String     `tfsdk:"id"`
+	Fpc                types.Int64      `tfsdk:"fpc"`
+	Pic                types.Int64      `tfsdk:"pic"`
+	Port               types.Int64      `tfsdk:"port"`
+	InterfaceNameMatch types.String     `tfsdk:"interface_name_match"`
+	ChannelSpeed       types.String     `tfsdk:"channel_speed"`
+	NumberOfSubPorts   types.Int64      `tfsdk:"number_of_sub_ports"`
+	Speed              types.String     `tfsdk:"speed"`
+	Timeouts           resourceTimeouts `tfsdk:"timeouts"`
+}
+
+func (rsc *chassisFpcPicPort) ValidateConfig(
+	ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse,
+) {
+	var config chassisFpcPicPortData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ChannelSpeed.IsNull() &&
+		config.NumberOfSubPorts.IsNull() &&
+		config.Speed.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("speed"),
+			tfdiag.MissingConfigErrSummary,
+			"at least one of channel_speed, number_of_sub_ports or speed must be specified",
+		)
+	}
+	if !config.ChannelSpeed.IsNull() && !config.Speed.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("channel_speed"),
+			tfdiag.ConflictConfigErrSummary,
+			"only one of channel_speed or speed can be specified",
+		)
+	}
+}
+
+func (rsc *chassisFpcPicPort) Create(
+	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
+) {
+	var plan chassisFpcPicPortData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceCreate(
+		ctx,
+		rsc,
+		func(fnCtx context.Context, junSess *junos.Session) bool {
+			portExists, err := checkChassisFpcPicPortExists(
+				fnCtx,
+				plan.Fpc.ValueInt64(),
+				plan.Pic.ValueInt64(),
+				plan.Port.ValueInt64(),
+				junSess,
+			)
+			if err != nil {
+				resp.Diagnostics.AddError(tfdiag.PreCheckErrSummary, err.Error())
+
+				return false
+			}
+			if portExists {
+				resp.Diagnostics.AddError(
+					tfdiag.DuplicateConfigErrSummary,
+					fmt.Sprintf(rsc.junosName()+" fpc %d pic %d port %d already exists",
+						plan.Fpc.ValueInt64(), plan.Pic.ValueInt64(), plan.Port.ValueInt64()),
+				)
+
+				return false
+			}
+
+			return true
+		},
+		func(fnCtx context.Context, junSess *junos.Session) bool {
+			portExists, err := checkChassisFpcPicPortExists(
+				fnCtx,
+				plan.Fpc.ValueInt64(),
+				plan.Pic.ValueInt64(),
+				plan.Port.ValueInt64(),
+				junSess,
+			)
+			if err != nil {
+				resp.Diagnostics.AddError(tfdiag.PostCheckErrSummary, err.Error())
+
+				return false
+			}
+			if !portExists {
+				resp.Diagnostics.AddError(
+					tfdiag.NotFoundErrSummary,
+					fmt.Sprintf(rsc.junosName()+" fpc %d pic %d port %d does not exists after commit "+
+						"=> check your config", plan.Fpc.ValueInt64(), plan.Pic.ValueInt64(), plan.Port.ValueInt64()),
+				)
+
+				return false
+			}
+
+			return true
+		},
+		&plan,
+		resp,
+	)
+}
+
+func (rsc *chassisFpcPicPort) Read(
+	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse,
+) {
+	var state, data chassisFpcPicPortData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var _ resourceDataReadFrom3String = &data
+	defaultResourceRead(
+		ctx,
+		rsc,
+		[]string{
+			utils.ConvI64toa(state.Fpc.ValueInt64()),
+			utils.ConvI64toa(state.Pic.ValueInt64()),
+			utils.ConvI64toa(state.Port.ValueInt64()),
+		},
+		&data,
+		nil,
+		resp,
+	)
+}
+
+func (rsc *chassisFpcPicPort) Update(
+	ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
+) {
+	var plan, state chassisFpcPicPortData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceUpdate(
+		ctx,
+		rsc,
+		&state,
+		&plan,
+		resp,
+	)
+}
+
+func (rsc *chassisFpcPicPort) Delete(
+	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
+) {
+	var state chassisFpcPicPortData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceDelete(
+		ctx,
+		rsc,
+		&state,
+		resp,
+	)
+}
+
+func (rsc *chassisFpcPicPort) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
+) {
+	var data chassisFpcPicPortData
+
+	var _ resourceDataReadFrom3String = &data
+	defaultResourceImportState(
+		ctx,
+		rsc,
+		&data,
+		req,
+		resp,
+		fmt.Sprintf("don't find "+rsc.junosName()+" with id %q "+
+			"(id must be <fpc>"+junos.IDSeparator+"<pic>"+junos.IDSeparator+"<port>)", req.ID),
+	)
+}
+
+func checkChassisFpcPicPortExists(
+	_ context.Context, fpc, pic, port int64, junSess *junos.Session,
+) (
+	bool, error,
+) {
+	showConfig, err := junSess.Command(junos.CmdShowConfig +
+		"chassis fpc " + utils.ConvI64toa(fpc) +
+		" pic " + utils.ConvI64toa(pic) +
+		" port " + utils.ConvI64toa(port) +
+		junos.PipeDisplaySet)
+	if err != nil {
+		return false, err
+	}
+	if showConfig == junos.EmptyW {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (rscData *chassisFpcPicPortData) fillID() {
+	fpc := utils.ConvI64toa(rscData.Fpc.ValueInt64())
+	pic := utils.ConvI64toa(rscData.Pic.ValueInt64())
+	port := utils.ConvI64toa(rscData.Port.ValueInt64())
+
+	rscData.ID = types.StringValue(fpc + junos.IDSeparator + pic + junos.IDSeparator + port)
+	rscData.InterfaceNameMatch = types.StringValue(junos.PortInterfaceNameMatch(fpc, pic, port))
+}
+
+func (rscData *chassisFpcPicPortData) nullID() bool {
+	return rscData.ID.IsNull()
+}
+
+func (rscData *chassisFpcPicPortData) set(
+	_ context.Context, junSess *junos.Session,
+) (
+	path.Path, error,
+) {
+	setPrefix := "set chassis fpc " + utils.ConvI64toa(rscData.Fpc.ValueInt64()) +
+		" pic " + utils.ConvI64toa(rscData.Pic.ValueInt64()) +
+		" port " + utils.ConvI64toa(rscData.Port.ValueInt64()) + " "
+	configSet := make([]string, 0)
+
+	if v := rscData.ChannelSpeed.ValueString(); v != "" {
+		configSet = append(configSet, setPrefix+"channel-speed "+v)
+	}
+	if !rscData.NumberOfSubPorts.IsNull() {
+		configSet = append(configSet, setPrefix+"number-of-sub-ports "+
+			utils.ConvI64toa(rscData.NumberOfSubPorts.ValueInt64()))
+	}
+	if v := rscData.Speed.ValueString(); v != "" {
+		configSet = append(configSet, setPrefix+"speed "+v)
+	}
+
+	return path.Empty(), junSess.ConfigSet(configSet)
+}
+
+func (rscData *chassisFpcPicPortData) read(
+	_ context.Context, fpc, pic, port string, junSess *junos.Session,
+) (
+	err error,
+) {
+	showConfig, err := junSess.Command(junos.CmdShowConfig +
+		"chassis fpc " + fpc + " pic " + pic + " port " + port + junos.PipeDisplaySetRelative)
+	if err != nil {
+		return err
+	}
+	if showConfig != junos.EmptyW {
+		rscData.Fpc, err = tfdata.ConvAtoi64Value(fpc)
+		if err != nil {
+			return err
+		}
+		rscData.Pic, err = tfdata.ConvAtoi64Value(pic)
+		if err != nil {
+			return err
+		}
+		rscData.Port, err = tfdata.ConvAtoi64Value(port)
+		if err != nil {
+			return err
+		}
+		rscData.fillID()
+		for _, item := range strings.Split(showConfig, "\n") {
+			if strings.Contains(item, junos.XMLStartTagConfigOut) {
+				continue
+			}
+			if strings.Contains(item, junos.XMLEndTagConfigOut) {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, junos.SetLS)
+			switch {
+			case balt.CutPrefixInString(&itemTrim, "channel-speed "):
+				rscData.ChannelSpeed = types.StringValue(itemTrim)
+			case balt.CutPrefixInString(&itemTrim, "number-of-sub-ports "):
+				rscData.NumberOfSubPorts, err = tfdata.ConvAtoi64Value(itemTrim)
+				if err != nil {
+					return err
+				}
+			case balt.CutPrefixInString(&itemTrim, "speed "):
+				rscData.Speed = types.StringValue(itemTrim)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (rscData *chassisFpcPicPortData) del(
+	_ context.Context, junSess *junos.Session,
+) error {
+	configSet := []string{
+		"delete chassis fpc " + utils.ConvI64toa(rscData.Fpc.ValueInt64()) +
+			" pic " + utils.ConvI64toa(rscData.Pic.ValueInt64()) +
+			" port " + utils.ConvI64toa(rscData.Port.ValueInt64()),
+	}
+
+	return junSess.ConfigSet(configSet)
+}
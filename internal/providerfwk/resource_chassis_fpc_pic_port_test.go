@@ -0,0 +1,67 @@
+package providerfwk_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJunosChassisFpcPicPort_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosChassisFpcPicPortConfigCreate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_chassis_fpc_pic_port.testacc_port",
+							"id", "0"+junos.IDSeparator+"0"+junos.IDSeparator+"48"),
+						resource.TestCheckResourceAttr("junos_chassis_fpc_pic_port.testacc_port",
+							"interface_name_match", `^(ge|mge|xe|et)-0/0/48(:\d+)?$`),
+					),
+				},
+				{
+					Config: testAccJunosChassisFpcPicPortConfigUpdate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_chassis_fpc_pic_port.testacc_port",
+							"speed", "40g"),
+					),
+				},
+				{
+					ResourceName:      "junos_chassis_fpc_pic_port.testacc_port",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosChassisFpcPicPortConfigCreate() string {
+	return `
+resource "junos_chassis_fpc_pic_port" "testacc_port" {
+  fpc           = 0
+  pic           = 0
+  port          = 48
+  channel_speed = "10g"
+}
+data "junos_interfaces_physical_present" "testacc_port" {
+  match_name = junos_chassis_fpc_pic_port.testacc_port.interface_name_match
+}
+`
+}
+
+func testAccJunosChassisFpcPicPortConfigUpdate() string {
+	return `
+resource "junos_chassis_fpc_pic_port" "testacc_port" {
+  fpc   = 0
+  pic   = 0
+  port  = 48
+  speed = "40g"
+}
+`
+}
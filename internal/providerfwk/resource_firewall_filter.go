@@ -78,7 +78,7 @@ func (rsc *firewallFilter) Configure(
 }
 
 func (rsc *firewallFilter) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -755,6 +755,7 @@ func (rsc *firewallFilter) Schema(
 					listvalidator.SizeAtLeast(1),
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -765,14 +766,16 @@ type firewallFilterData struct {
 	Name              types.String              `tfsdk:"name"`
 	Family            types.String              `tfsdk:"family"`
 	Term              []firewallFilterBlockTerm `tfsdk:"term"`
+	Timeouts          resourceTimeouts          `tfsdk:"timeouts"`
 }
 
 type firewallFilterConfig struct {
-	InterfaceSpecific types.Bool   `tfsdk:"interface_specific"`
-	ID                types.String `tfsdk:"id"`
-	Name              types.String `tfsdk:"name"`
-	Family            types.String `tfsdk:"family"`
-	Term              types.List   `tfsdk:"term"`
+	InterfaceSpecific types.Bool       `tfsdk:"interface_specific"`
+	ID                types.String     `tfsdk:"id"`
+	Name              types.String     `tfsdk:"name"`
+	Family            types.String     `tfsdk:"family"`
+	Term              types.List       `tfsdk:"term"`
+	Timeouts          resourceTimeouts `tfsdk:"timeouts"`
 }
 
 type firewallFilterBlockTerm struct {
@@ -77,7 +77,7 @@ func (rsc *firewallPolicer) Configure(
 }
 
 func (rsc *firewallPolicer) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -232,6 +232,7 @@ func (rsc *firewallPolicer) Schema(
 					tfplanmodifier.BlockRemoveNull(),
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -247,6 +248,7 @@ type firewallPolicerData struct {
 	IfExceeding              *firewallPolicerBlockIfExceeding    `tfsdk:"if_exceeding"`
 	IfExceedingPPS           *firewallPolicerBlockIfExceedingPPS `tfsdk:"if_exceeding_pps"`
 	Then                     *firewallPolicerBlockThen           `tfsdk:"then"`
+	Timeouts                 resourceTimeouts                    `tfsdk:"timeouts"`
 }
 
 type firewallPolicerBlockIfExceeding struct {
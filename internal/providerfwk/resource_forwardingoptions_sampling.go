@@ -77,7 +77,7 @@ func (rsc *forwardingoptionsSampling) Configure(
 }
 
 func (rsc *forwardingoptionsSampling) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Configure static configuration in `" + rsc.junosName() + "` block",
@@ -404,6 +404,7 @@ func (rsc *forwardingoptionsSampling) Schema(
 					tfplanmodifier.BlockRemoveNull(),
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -674,6 +675,7 @@ type forwardingoptionsSamplingData struct {
 	FamilyMplsInput   *forwardingoptionsSamplingBlockInput             `tfsdk:"family_mpls_input"`
 	FamilyMplsOutput  *forwardingoptionsSamplingBlockFamilyMplsOutput  `tfsdk:"family_mpls_output"`
 	Input             *forwardingoptionsSamplingBlockInput             `tfsdk:"input"`
+	Timeouts          resourceTimeouts                                 `tfsdk:"timeouts"`
 }
 
 type forwardingoptionsSamplingConfig struct {
@@ -689,6 +691,7 @@ type forwardingoptionsSamplingConfig struct {
 	FamilyMplsInput   *forwardingoptionsSamplingBlockInput                   `tfsdk:"family_mpls_input"`
 	FamilyMplsOutput  *forwardingoptionsSamplingBlockFamilyMplsOutputConfig  `tfsdk:"family_mpls_output"`
 	Input             *forwardingoptionsSamplingBlockInput                   `tfsdk:"input"`
+	Timeouts          resourceTimeouts                                       `tfsdk:"timeouts"`
 }
 
 type forwardingoptionsSamplingBlockInput struct {
@@ -1253,6 +1256,13 @@ func (rsc *forwardingoptionsSampling) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultResourceReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	junSess, err := rsc.client.StartNewSession(ctx)
 	if err != nil {
@@ -1289,6 +1299,7 @@ func (rsc *forwardingoptionsSampling) Read(
 		return
 	}
 
+	data.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -1331,6 +1342,9 @@ func (rsc *forwardingoptionsSampling) Delete(
 func (rsc *forwardingoptionsSampling) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	ctx, cancel := context.WithTimeout(ctx, defaultResourceReadTimeout)
+	defer cancel()
+
 	junSess, err := rsc.client.StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
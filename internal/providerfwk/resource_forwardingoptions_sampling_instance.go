@@ -78,7 +78,7 @@ func (rsc *forwardingoptionsSamplingInstance) Configure(
 }
 
 func (rsc *forwardingoptionsSamplingInstance) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -357,6 +357,7 @@ func (rsc *forwardingoptionsSamplingInstance) Schema(
 					tfplanmodifier.BlockRemoveNull(),
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -634,6 +635,7 @@ type forwardingoptionsSamplingInstanceData struct {
 	FamilyMplsInput   *forwardingoptionsSamplingInstanceBlockInput             `tfsdk:"family_mpls_input"`
 	FamilyMplsOutput  *forwardingoptionsSamplingInstanceBlockFamilyMplsOutput  `tfsdk:"family_mpls_output"`
 	Input             *forwardingoptionsSamplingInstanceBlockInput             `tfsdk:"input"`
+	Timeouts          resourceTimeouts                                         `tfsdk:"timeouts"`
 }
 
 type forwardingoptionsSamplingInstanceConfig struct {
@@ -648,6 +650,7 @@ type forwardingoptionsSamplingInstanceConfig struct {
 	FamilyMplsInput   *forwardingoptionsSamplingInstanceBlockInput                  `tfsdk:"family_mpls_input"`
 	FamilyMplsOutput  *forwardingoptionsSamplingInstanceBlockFamilyMplsOutputConfig `tfsdk:"family_mpls_output"`
 	Input             *forwardingoptionsSamplingInstanceBlockInput                  `tfsdk:"input"`
+	Timeouts          resourceTimeouts                                              `tfsdk:"timeouts"`
 }
 
 type forwardingoptionsSamplingInstanceBlockInput struct {
@@ -1251,6 +1254,9 @@ func (rsc *forwardingoptionsSamplingInstance) Delete(
 func (rsc *forwardingoptionsSamplingInstance) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	ctx, cancel := context.WithTimeout(ctx, defaultResourceReadTimeout)
+	defer cancel()
+
 	junSess, err := rsc.client.StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -0,0 +1,627 @@
+package providerfwk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdata"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	balt "github.com/jeremmfr/go-utils/basicalter"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &igmpInterface{}
+	_ resource.ResourceWithConfigure      = &igmpInterface{}
+	_ resource.ResourceWithValidateConfig = &igmpInterface{}
+	_ resource.ResourceWithImportState    = &igmpInterface{}
+)
+
+type igmpInterface struct {
+	client *junos.Client
+}
+
+func newIgmpInterfaceResource() resource.Resource {
+	return &igmpInterface{}
+}
+
+func (rsc *igmpInterface) typeName() string {
+	return providerName + "_igmp_interface"
+}
+
+func (rsc *igmpInterface) junosName() string {
+	return "protocols igmp interface"
+}
+
+func (rsc *igmpInterface) junosClient() *junos.Client {
+	return rsc.client
+}
+
+func (rsc *igmpInterface) Metadata(
+	_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse,
+) {
+	resp.TypeName = rsc.typeName()
+}
+
+func (rsc *igmpInterface) Configure(
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedResourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	rsc.client = client
+}
+
+func (rsc *igmpInterface) Schema(
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a " + rsc.junosName() + ".",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				Description: "An identifier for the resource with format " +
+					"`<name>" + junos.IDSeparator + "<routing_instance>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Interface name or `all`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					tfvalidator.StringFormat(tfvalidator.InterfaceFormat),
+				},
+			},
+			"routing_instance": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(junos.DefaultW),
+				Description: "Routing instance for igmp protocol if not root level.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+			"disable": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Disable IGMP on this interface.",
+				Validators: []validator.Bool{
+					tfvalidator.BoolTrue(),
+				},
+			},
+			"group_limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of groups an interface can join.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 32767),
+				},
+			},
+			"group_policy": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Group policy.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(
+						stringvalidator.LengthBetween(1, 250),
+						tfvalidator.StringDoubleQuoteExclusion(),
+					),
+				},
+			},
+			"immediate_leave": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Enable immediate group leave on interface.",
+				Validators: []validator.Bool{
+					tfvalidator.BoolTrue(),
+				},
+			},
+			"promiscuous_mode": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Enable promiscuous mode on interface.",
+				Validators: []validator.Bool{
+					tfvalidator.BoolTrue(),
+				},
+			},
+			"ssm_map": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of SSM map.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 250),
+					tfvalidator.StringDoubleQuoteExclusion(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Optional:    true,
+				Description: "IGMP version number.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("1", "2", "3"),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"static_group": schema.ListNestedBlock{
+				Description: "For each static group to join.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Required:    true,
+							Description: "IP multicast group address.",
+							Validators: []validator.String{
+								tfvalidator.StringIPAddress().IPv4Only(),
+							},
+						},
+						"source": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "IP multicast source addresses.",
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(
+									tfvalidator.StringIPAddress().IPv4Only(),
+								),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
+	}
+}
+
+type igmpInterfaceData struct {
+	ID              types.String                    `tfsdk:"id"`
+	Name            types.String                    `tfsdk:"name"`
+	RoutingInstance types.String                    `tfsdk:"routing_instance"`
+	Disable         types.Bool                      `tfsdk:"disable"`
+	GroupLimit      types.Int64                     `tfsdk:"group_limit"`
+	GroupPolicy     []types.String                  `tfsdk:"group_policy"`
+	ImmediateLeave  types.Bool                      `tfsdk:"immediate_leave"`
+	PromiscuousMode types.Bool                      `tfsdk:"promiscuous_mode"`
+	SsmMap          types.String                    `tfsdk:"ssm_map"`
+	Version         types.String                    `tfsdk:"version"`
+	StaticGroup     []igmpInterfaceBlockStaticGroup `tfsdk:"static_group"`
+	Timeouts        resourceTimeouts                `tfsdk:"timeouts"`
+}
+
+type igmpInterfaceConfig struct {
+	ID              types.String     `tfsdk:"id"`
+	Name            types.String     `tfsdk:"name"`
+	RoutingInstance types.String     `tfsdk:"routing_instance"`
+	Disable         types.Bool       `tfsdk:"disable"`
+	GroupLimit      types.Int64      `tfsdk:"group_limit"`
+	GroupPolicy     types.List       `tfsdk:"group_policy"`
+	ImmediateLeave  types.Bool       `tfsdk:"immediate_leave"`
+	PromiscuousMode types.Bool       `tfsdk:"promiscuous_mode"`
+	SsmMap          types.String     `tfsdk:"ssm_map"`
+	Version         types.String     `tfsdk:"version"`
+	StaticGroup     types.List       `tfsdk:"static_group"`
+	Timeouts        resourceTimeouts `tfsdk:"timeouts"`
+}
+
+type igmpInterfaceBlockStaticGroup struct {
+	Address types.String   `tfsdk:"address"`
+	Source  []types.String `tfsdk:"source"`
+}
+
+type igmpInterfaceBlockStaticGroupConfig struct {
+	Address types.String `tfsdk:"address"`
+	Source  types.Set    `tfsdk:"source"`
+}
+
+func (rsc *igmpInterface) ValidateConfig(
+	ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse,
+) {
+	var config igmpInterfaceConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.StaticGroup.IsNull() && !config.StaticGroup.IsUnknown() {
+		var configStaticGroup []igmpInterfaceBlockStaticGroupConfig
+		asDiags := config.StaticGroup.ElementsAs(ctx, &configStaticGroup, false)
+		if asDiags.HasError() {
+			resp.Diagnostics.Append(asDiags...)
+
+			return
+		}
+		staticGroupAddress := make(map[string]struct{})
+		for i, block := range configStaticGroup {
+			if block.Address.IsUnknown() {
+				continue
+			}
+			address := block.Address.ValueString()
+			if _, ok := staticGroupAddress[address]; ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("static_group").AtListIndex(i).AtName("address"),
+					tfdiag.DuplicateConfigErrSummary,
+					fmt.Sprintf("multiple static_group blocks with the same address %q", address),
+				)
+			}
+			staticGroupAddress[address] = struct{}{}
+		}
+	}
+}
+
+func (rsc *igmpInterface) Create(
+	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
+) {
+	var plan igmpInterfaceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Name.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Empty Name",
+			"could not create "+rsc.junosName()+" with empty name",
+		)
+
+		return
+	}
+
+	defaultResourceCreate(
+		ctx,
+		rsc,
+		func(fnCtx context.Context, junSess *junos.Session) bool {
+			if v := plan.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
+				instanceExists, err := checkRoutingInstanceExists(fnCtx, v, junSess)
+				if err != nil {
+					resp.Diagnostics.AddError(tfdiag.PreCheckErrSummary, err.Error())
+
+					return false
+				}
+				if !instanceExists {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("routing_instance"),
+						tfdiag.MissingConfigErrSummary,
+						fmt.Sprintf("routing instance %q doesn't exist", v),
+					)
+
+					return false
+				}
+			}
+			interfaceExists, err := checkIgmpInterfaceExists(
+				fnCtx,
+				plan.Name.ValueString(),
+				plan.RoutingInstance.ValueString(),
+				junSess,
+			)
+			if err != nil {
+				resp.Diagnostics.AddError(tfdiag.PreCheckErrSummary, err.Error())
+
+				return false
+			}
+			if interfaceExists {
+				if v := plan.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
+					resp.Diagnostics.AddError(
+						tfdiag.DuplicateConfigErrSummary,
+						fmt.Sprintf(rsc.junosName()+" %q already exists in routing-instance %q", plan.Name.ValueString(), v),
+					)
+				} else {
+					resp.Diagnostics.AddError(
+						tfdiag.DuplicateConfigErrSummary,
+						fmt.Sprintf(rsc.junosName()+" %q already exists", plan.Name.ValueString()),
+					)
+				}
+
+				return false
+			}
+
+			return true
+		},
+		func(fnCtx context.Context, junSess *junos.Session) bool {
+			interfaceExists, err := checkIgmpInterfaceExists(
+				fnCtx,
+				plan.Name.ValueString(),
+				plan.RoutingInstance.ValueString(),
+				junSess,
+			)
+			if err != nil {
+				resp.Diagnostics.AddError(tfdiag.PostCheckErrSummary, err.Error())
+
+				return false
+			}
+			if !interfaceExists {
+				if v := plan.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
+					resp.Diagnostics.AddError(
+						tfdiag.NotFoundErrSummary,
+						fmt.Sprintf(rsc.junosName()+" %q does not exists in routing-instance %q after commit "+
+							"=> check your config", plan.Name.ValueString(), v),
+					)
+				} else {
+					resp.Diagnostics.AddError(
+						tfdiag.NotFoundErrSummary,
+						fmt.Sprintf(rsc.junosName()+" %q does not exists after commit "+
+							"=> check your config", plan.Name.ValueString()),
+					)
+				}
+
+				return false
+			}
+
+			return true
+		},
+		&plan,
+		resp,
+	)
+}
+
+func (rsc *igmpInterface) Read(
+	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse,
+) {
+	var state, data igmpInterfaceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var _ resourceDataReadFrom2String = &data
+	defaultResourceRead(
+		ctx,
+		rsc,
+		[]string{
+			state.Name.ValueString(),
+			state.RoutingInstance.ValueString(),
+		},
+		&data,
+		nil,
+		resp,
+	)
+}
+
+func (rsc *igmpInterface) Update(
+	ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
+) {
+	var plan, state igmpInterfaceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceUpdate(
+		ctx,
+		rsc,
+		&state,
+		&plan,
+		resp,
+	)
+}
+
+func (rsc *igmpInterface) Delete(
+	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
+) {
+	var state igmpInterfaceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceDelete(
+		ctx,
+		rsc,
+		&state,
+		resp,
+	)
+}
+
+func (rsc *igmpInterface) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
+) {
+	var data igmpInterfaceData
+
+	var _ resourceDataReadFrom2String = &data
+	defaultResourceImportState(
+		ctx,
+		rsc,
+		&data,
+		req,
+		resp,
+		fmt.Sprintf("don't find "+rsc.junosName()+" with id %q "+
+			"(id must be <name>"+junos.IDSeparator+"<routing_instance>)", req.ID),
+	)
+}
+
+func checkIgmpInterfaceExists(
+	_ context.Context, name, routingInstance string, junSess *junos.Session,
+) (
+	bool, error,
+) {
+	showPrefix := junos.CmdShowConfig
+	if routingInstance != "" && routingInstance != junos.DefaultW {
+		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
+	}
+	showConfig, err := junSess.Command(showPrefix +
+		"protocols igmp interface " + name + junos.PipeDisplaySet)
+	if err != nil {
+		return false, err
+	}
+	if showConfig == junos.EmptyW {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (rscData *igmpInterfaceData) fillID() {
+	if v := rscData.RoutingInstance.ValueString(); v != "" {
+		rscData.ID = types.StringValue(rscData.Name.ValueString() + junos.IDSeparator + v)
+	} else {
+		rscData.ID = types.StringValue(rscData.Name.ValueString() + junos.IDSeparator + junos.DefaultW)
+	}
+}
+
+func (rscData *igmpInterfaceData) nullID() bool {
+	return rscData.ID.IsNull()
+}
+
+func (rscData *igmpInterfaceData) set(
+	_ context.Context, junSess *junos.Session,
+) (
+	path.Path, error,
+) {
+	setPrefix := junos.SetLS
+	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
+		setPrefix = junos.SetRoutingInstances + v + " "
+	}
+	setPrefix += "protocols igmp interface " + rscData.Name.ValueString() + " "
+
+	configSet := []string{
+		setPrefix,
+	}
+	if rscData.Disable.ValueBool() {
+		configSet = append(configSet, setPrefix+"disable")
+	}
+	if !rscData.GroupLimit.IsNull() {
+		configSet = append(configSet, setPrefix+"group-limit "+
+			utils.ConvI64toa(rscData.GroupLimit.ValueInt64()))
+	}
+	for _, v := range rscData.GroupPolicy {
+		configSet = append(configSet, setPrefix+"group-policy \""+v.ValueString()+"\"")
+	}
+	if rscData.ImmediateLeave.ValueBool() {
+		configSet = append(configSet, setPrefix+"immediate-leave")
+	}
+	if rscData.PromiscuousMode.ValueBool() {
+		configSet = append(configSet, setPrefix+"promiscuous-mode")
+	}
+	if v := rscData.SsmMap.ValueString(); v != "" {
+		configSet = append(configSet, setPrefix+"ssm-map \""+v+"\"")
+	}
+	if v := rscData.Version.ValueString(); v != "" {
+		configSet = append(configSet, setPrefix+"version "+v)
+	}
+	staticGroupAddress := make(map[string]struct{})
+	for i, block := range rscData.StaticGroup {
+		address := block.Address.ValueString()
+		if _, ok := staticGroupAddress[address]; ok {
+			return path.Root("static_group").AtListIndex(i).AtName("address"),
+				fmt.Errorf("multiple static_group blocks with the same address %q", address)
+		}
+		staticGroupAddress[address] = struct{}{}
+
+		setPrefixStaticGroup := setPrefix + "static group " + address
+		configSet = append(configSet, setPrefixStaticGroup)
+		for _, v := range block.Source {
+			configSet = append(configSet, setPrefixStaticGroup+" source "+v.ValueString())
+		}
+	}
+
+	return path.Empty(), junSess.ConfigSet(configSet)
+}
+
+func (rscData *igmpInterfaceData) read(
+	_ context.Context, name, routingInstance string, junSess *junos.Session,
+) (
+	err error,
+) {
+	showPrefix := junos.CmdShowConfig
+	if routingInstance != "" && routingInstance != junos.DefaultW {
+		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
+	}
+	showConfig, err := junSess.Command(showPrefix +
+		"protocols igmp interface " + name + junos.PipeDisplaySetRelative)
+	if err != nil {
+		return err
+	}
+	if showConfig != junos.EmptyW {
+		rscData.Name = types.StringValue(name)
+		if routingInstance == "" {
+			rscData.RoutingInstance = types.StringValue(junos.DefaultW)
+		} else {
+			rscData.RoutingInstance = types.StringValue(routingInstance)
+		}
+		rscData.fillID()
+		for _, item := range strings.Split(showConfig, "\n") {
+			if strings.Contains(item, junos.XMLStartTagConfigOut) {
+				continue
+			}
+			if strings.Contains(item, junos.XMLEndTagConfigOut) {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, junos.SetLS)
+			switch {
+			case itemTrim == "disable":
+				rscData.Disable = types.BoolValue(true)
+			case balt.CutPrefixInString(&itemTrim, "group-limit "):
+				rscData.GroupLimit, err = tfdata.ConvAtoi64Value(itemTrim)
+				if err != nil {
+					return err
+				}
+			case balt.CutPrefixInString(&itemTrim, "group-policy "):
+				rscData.GroupPolicy = append(rscData.GroupPolicy, types.StringValue(strings.Trim(itemTrim, "\"")))
+			case itemTrim == "immediate-leave":
+				rscData.ImmediateLeave = types.BoolValue(true)
+			case itemTrim == "promiscuous-mode":
+				rscData.PromiscuousMode = types.BoolValue(true)
+			case balt.CutPrefixInString(&itemTrim, "ssm-map "):
+				rscData.SsmMap = types.StringValue(strings.Trim(itemTrim, "\""))
+			case balt.CutPrefixInString(&itemTrim, "version "):
+				rscData.Version = types.StringValue(itemTrim)
+			case balt.CutPrefixInString(&itemTrim, "static group "):
+				address := tfdata.FirstElementOfJunosLine(itemTrim)
+				var staticGroup igmpInterfaceBlockStaticGroup
+				rscData.StaticGroup, staticGroup = tfdata.ExtractBlockWithTFTypesString(
+					rscData.StaticGroup, "Address", address,
+				)
+				staticGroup.Address = types.StringValue(address)
+				if balt.CutPrefixInString(&itemTrim, address+" source ") {
+					staticGroup.Source = append(staticGroup.Source, types.StringValue(itemTrim))
+				}
+				rscData.StaticGroup = append(rscData.StaticGroup, staticGroup)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (rscData *igmpInterfaceData) del(
+	_ context.Context, junSess *junos.Session,
+) error {
+	delPrefix := junos.DeleteLS
+	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
+		delPrefix = junos.DelRoutingInstances + v + " "
+	}
+
+	configSet := []string{
+		delPrefix + "protocols igmp interface " + rscData.Name.ValueString(),
+	}
+
+	return junSess.ConfigSet(configSet)
+}
@@ -0,0 +1,114 @@
+package providerfwk_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// export TESTACC_INTERFACE=<inteface> for choose interface available else it's ge-0/0/3.
+func TestAccJunosIgmpInterface_basic(t *testing.T) {
+	testaccInterface := junos.DefaultInterfaceTestAcc
+	if iface := os.Getenv("TESTACC_INTERFACE"); iface != "" {
+		testaccInterface = iface
+	}
+	if os.Getenv("TESTACC_ROUTER") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosIgmpInterfaceConfigCreate(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_igmp_interface.testacc_igmp",
+							"id", testaccInterface+".0"+junos.IDSeparator+junos.DefaultW),
+						resource.TestCheckResourceAttr("junos_igmp_interface.testacc_igmp",
+							"static_group.#", "2"),
+					),
+				},
+				{
+					Config: testAccJunosIgmpInterfaceConfigUpdate(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_igmp_interface.testacc_igmp",
+							"version", "2"),
+					),
+				},
+				{
+					ResourceName:      "junos_igmp_interface.testacc_igmp",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					ResourceName:      "junos_igmp_interface.testacc_igmp_ri",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosIgmpInterfaceConfigCreate(interFace string) string {
+	return fmt.Sprintf(`
+resource "junos_interface_logical" "testacc_igmp" {
+  name = "%s.0"
+  family_inet {
+    address {
+      cidr_ip = "192.0.2.1/25"
+    }
+  }
+}
+resource "junos_igmp_interface" "testacc_igmp" {
+  name            = junos_interface_logical.testacc_igmp.name
+  version         = "3"
+  immediate_leave = true
+  group_limit     = 100
+  static_group {
+    address = "232.1.1.1"
+    source  = ["192.0.2.10", "192.0.2.11"]
+  }
+  static_group {
+    address = "239.1.1.1"
+  }
+}
+resource "junos_routing_instance" "testacc_igmp" {
+  name = "testacc_igmp"
+  type = "virtual-router"
+}
+resource "junos_igmp_interface" "testacc_igmp_ri" {
+  name             = "all"
+  routing_instance = junos_routing_instance.testacc_igmp.name
+  disable          = true
+}
+`, interFace)
+}
+
+func testAccJunosIgmpInterfaceConfigUpdate(interFace string) string {
+	return fmt.Sprintf(`
+resource "junos_interface_logical" "testacc_igmp" {
+  name = "%s.0"
+  family_inet {
+    address {
+      cidr_ip = "192.0.2.1/25"
+    }
+  }
+}
+resource "junos_igmp_interface" "testacc_igmp" {
+  name             = junos_interface_logical.testacc_igmp.name
+  version          = "2"
+  promiscuous_mode = true
+}
+resource "junos_routing_instance" "testacc_igmp" {
+  name = "testacc_igmp"
+  type = "virtual-router"
+}
+resource "junos_igmp_interface" "testacc_igmp_ri" {
+  name             = "all"
+  routing_instance = junos_routing_instance.testacc_igmp.name
+}
+`, interFace)
+}
@@ -81,7 +81,7 @@ func (rsc *interfaceLogical) Configure(
 }
 
 func (rsc *interfaceLogical) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -1004,6 +1004,7 @@ func (rsc *interfaceLogical) Schema(
 					tfplanmodifier.BlockRemoveNull(),
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -1023,6 +1024,7 @@ type interfaceLogicalData struct {
 	FamilyInet               *interfaceLogicalBlockFamilyInet  `tfsdk:"family_inet"`
 	FamilyInet6              *interfaceLogicalBlockFamilyInet6 `tfsdk:"family_inet6"`
 	Tunnel                   *interfaceLogicalBlockTunnel      `tfsdk:"tunnel"`
+	Timeouts                 resourceTimeouts                  `tfsdk:"timeouts"`
 }
 
 type interfaceLogicalConfig struct {
@@ -1040,6 +1042,7 @@ type interfaceLogicalConfig struct {
 	FamilyInet               *interfaceLogicalBlockFamilyInetConfig  `tfsdk:"family_inet"`
 	FamilyInet6              *interfaceLogicalBlockFamilyInet6Config `tfsdk:"family_inet6"`
 	Tunnel                   *interfaceLogicalBlockTunnel            `tfsdk:"tunnel"`
+	Timeouts                 resourceTimeouts                        `tfsdk:"timeouts"`
 }
 
 type interfaceLogicalBlockFamilyInet struct {
@@ -1634,6 +1637,14 @@ func (rsc *interfaceLogical) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultResourceCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	if plan.Name.ValueString() == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("name"),
@@ -1667,7 +1678,7 @@ func (rsc *interfaceLogical) Create(
 		if err := delInterfaceNC(
 			ctx,
 			plan.Name.ValueString(),
-			rsc.client.GroupInterfaceDelete(),
+			rsc.client.InterfaceRelease().Logical(),
 			junSess,
 		); err != nil {
 			resp.Diagnostics.AddError("Pre Config Set Error", err.Error())
@@ -1697,6 +1708,7 @@ func (rsc *interfaceLogical) Create(
 		return
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(plan.Name.ValueString())
 	if err := junSess.ConfigLock(ctx); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigLockErrSummary, err.Error())
 
@@ -1709,7 +1721,7 @@ func (rsc *interfaceLogical) Create(
 	ncInt, emptyInt, _, err := checkInterfaceLogicalNCEmpty(
 		ctx,
 		plan.Name.ValueString(),
-		rsc.client.GroupInterfaceDelete(),
+		rsc.client.InterfaceRelease().Logical(),
 		junSess,
 	)
 	if err != nil {
@@ -1729,7 +1741,7 @@ func (rsc *interfaceLogical) Create(
 		if err := delInterfaceNC(
 			ctx,
 			plan.Name.ValueString(),
-			rsc.client.GroupInterfaceDelete(),
+			rsc.client.InterfaceRelease().Logical(),
 			junSess,
 		); err != nil {
 			resp.Diagnostics.AddError("Pre Config Set Error", err.Error())
@@ -1804,7 +1816,7 @@ func (rsc *interfaceLogical) Create(
 	ncInt, emptyInt, setInt, err := checkInterfaceLogicalNCEmpty(
 		ctx,
 		plan.Name.ValueString(),
-		rsc.client.GroupInterfaceDelete(),
+		rsc.client.InterfaceRelease().Logical(),
 		junSess,
 	)
 	if err != nil {
@@ -1851,6 +1863,13 @@ func (rsc *interfaceLogical) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultResourceReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	junSess, err := rsc.client.StartNewSession(ctx)
 	if err != nil {
@@ -1866,7 +1885,7 @@ func (rsc *interfaceLogical) Read(
 	ncInt, emptyInt, setInt, err := checkInterfaceLogicalNCEmpty(
 		ctx,
 		state.Name.ValueString(),
-		rsc.client.GroupInterfaceDelete(),
+		rsc.client.InterfaceRelease().Logical(),
 		junSess,
 	)
 	if err != nil {
@@ -1901,6 +1920,7 @@ func (rsc *interfaceLogical) Read(
 
 	data.St0AlsoOnDestroy = state.St0AlsoOnDestroy
 	data.VlanNoCompute = state.VlanNoCompute
+	data.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -1913,6 +1933,14 @@ func (rsc *interfaceLogical) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultResourceUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	if plan.VlanID.IsUnknown() {
 		if plan.VlanNoCompute.ValueBool() {
 			plan.VlanID = types.Int64Null()
@@ -1965,6 +1993,7 @@ func (rsc *interfaceLogical) Update(
 		return
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(state.ID.ValueString())
 	if err := junSess.ConfigLock(ctx); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigLockErrSummary, err.Error())
 
@@ -2084,6 +2113,9 @@ func (rsc *interfaceLogical) Delete(
 func (rsc *interfaceLogical) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	ctx, cancel := context.WithTimeout(ctx, defaultResourceReadTimeout)
+	defer cancel()
+
 	if strings.Count(req.ID, ".") != 1 {
 		resp.Diagnostics.AddError(
 			tfdiag.PreCheckErrSummary,
@@ -2104,7 +2136,7 @@ func (rsc *interfaceLogical) ImportState(
 	ncInt, emptyInt, setInt, err := checkInterfaceLogicalNCEmpty(
 		ctx,
 		req.ID,
-		rsc.client.GroupInterfaceDelete(),
+		rsc.client.InterfaceRelease().Logical(),
 		junSess,
 	)
 	if err != nil {
@@ -2216,7 +2248,7 @@ func (rscData *interfaceLogicalData) computeVlanID() {
 }
 
 func checkInterfaceLogicalNCEmpty(
-	_ context.Context, name, groupInterfaceDelete string, junSess *junos.Session,
+	_ context.Context, name string, release junos.InterfaceRelease, junSess *junos.Session,
 ) (
 	ncInt, // interface is set with NC config
 	emtyInt, // interface is emty not set or just with set
@@ -2249,16 +2281,10 @@ func checkInterfaceLogicalNCEmpty(
 	if len(showConfigLines) == 0 {
 		return false, true, true, nil
 	}
-	showConfig = strings.Join(showConfigLines, "\n")
-	if groupInterfaceDelete != "" {
-		if showConfig == "set apply-groups "+groupInterfaceDelete {
-			return true, false, false, nil
-		}
-	}
-	if showConfig == "set description NC\nset disable" ||
-		showConfig == "set disable\nset description NC" {
+	if release.IsReleased(showConfigLines) {
 		return true, false, false, nil
 	}
+	showConfig = strings.Join(showConfigLines, "\n")
 	switch {
 	case showConfig == junos.SetLS:
 		return false, true, true, nil
@@ -75,7 +75,7 @@ func (rsc *interfacePhysical) Configure(
 }
 
 func (rsc *interfacePhysical) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -107,6 +107,24 @@ func (rsc *interfacePhysical) Schema(
 					tfvalidator.BoolTrue(),
 				},
 			},
+			"adopt_configured": schema.BoolAttribute{
+				Optional: true,
+				Description: "When create this resource, take the interface even if it's already configured " +
+					"and capture its configuration in `release_snapshot` " +
+					"(need `snapshot` for `interface_release_policy` on provider).",
+				Validators: []validator.Bool{
+					tfvalidator.BoolTrue(),
+				},
+			},
+			"release_snapshot": schema.StringAttribute{
+				Computed: true,
+				Description: "Configuration lines of interface captured when creating this resource " +
+					"to restore them when destroy this resource " +
+					"(only with `snapshot` for `interface_release_policy` on provider).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"description": schema.StringAttribute{
 				Optional:    true,
 				Description: "Description for interface.",
@@ -540,6 +558,7 @@ func (rsc *interfacePhysical) Schema(
 					tfplanmodifier.BlockRemoveNull(),
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -609,6 +628,7 @@ func (rsc *interfacePhysical) schemaEtherOptsAttributes() map[string]schema.Attr
 
 type interfacePhysicalData struct {
 	NoDisableOnDestroy     types.Bool                             `tfsdk:"no_disable_on_destroy"`
+	AdoptConfigured        types.Bool                             `tfsdk:"adopt_configured"`
 	Disable                types.Bool                             `tfsdk:"disable"`
 	FlexibleVlanTagging    types.Bool                             `tfsdk:"flexible_vlan_tagging"`
 	GratuitousArpReply     types.Bool                             `tfsdk:"gratuitous_arp_reply"`
@@ -618,6 +638,7 @@ type interfacePhysicalData struct {
 	VlanTagging            types.Bool                             `tfsdk:"vlan_tagging"`
 	ID                     types.String                           `tfsdk:"id"`
 	Name                   types.String                           `tfsdk:"name"`
+	ReleaseSnapshot        types.String                           `tfsdk:"release_snapshot"`
 	Description            types.String                           `tfsdk:"description"`
 	Encapsulation          types.String                           `tfsdk:"encapsulation"`
 	HoldTimeDown           types.Int64                            `tfsdk:"hold_time_down"`
@@ -631,10 +652,12 @@ type interfacePhysicalData struct {
 	EtherOpts              *interfacePhysicalBlockEtherOpts       `tfsdk:"ether_opts"`
 	GigetherOpts           *interfacePhysicalBlockEtherOpts       `tfsdk:"gigether_opts"`
 	ParentEtherOpts        *interfacePhysicalBlockParentEtherOpts `tfsdk:"parent_ether_opts"`
+	Timeouts               resourceTimeouts                       `tfsdk:"timeouts"`
 }
 
 type interfacePhysicalConfig struct {
 	NoDisableOnDestroy     types.Bool                                   `tfsdk:"no_disable_on_destroy"`
+	AdoptConfigured        types.Bool                                   `tfsdk:"adopt_configured"`
 	Disable                types.Bool                                   `tfsdk:"disable"`
 	FlexibleVlanTagging    types.Bool                                   `tfsdk:"flexible_vlan_tagging"`
 	GratuitousArpReply     types.Bool                                   `tfsdk:"gratuitous_arp_reply"`
@@ -644,6 +667,7 @@ type interfacePhysicalConfig struct {
 	VlanTagging            types.Bool                                   `tfsdk:"vlan_tagging"`
 	ID                     types.String                                 `tfsdk:"id"`
 	Name                   types.String                                 `tfsdk:"name"`
+	ReleaseSnapshot        types.String                                 `tfsdk:"release_snapshot"`
 	Description            types.String                                 `tfsdk:"description"`
 	Encapsulation          types.String                                 `tfsdk:"encapsulation"`
 	HoldTimeDown           types.Int64                                  `tfsdk:"hold_time_down"`
@@ -657,6 +681,7 @@ type interfacePhysicalConfig struct {
 	EtherOpts              *interfacePhysicalBlockEtherOpts             `tfsdk:"ether_opts"`
 	GigetherOpts           *interfacePhysicalBlockEtherOpts             `tfsdk:"gigether_opts"`
 	ParentEtherOpts        *interfacePhysicalBlockParentEtherOptsConfig `tfsdk:"parent_ether_opts"`
+	Timeouts               resourceTimeouts                             `tfsdk:"timeouts"`
 }
 
 type interfacePhysicalBlockESI struct {
@@ -1011,6 +1036,14 @@ func (rsc *interfacePhysical) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultResourceCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	if plan.Name.ValueString() == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("name"),
@@ -1029,14 +1062,25 @@ func (rsc *interfacePhysical) Create(
 
 		return
 	}
+	if plan.AdoptConfigured.ValueBool() &&
+		rsc.client.InterfaceRelease().Policy() != junos.InterfaceReleaseSnapshot {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("adopt_configured"),
+			tfdiag.ConflictConfigErrSummary,
+			"adopt_configured need `snapshot` for interface_release_policy on provider",
+		)
+
+		return
+	}
 
+	plan.ReleaseSnapshot = types.StringNull()
 	if rsc.client.FakeCreateSetFile() {
 		junSess := rsc.client.NewSessionWithoutNetconf(ctx)
 
 		if err := delInterfaceNC(
 			ctx,
 			plan.Name.ValueString(),
-			rsc.client.GroupInterfaceDelete(),
+			rsc.client.InterfaceRelease(),
 			junSess,
 		); err != nil {
 			resp.Diagnostics.AddError("Pre Config Set Error", err.Error())
@@ -1066,6 +1110,7 @@ func (rsc *interfacePhysical) Create(
 		return
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(plan.Name.ValueString())
 	if err := junSess.ConfigLock(ctx); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigLockErrSummary, err.Error())
 
@@ -1075,10 +1120,11 @@ func (rsc *interfacePhysical) Create(
 		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigClearUnlockWarnSummary, junSess.ConfigClear())...)
 	}()
 
+	release := rsc.client.InterfaceRelease()
 	ncInt, emptyInt, err := checkInterfacePhysicalNCEmpty(
 		ctx,
 		plan.Name.ValueString(),
-		rsc.client.GroupInterfaceDelete(),
+		release,
 		junSess,
 	)
 	if err != nil {
@@ -1086,7 +1132,8 @@ func (rsc *interfacePhysical) Create(
 
 		return
 	}
-	if !ncInt && !emptyInt {
+	adoptInt := !ncInt && !emptyInt && plan.AdoptConfigured.ValueBool()
+	if !ncInt && !emptyInt && !adoptInt {
 		resp.Diagnostics.AddError(
 			tfdiag.DuplicateConfigErrSummary,
 			fmt.Sprintf(rsc.junosName()+" %q already configured", plan.Name.ValueString()),
@@ -1094,11 +1141,22 @@ func (rsc *interfacePhysical) Create(
 
 		return
 	}
-	if ncInt {
+	if ncInt || adoptInt {
+		if release.Policy() == junos.InterfaceReleaseSnapshot {
+			// capture the configuration of interface to restore it when destroy
+			configLines, err := readInterfacePhysicalConfigLines(ctx, plan.Name.ValueString(), junSess)
+			if err != nil {
+				resp.Diagnostics.AddError(tfdiag.PreCheckErrSummary, err.Error())
+
+				return
+			}
+			plan.ReleaseSnapshot = types.StringValue(strings.Join(configLines, "\n"))
+			release = release.WithSnapshot(configLines)
+		}
 		if err := delInterfaceNC(
 			ctx,
 			plan.Name.ValueString(),
-			rsc.client.GroupInterfaceDelete(),
+			release,
 			junSess,
 		); err != nil {
 			resp.Diagnostics.AddError("Pre Config Set Error", err.Error())
@@ -1127,7 +1185,7 @@ func (rsc *interfacePhysical) Create(
 	ncInt, emptyInt, err = checkInterfacePhysicalNCEmpty(
 		ctx,
 		plan.Name.ValueString(),
-		rsc.client.GroupInterfaceDelete(),
+		rsc.client.InterfaceRelease(),
 		junSess,
 	)
 	if err != nil {
@@ -1174,6 +1232,14 @@ func (rsc *interfacePhysical) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultResourceReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	junSess, err := rsc.client.StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -1188,7 +1254,7 @@ func (rsc *interfacePhysical) Read(
 	ncInt, emptyInt, err := checkInterfacePhysicalNCEmpty(
 		ctx,
 		state.Name.ValueString(),
-		rsc.client.GroupInterfaceDelete(),
+		rsc.client.InterfaceRelease(),
 		junSess,
 	)
 	if err != nil {
@@ -1222,6 +1288,9 @@ func (rsc *interfacePhysical) Read(
 	}
 
 	data.NoDisableOnDestroy = state.NoDisableOnDestroy
+	data.AdoptConfigured = state.AdoptConfigured
+	data.ReleaseSnapshot = state.ReleaseSnapshot
+	data.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -1234,6 +1303,14 @@ func (rsc *interfacePhysical) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultResourceUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var oldAE string
 	if state.EtherOpts != nil {
 		if v := state.EtherOpts.Ae8023ad.ValueString(); v != "" {
@@ -1276,6 +1353,7 @@ func (rsc *interfacePhysical) Update(
 		return
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(state.ID.ValueString())
 	if err := junSess.ConfigLock(ctx); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigLockErrSummary, err.Error())
 
@@ -1324,6 +1402,13 @@ func (rsc *interfacePhysical) Delete(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultResourceDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
 	if rsc.client.FakeDeleteAlso() {
 		junSess := rsc.client.NewSessionWithoutNetconf(ctx)
@@ -1344,6 +1429,7 @@ func (rsc *interfacePhysical) Delete(
 		return
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(state.ID.ValueString())
 	if err := junSess.ConfigLock(ctx); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigLockErrSummary, err.Error())
 
@@ -1366,22 +1452,19 @@ func (rsc *interfacePhysical) Delete(
 		return
 	}
 
-	if !state.NoDisableOnDestroy.ValueBool() {
+	release := rsc.client.InterfaceRelease().WithSnapshot(state.releaseSnapshotLines())
+	if releaseLines := release.SetLines(state.Name.ValueString()); len(releaseLines) > 0 &&
+		!state.NoDisableOnDestroy.ValueBool() {
 		intExists, err := junSess.CheckInterfaceExists(state.Name.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError("Pre Disable Config Set Error", err.Error())
 		} else if intExists {
-			if err := addInterfaceNC(
-				ctx,
-				state.Name.ValueString(),
-				rsc.client.GroupInterfaceDelete(),
-				junSess,
-			); err != nil {
+			if err := junSess.ConfigSet(releaseLines); err != nil {
 				resp.Diagnostics.AddError("Disable Config Set Error", err.Error())
 
 				return
 			}
-			warns, err = junSess.CommitConf("disable(NC) resource " + rsc.typeName())
+			warns, err = junSess.CommitConf(release.LogMessage() + " resource " + rsc.typeName())
 			resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigCommitWarnSummary, warns)...)
 			if err != nil {
 				resp.Diagnostics.AddError(tfdiag.ConfigCommitErrSummary, err.Error())
@@ -1395,6 +1478,9 @@ func (rsc *interfacePhysical) Delete(
 func (rsc *interfacePhysical) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	ctx, cancel := context.WithTimeout(ctx, defaultResourceReadTimeout)
+	defer cancel()
+
 	if strings.Count(req.ID, ".") != 0 {
 		resp.Diagnostics.AddError(
 			tfdiag.PreCheckErrSummary,
@@ -1415,7 +1501,7 @@ func (rsc *interfacePhysical) ImportState(
 	ncInt, emptyInt, err := checkInterfacePhysicalNCEmpty(
 		ctx,
 		req.ID,
-		rsc.client.GroupInterfaceDelete(),
+		rsc.client.InterfaceRelease(),
 		junSess,
 	)
 	if err != nil {
@@ -1463,19 +1549,49 @@ func (rscData *interfacePhysicalData) fillID() {
 	rscData.ID = types.StringValue(rscData.Name.ValueString())
 }
 
+// releaseSnapshotLines returns the lines of snapshot captured when creating the resource.
+func (rscData *interfacePhysicalData) releaseSnapshotLines() []string {
+	if v := rscData.ReleaseSnapshot.ValueString(); v != "" {
+		return strings.Split(v, "\n")
+	}
+
+	return nil
+}
+
 func checkInterfacePhysicalNCEmpty(
-	_ context.Context, name, groupInterfaceDelete string, junSess *junos.Session,
+	ctx context.Context, name string, release junos.InterfaceRelease, junSess *junos.Session,
 ) (
 	ncInt, // interface is set with NC config
 	emtyInt bool, // interface is not set (empty)
 	_ error,
+) {
+	configLines, err := readInterfacePhysicalConfigLines(ctx, name, junSess)
+	if err != nil {
+		return false, false, err
+	}
+	if len(configLines) == 0 {
+		return false, true, nil
+	}
+	if release.IsReleased(configLines) {
+		return true, false, nil
+	}
+
+	return false, false, nil
+}
+
+// readInterfacePhysicalConfigLines returns the configuration lines (set relative) of the physical interface
+// without parameters of units (except ethernet-switching).
+func readInterfacePhysicalConfigLines(
+	_ context.Context, name string, junSess *junos.Session,
+) (
+	[]string, error,
 ) {
 	showConfig, err := junSess.Command(junos.CmdShowConfig +
 		"interfaces " + name + junos.PipeDisplaySetRelative)
 	if err != nil {
-		return false, false, err
+		return nil, err
 	}
-	showConfigLines := make([]string, 0)
+	configLines := make([]string, 0)
 	// remove unused lines
 	for _, item := range strings.Split(showConfig, "\n") {
 		// show parameters root on interface exclude unit parameters (except ethernet-switching)
@@ -1491,26 +1607,13 @@ func checkInterfacePhysicalNCEmpty(
 		if item == "" {
 			continue
 		}
-		showConfigLines = append(showConfigLines, item)
+		configLines = append(configLines, item)
 	}
-	if len(showConfigLines) == 0 {
-		return false, true, nil
-	}
-	showConfig = strings.Join(showConfigLines, "\n")
-	if groupInterfaceDelete != "" {
-		if showConfig == "set apply-groups "+groupInterfaceDelete {
-			return true, false, nil
-		}
-	}
-	if showConfig == "set description NC\nset disable" ||
-		showConfig == "set disable\nset description NC" {
-		return true, false, nil
-	}
-	if showConfig == junos.EmptyW {
-		return false, true, nil
+	if len(configLines) == 1 && configLines[0] == junos.EmptyW {
+		return []string{}, nil
 	}
 
-	return false, false, nil
+	return configLines, nil
 }
 
 func checkInterfacePhysicalContainsUnit(
@@ -1893,20 +1996,9 @@ func (block *interfacePhysicalBlockParentEtherOpts) configSet(
 }
 
 func addInterfaceNC(
-	_ context.Context, name, groupInterfaceDelete string, junSess *junos.Session,
-) (
-	err error,
-) {
-	if groupInterfaceDelete == "" {
-		err = junSess.ConfigSet([]string{"set interfaces " + name + " disable description NC"})
-	} else {
-		err = junSess.ConfigSet([]string{"set interfaces " + name + " apply-groups " + groupInterfaceDelete})
-	}
-	if err != nil {
-		return err
-	}
-
-	return nil
+	_ context.Context, name string, release junos.InterfaceRelease, junSess *junos.Session,
+) error {
+	return junSess.ConfigSet(release.DisableLines(name))
 }
 
 func (rscData *interfacePhysicalData) read(
@@ -2293,19 +2385,9 @@ func (rscData *interfacePhysicalData) del(
 }
 
 func delInterfaceNC(
-	_ context.Context, name, groupInterfaceDelete string, junSess *junos.Session,
+	_ context.Context, name string, release junos.InterfaceRelease, junSess *junos.Session,
 ) error {
-	delPrefix := "delete interfaces " + name + " "
-
-	configSet := []string{
-		delPrefix + "description",
-		delPrefix + "disable",
-	}
-	if groupInterfaceDelete != "" {
-		configSet = append(configSet, delPrefix+"apply-groups "+groupInterfaceDelete)
-	}
-
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(release.DelLines(name))
 }
 
 func findInterfaceAggregatedLastChild(
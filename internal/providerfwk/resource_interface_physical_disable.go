@@ -62,7 +62,7 @@ func (rsc *interfacePhysicalDisable) Configure(
 }
 
 func (rsc *interfacePhysicalDisable) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Disable " + rsc.junosName(),
@@ -87,12 +87,16 @@ func (rsc *interfacePhysicalDisable) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
 type interfacePhysicalDisableData struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID       types.String     `tfsdk:"id"`
+	Name     types.String     `tfsdk:"name"`
+	Timeouts resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *interfacePhysicalDisable) Create(
@@ -103,6 +107,13 @@ func (rsc *interfacePhysicalDisable) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultResourceCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	if rsc.client.FakeCreateSetFile() {
 		junSess := rsc.client.NewSessionWithoutNetconf(ctx)
@@ -110,7 +121,7 @@ func (rsc *interfacePhysicalDisable) Create(
 		if err := addInterfaceNC(
 			ctx,
 			plan.Name.ValueString(),
-			rsc.client.GroupInterfaceDelete(),
+			rsc.client.InterfaceRelease(),
 			junSess,
 		); err != nil {
 			resp.Diagnostics.AddError(tfdiag.ConfigSetErrSummary, err.Error())
@@ -131,6 +142,7 @@ func (rsc *interfacePhysicalDisable) Create(
 		return
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(plan.Name.ValueString())
 	if err := junSess.ConfigLock(ctx); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigLockErrSummary, err.Error())
 
@@ -143,7 +155,7 @@ func (rsc *interfacePhysicalDisable) Create(
 	ncInt, emptyInt, err := checkInterfacePhysicalNCEmpty(
 		ctx,
 		plan.Name.ValueString(),
-		rsc.client.GroupInterfaceDelete(),
+		rsc.client.InterfaceRelease(),
 		junSess,
 	)
 	if err != nil {
@@ -183,7 +195,7 @@ func (rsc *interfacePhysicalDisable) Create(
 	if err := addInterfaceNC(
 		ctx,
 		plan.Name.ValueString(),
-		rsc.client.GroupInterfaceDelete(),
+		rsc.client.InterfaceRelease(),
 		junSess,
 	); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigSetErrSummary, err.Error())
@@ -201,7 +213,7 @@ func (rsc *interfacePhysicalDisable) Create(
 	ncInt, _, err = checkInterfacePhysicalNCEmpty(
 		ctx,
 		plan.Name.ValueString(),
-		rsc.client.GroupInterfaceDelete(),
+		rsc.client.InterfaceRelease(),
 		junSess,
 	)
 	if err != nil {
@@ -231,6 +243,13 @@ func (rsc *interfacePhysicalDisable) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultResourceReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	junSess, err := rsc.client.StartNewSession(ctx)
 	if err != nil {
@@ -244,7 +263,7 @@ func (rsc *interfacePhysicalDisable) Read(
 	ncInt, _, err := checkInterfacePhysicalNCEmpty(
 		ctx,
 		state.Name.ValueString(),
-		rsc.client.GroupInterfaceDelete(),
+		rsc.client.InterfaceRelease(),
 		junSess,
 	)
 	junos.MutexUnlock()
@@ -63,7 +63,7 @@ func (rsc *interfaceSt0Unit) Configure(
 }
 
 func (rsc *interfaceSt0Unit) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Find an available " + rsc.junosName() + " and create it.",
@@ -76,16 +76,33 @@ func (rsc *interfaceSt0Unit) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
 type interfaceSt0UnitData struct {
-	ID types.String `tfsdk:"id"`
+	ID       types.String     `tfsdk:"id"`
+	Timeouts resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *interfaceSt0Unit) Create(
-	ctx context.Context, _ resource.CreateRequest, resp *resource.CreateResponse,
+	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
+	var plan interfaceSt0UnitData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultResourceCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	junSess, err := rsc.client.StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -108,6 +125,7 @@ func (rsc *interfaceSt0Unit) Create(
 
 		return
 	}
+	junSess.SetResourceID(newSt0)
 	if err := junSess.ConfigSet([]string{
 		"set interfaces " + newSt0,
 	}); err != nil {
@@ -126,7 +144,7 @@ func (rsc *interfaceSt0Unit) Create(
 	ncInt, emptyInt, setInt, err := checkInterfaceLogicalNCEmpty(
 		ctx,
 		newSt0,
-		rsc.client.GroupInterfaceDelete(),
+		rsc.client.InterfaceRelease().Logical(),
 		junSess,
 	)
 	if err != nil {
@@ -154,7 +172,8 @@ func (rsc *interfaceSt0Unit) Create(
 	}
 
 	data := interfaceSt0UnitData{
-		ID: types.StringValue(newSt0),
+		ID:       types.StringValue(newSt0),
+		Timeouts: plan.Timeouts,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
@@ -167,6 +186,13 @@ func (rsc *interfaceSt0Unit) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultResourceReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	junSess, err := rsc.client.StartNewSession(ctx)
 	if err != nil {
@@ -180,7 +206,7 @@ func (rsc *interfaceSt0Unit) Read(
 	ncInt, emptyInt, setInt, err := checkInterfaceLogicalNCEmpty(
 		ctx,
 		state.ID.ValueString(),
-		rsc.client.GroupInterfaceDelete(),
+		rsc.client.InterfaceRelease().Logical(),
 		junSess,
 	)
 	junos.MutexUnlock()
@@ -210,6 +236,13 @@ func (rsc *interfaceSt0Unit) Delete(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultResourceDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
 	if rsc.client.FakeDeleteAlso() {
 		junSess := rsc.client.NewSessionWithoutNetconf(ctx)
@@ -232,6 +265,7 @@ func (rsc *interfaceSt0Unit) Delete(
 		return
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(state.ID.ValueString())
 	if err := junSess.ConfigLock(ctx); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigLockErrSummary, err.Error())
 
@@ -244,7 +278,7 @@ func (rsc *interfaceSt0Unit) Delete(
 	ncInt, emptyInt, _, err := checkInterfaceLogicalNCEmpty(
 		ctx,
 		state.ID.ValueString(),
-		rsc.client.GroupInterfaceDelete(),
+		rsc.client.InterfaceRelease().Logical(),
 		junSess,
 	)
 	if err != nil {
@@ -287,6 +321,9 @@ func (rsc *interfaceSt0Unit) ImportState(
 
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, defaultResourceReadTimeout)
+	defer cancel()
+
 	junSess, err := rsc.client.StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -298,7 +335,7 @@ func (rsc *interfaceSt0Unit) ImportState(
 	ncInt, emptyInt, setInt, err := checkInterfaceLogicalNCEmpty(
 		ctx,
 		req.ID,
-		rsc.client.GroupInterfaceDelete(),
+		rsc.client.InterfaceRelease().Logical(),
 		junSess,
 	)
 	if err != nil {
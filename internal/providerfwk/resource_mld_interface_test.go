@@ -0,0 +1,97 @@
+package providerfwk_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// export TESTACC_INTERFACE=<inteface> for choose interface available else it's ge-0/0/3.
+func TestAccJunosMldInterface_basic(t *testing.T) {
+	testaccInterface := junos.DefaultInterfaceTestAcc
+	if iface := os.Getenv("TESTACC_INTERFACE"); iface != "" {
+		testaccInterface = iface
+	}
+	if os.Getenv("TESTACC_ROUTER") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosMldInterfaceConfigCreate(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_mld_interface.testacc_mld",
+							"id", testaccInterface+".0"+junos.IDSeparator+junos.DefaultW),
+						resource.TestCheckResourceAttr("junos_mld_interface.testacc_mld",
+							"static_group.#", "2"),
+					),
+				},
+				{
+					Config: testAccJunosMldInterfaceConfigUpdate(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_mld_interface.testacc_mld",
+							"version", "1"),
+					),
+				},
+				{
+					ResourceName:      "junos_mld_interface.testacc_mld",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosMldInterfaceConfigCreate(interFace string) string {
+	return fmt.Sprintf(`
+resource "junos_interface_logical" "testacc_mld" {
+  name = "%s.0"
+  family_inet6 {
+    address {
+      cidr_ip = "2001:db8::1/64"
+    }
+  }
+}
+resource "junos_mld_interface" "testacc_mld" {
+  name            = junos_interface_logical.testacc_mld.name
+  version         = "2"
+  immediate_leave = true
+  static_group {
+    address = "ff3e::1"
+    source  = ["2001:db8::10"]
+  }
+  static_group {
+    address = "ff3e::2"
+  }
+}
+`, interFace)
+}
+
+func testAccJunosMldInterfaceConfigUpdate(interFace string) string {
+	return fmt.Sprintf(`
+resource "junos_interface_logical" "testacc_mld" {
+  name = "%s.0"
+  family_inet6 {
+    address {
+      cidr_ip = "2001:db8::1/64"
+    }
+  }
+}
+resource "junos_mld_interface" "testacc_mld" {
+  name         = junos_interface_logical.testacc_mld.name
+  version      = "1"
+  group_policy = [junos_policyoptions_policy_statement.testacc_mld.name]
+}
+resource "junos_policyoptions_policy_statement" "testacc_mld" {
+  name = "testacc_mld"
+  then {
+    action = "accept"
+  }
+}
+`, interFace)
+}
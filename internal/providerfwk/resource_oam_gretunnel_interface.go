@@ -75,7 +75,7 @@ func (rsc *oamGretunnelInterface) Configure(
 }
 
 func (rsc *oamGretunnelInterface) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -115,14 +115,18 @@ func (rsc *oamGretunnelInterface) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
 type oamGretunnelInterfaceData struct {
-	ID            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	HoldTime      types.Int64  `tfsdk:"hold_time"`
-	KeepaliveTime types.Int64  `tfsdk:"keepalive_time"`
+	ID            types.String     `tfsdk:"id"`
+	Name          types.String     `tfsdk:"name"`
+	HoldTime      types.Int64      `tfsdk:"hold_time"`
+	KeepaliveTime types.Int64      `tfsdk:"keepalive_time"`
+	Timeouts      resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *oamGretunnelInterface) ValidateConfig(
@@ -0,0 +1,871 @@
+package providerfwk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdata"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfplanmodifier"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	balt "github.com/jeremmfr/go-utils/basicalter"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &pim{}
+	_ resource.ResourceWithConfigure      = &pim{}
+	_ resource.ResourceWithValidateConfig = &pim{}
+	_ resource.ResourceWithImportState    = &pim{}
+)
+
+type pim struct {
+	client *junos.Client
+}
+
+func newPimResource() resource.Resource {
+	return &pim{}
+}
+
+func (rsc *pim) typeName() string {
+	return providerName + "_pim"
+}
+
+func (rsc *pim) junosName() string {
+	return "protocols pim"
+}
+
+func (rsc *pim) junosClient() *junos.Client {
+	return rsc.client
+}
+
+func (rsc *pim) Metadata(
+	_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse,
+) {
+	resp.TypeName = rsc.typeName()
+}
+
+func (rsc *pim) Configure(
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedResourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	rsc.client = client
+}
+
+func (rsc *pim) Schema(
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+) {
+	localAttributes := func(ipVersion string) map[string]schema.Attribute {
+		addressValidator := tfvalidator.StringIPAddress().IPv4Only()
+		if ipVersion == junos.Inet6W {
+			addressValidator = tfvalidator.StringIPAddress().IPv6Only()
+		}
+
+		return map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				Required:    false, // true when SingleNestedBlock is specified
+				Optional:    true,
+				Description: "Local RP address.",
+				Validators: []validator.String{
+					addressValidator,
+				},
+			},
+			"group_ranges": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Group address ranges.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						tfvalidator.StringCIDRNetwork(),
+					),
+				},
+			},
+			"hold_time": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How long neighbor considers this router to be up, in seconds.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Router's priority for becoming an RP.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 255),
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Configure static configuration in `" + rsc.junosName() + "` block " +
+			"(in root level or in a routing-instance)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the resource with format `<routing_instance>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"routing_instance": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(junos.DefaultW),
+				Description: "Routing instance for pim protocol if not root level.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"interface": schema.ListNestedBlock{
+				Description: "For each interface (or `all`) to configure.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Interface name or `all`.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+								tfvalidator.StringFormat(tfvalidator.InterfaceFormat),
+							},
+						},
+						"accept_remote_source": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Accept traffic from remote source.",
+							Validators: []validator.Bool{
+								tfvalidator.BoolTrue(),
+							},
+						},
+						"disable": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Disable PIM on this interface.",
+							Validators: []validator.Bool{
+								tfvalidator.BoolTrue(),
+							},
+						},
+						"distributed_dr": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Enable PIM distributed designated router functionality.",
+							Validators: []validator.Bool{
+								tfvalidator.BoolTrue(),
+							},
+						},
+						"hello_interval": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Hello interval (seconds).",
+							Validators: []validator.Int64{
+								int64validator.Between(0, 255),
+							},
+						},
+						"mode": schema.StringAttribute{
+							Optional:    true,
+							Description: "Mode of interface.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("dense", "sparse", "sparse-dense"),
+							},
+						},
+						"priority": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Hello option DR priority.",
+							Validators: []validator.Int64{
+								int64validator.Between(0, 4294967295),
+							},
+						},
+					},
+				},
+			},
+			"rp": schema.SingleNestedBlock{
+				Description: "Declare `rp` configuration (rendezvous point).",
+				Attributes: map[string]schema.Attribute{
+					"bootstrap_family_inet_priority": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Eligibility to be the bootstrap router for IPv4.",
+						Validators: []validator.Int64{
+							int64validator.Between(0, 255),
+						},
+					},
+					"bootstrap_family_inet6_priority": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Eligibility to be the bootstrap router for IPv6.",
+						Validators: []validator.Int64{
+							int64validator.Between(0, 255),
+						},
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"local": schema.SingleNestedBlock{
+						Description: "Declare `local` configuration to be a local RP for IPv4.",
+						Attributes:  localAttributes(junos.InetW),
+						PlanModifiers: []planmodifier.Object{
+							tfplanmodifier.BlockRemoveNull(),
+						},
+					},
+					"local_inet6": schema.SingleNestedBlock{
+						Description: "Declare `local family inet6` configuration to be a local RP for IPv6.",
+						Attributes:  localAttributes(junos.Inet6W),
+						PlanModifiers: []planmodifier.Object{
+							tfplanmodifier.BlockRemoveNull(),
+						},
+					},
+					"static": schema.ListNestedBlock{
+						Description: "For each static RP address.",
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"address": schema.StringAttribute{
+									Required:    true,
+									Description: "Static RP address.",
+									Validators: []validator.String{
+										tfvalidator.StringIPAddress(),
+									},
+								},
+								"group_ranges": schema.SetAttribute{
+									ElementType: types.StringType,
+									Optional:    true,
+									Description: "Group address ranges.",
+									Validators: []validator.Set{
+										setvalidator.SizeAtLeast(1),
+										setvalidator.ValueStringsAre(
+											tfvalidator.StringCIDRNetwork(),
+										),
+									},
+								},
+								"override": schema.BoolAttribute{
+									Optional:    true,
+									Description: "Static RP mapping takes precedence over dynamic RP mapping.",
+									Validators: []validator.Bool{
+										tfvalidator.BoolTrue(),
+									},
+								},
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					tfplanmodifier.BlockRemoveNull(),
+				},
+			},
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
+	}
+}
+
+type pimData struct {
+	ID              types.String        `tfsdk:"id"`
+	RoutingInstance types.String        `tfsdk:"routing_instance"`
+	Interface       []pimBlockInterface `tfsdk:"interface"`
+	Rp              *pimBlockRp         `tfsdk:"rp"`
+	Timeouts        resourceTimeouts    `tfsdk:"timeouts"`
+}
+
+type pimConfig struct {
+	ID              types.String      `tfsdk:"id"`
+	RoutingInstance types.String      `tfsdk:"routing_instance"`
+	Interface       types.List        `tfsdk:"interface"`
+	Rp              *pimBlockRpConfig `tfsdk:"rp"`
+	Timeouts        resourceTimeouts  `tfsdk:"timeouts"`
+}
+
+type pimBlockInterface struct {
+	Name               types.String `tfsdk:"name"`
+	AcceptRemoteSource types.Bool   `tfsdk:"accept_remote_source"`
+	Disable            types.Bool   `tfsdk:"disable"`
+	DistributedDR      types.Bool   `tfsdk:"distributed_dr"`
+	HelloInterval      types.Int64  `tfsdk:"hello_interval"`
+	Mode               types.String `tfsdk:"mode"`
+	Priority           types.Int64  `tfsdk:"priority"`
+}
+
+type pimBlockRp struct {
+	BootstrapFamilyInetPriority  types.Int64             `tfsdk:"bootstrap_family_inet_priority"`
+	BootstrapFamilyInet6Priority types.Int64             `tfsdk:"bootstrap_family_inet6_priority"`
+	Local                        *pimBlockRpBlockLocal   `tfsdk:"local"`
+	LocalInet6                   *pimBlockRpBlockLocal   `tfsdk:"local_inet6"`
+	Static                       []pimBlockRpBlockStatic `tfsdk:"static"`
+}
+
+type pimBlockRpConfig struct {
+	BootstrapFamilyInetPriority  types.Int64                 `tfsdk:"bootstrap_family_inet_priority"`
+	BootstrapFamilyInet6Priority types.Int64                 `tfsdk:"bootstrap_family_inet6_priority"`
+	Local                        *pimBlockRpBlockLocalConfig `tfsdk:"local"`
+	LocalInet6                   *pimBlockRpBlockLocalConfig `tfsdk:"local_inet6"`
+	Static                       types.List                  `tfsdk:"static"`
+}
+
+func (block *pimBlockRpConfig) isEmpty() bool {
+	switch {
+	case !block.BootstrapFamilyInetPriority.IsNull():
+		return false
+	case !block.BootstrapFamilyInet6Priority.IsNull():
+		return false
+	case block.Local != nil:
+		return false
+	case block.LocalInet6 != nil:
+		return false
+	case !block.Static.IsNull():
+		return false
+	default:
+		return true
+	}
+}
+
+type pimBlockRpBlockLocal struct {
+	Address     types.String   `tfsdk:"address"`
+	GroupRanges []types.String `tfsdk:"group_ranges"`
+	HoldTime    types.Int64    `tfsdk:"hold_time"`
+	Priority    types.Int64    `tfsdk:"priority"`
+}
+
+type pimBlockRpBlockLocalConfig struct {
+	Address     types.String `tfsdk:"address"`
+	GroupRanges types.Set    `tfsdk:"group_ranges"`
+	HoldTime    types.Int64  `tfsdk:"hold_time"`
+	Priority    types.Int64  `tfsdk:"priority"`
+}
+
+type pimBlockRpBlockStatic struct {
+	Address     types.String   `tfsdk:"address"`
+	GroupRanges []types.String `tfsdk:"group_ranges"`
+	Override    types.Bool     `tfsdk:"override"`
+}
+
+type pimBlockRpBlockStaticConfig struct {
+	Address     types.String `tfsdk:"address"`
+	GroupRanges types.Set    `tfsdk:"group_ranges"`
+	Override    types.Bool   `tfsdk:"override"`
+}
+
+func (rsc *pim) ValidateConfig(
+	ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse,
+) {
+	var config pimConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Interface.IsNull() && !config.Interface.IsUnknown() {
+		var configInterface []pimBlockInterface
+		asDiags := config.Interface.ElementsAs(ctx, &configInterface, false)
+		if asDiags.HasError() {
+			resp.Diagnostics.Append(asDiags...)
+
+			return
+		}
+		interfaceName := make(map[string]struct{})
+		for i, block := range configInterface {
+			if block.Name.IsUnknown() {
+				continue
+			}
+			name := block.Name.ValueString()
+			if _, ok := interfaceName[name]; ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("interface").AtListIndex(i).AtName("name"),
+					tfdiag.DuplicateConfigErrSummary,
+					fmt.Sprintf("multiple interface blocks with the same name %q", name),
+				)
+			}
+			interfaceName[name] = struct{}{}
+		}
+	}
+
+	if config.Rp != nil {
+		if config.Rp.isEmpty() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rp").AtName("*"),
+				tfdiag.MissingConfigErrSummary,
+				"rp block is empty",
+			)
+		}
+		if config.Rp.Local != nil {
+			if config.Rp.Local.Address.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("rp").AtName("local").AtName("address"),
+					tfdiag.MissingConfigErrSummary,
+					"address must be specified in local block in rp block",
+				)
+			}
+		}
+		if config.Rp.LocalInet6 != nil {
+			if config.Rp.LocalInet6.Address.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("rp").AtName("local_inet6").AtName("address"),
+					tfdiag.MissingConfigErrSummary,
+					"address must be specified in local_inet6 block in rp block",
+				)
+			}
+		}
+		if !config.Rp.Static.IsNull() && !config.Rp.Static.IsUnknown() {
+			var configStatic []pimBlockRpBlockStaticConfig
+			asDiags := config.Rp.Static.ElementsAs(ctx, &configStatic, false)
+			if asDiags.HasError() {
+				resp.Diagnostics.Append(asDiags...)
+
+				return
+			}
+			staticAddress := make(map[string]struct{})
+			for i, block := range configStatic {
+				if block.Address.IsUnknown() {
+					continue
+				}
+				address := block.Address.ValueString()
+				if _, ok := staticAddress[address]; ok {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("rp").AtName("static").AtListIndex(i).AtName("address"),
+						tfdiag.DuplicateConfigErrSummary,
+						fmt.Sprintf("multiple static blocks with the same address %q in rp block", address),
+					)
+				}
+				staticAddress[address] = struct{}{}
+			}
+		}
+	}
+}
+
+func (rsc *pim) Create(
+	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
+) {
+	var plan pimData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceCreate(
+		ctx,
+		rsc,
+		func(fnCtx context.Context, junSess *junos.Session) bool {
+			if v := plan.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
+				instanceExists, err := checkRoutingInstanceExists(fnCtx, v, junSess)
+				if err != nil {
+					resp.Diagnostics.AddError(tfdiag.PreCheckErrSummary, err.Error())
+
+					return false
+				}
+				if !instanceExists {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("routing_instance"),
+						tfdiag.MissingConfigErrSummary,
+						fmt.Sprintf("routing instance %q doesn't exist", v),
+					)
+
+					return false
+				}
+			}
+
+			return true
+		},
+		nil,
+		&plan,
+		resp,
+	)
+}
+
+func (rsc *pim) Read(
+	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse,
+) {
+	var state, data pimData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var _ resourceDataReadFrom1String = &data
+	defaultResourceRead(
+		ctx,
+		rsc,
+		[]string{
+			state.RoutingInstance.ValueString(),
+		},
+		&data,
+		nil,
+		resp,
+	)
+}
+
+func (rsc *pim) Update(
+	ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
+) {
+	var plan, state pimData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceUpdate(
+		ctx,
+		rsc,
+		&state,
+		&plan,
+		resp,
+	)
+}
+
+func (rsc *pim) Delete(
+	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
+) {
+	var state pimData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceDelete(
+		ctx,
+		rsc,
+		&state,
+		resp,
+	)
+}
+
+func (rsc *pim) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
+) {
+	var data pimData
+
+	var _ resourceDataReadFrom1String = &data
+	defaultResourceImportState(
+		ctx,
+		rsc,
+		&data,
+		req,
+		resp,
+		fmt.Sprintf("don't find "+rsc.junosName()+" with id %q "+
+			"(id must be <routing_instance>)", req.ID),
+	)
+}
+
+func (rscData *pimData) fillID() {
+	if v := rscData.RoutingInstance.ValueString(); v != "" {
+		rscData.ID = types.StringValue(v)
+	} else {
+		rscData.ID = types.StringValue(junos.DefaultW)
+	}
+}
+
+func (rscData *pimData) nullID() bool {
+	return rscData.ID.IsNull()
+}
+
+func (rscData *pimData) set(
+	_ context.Context, junSess *junos.Session,
+) (
+	path.Path, error,
+) {
+	setPrefix := junos.SetLS
+	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
+		setPrefix = junos.SetRoutingInstances + v + " "
+	}
+	setPrefix += "protocols pim "
+
+	configSet := []string{
+		setPrefix,
+	}
+	interfaceName := make(map[string]struct{})
+	for i, block := range rscData.Interface {
+		name := block.Name.ValueString()
+		if _, ok := interfaceName[name]; ok {
+			return path.Root("interface").AtListIndex(i).AtName("name"),
+				fmt.Errorf("multiple interface blocks with the same name %q", name)
+		}
+		interfaceName[name] = struct{}{}
+
+		configSet = append(configSet, block.configSet(setPrefix)...)
+	}
+	if rscData.Rp != nil {
+		if rscData.Rp.isEmpty() {
+			return path.Root("rp").AtName("*"),
+				fmt.Errorf("rp block is empty")
+		}
+
+		blockSet, pathErr, err := rscData.Rp.configSet(setPrefix)
+		if err != nil {
+			return pathErr, err
+		}
+		configSet = append(configSet, blockSet...)
+	}
+
+	return path.Empty(), junSess.ConfigSet(configSet)
+}
+
+func (block *pimBlockInterface) configSet(setPrefix string) []string {
+	setPrefix += "interface " + block.Name.ValueString() + " "
+
+	configSet := []string{
+		setPrefix,
+	}
+	if block.AcceptRemoteSource.ValueBool() {
+		configSet = append(configSet, setPrefix+"accept-remote-source")
+	}
+	if block.Disable.ValueBool() {
+		configSet = append(configSet, setPrefix+"disable")
+	}
+	if block.DistributedDR.ValueBool() {
+		configSet = append(configSet, setPrefix+"distributed-dr")
+	}
+	if !block.HelloInterval.IsNull() {
+		configSet = append(configSet, setPrefix+"hello-interval "+
+			utils.ConvI64toa(block.HelloInterval.ValueInt64()))
+	}
+	if v := block.Mode.ValueString(); v != "" {
+		configSet = append(configSet, setPrefix+"mode "+v)
+	}
+	if !block.Priority.IsNull() {
+		configSet = append(configSet, setPrefix+"priority "+
+			utils.ConvI64toa(block.Priority.ValueInt64()))
+	}
+
+	return configSet
+}
+
+func (block *pimBlockRp) isEmpty() bool {
+	switch {
+	case !block.BootstrapFamilyInetPriority.IsNull():
+		return false
+	case !block.BootstrapFamilyInet6Priority.IsNull():
+		return false
+	case block.Local != nil:
+		return false
+	case block.LocalInet6 != nil:
+		return false
+	case len(block.Static) != 0:
+		return false
+	default:
+		return true
+	}
+}
+
+func (block *pimBlockRp) configSet(setPrefix string) (
+	[]string, // configSet
+	path.Path, // pathErr
+	error, // error
+) {
+	setPrefix += "rp "
+
+	configSet := make([]string, 0)
+	if !block.BootstrapFamilyInetPriority.IsNull() {
+		configSet = append(configSet, setPrefix+"bootstrap family inet priority "+
+			utils.ConvI64toa(block.BootstrapFamilyInetPriority.ValueInt64()))
+	}
+	if !block.BootstrapFamilyInet6Priority.IsNull() {
+		configSet = append(configSet, setPrefix+"bootstrap family inet6 priority "+
+			utils.ConvI64toa(block.BootstrapFamilyInet6Priority.ValueInt64()))
+	}
+	if block.Local != nil {
+		configSet = append(configSet, block.Local.configSet(setPrefix+"local ")...)
+	}
+	if block.LocalInet6 != nil {
+		configSet = append(configSet, block.LocalInet6.configSet(setPrefix+"local family inet6 ")...)
+	}
+	staticAddress := make(map[string]struct{})
+	for i, blockStatic := range block.Static {
+		address := blockStatic.Address.ValueString()
+		if _, ok := staticAddress[address]; ok {
+			return configSet,
+				path.Root("rp").AtName("static").AtListIndex(i).AtName("address"),
+				fmt.Errorf("multiple static blocks with the same address %q in rp block", address)
+		}
+		staticAddress[address] = struct{}{}
+
+		setPrefixStatic := setPrefix + "static address " + address
+		configSet = append(configSet, setPrefixStatic)
+		for _, v := range blockStatic.GroupRanges {
+			configSet = append(configSet, setPrefixStatic+" group-ranges "+v.ValueString())
+		}
+		if blockStatic.Override.ValueBool() {
+			configSet = append(configSet, setPrefixStatic+" override")
+		}
+	}
+
+	return configSet, path.Empty(), nil
+}
+
+func (block *pimBlockRpBlockLocal) configSet(setPrefix string) []string {
+	configSet := []string{
+		setPrefix + "address " + block.Address.ValueString(),
+	}
+	for _, v := range block.GroupRanges {
+		configSet = append(configSet, setPrefix+"group-ranges "+v.ValueString())
+	}
+	if !block.HoldTime.IsNull() {
+		configSet = append(configSet, setPrefix+"hold-time "+
+			utils.ConvI64toa(block.HoldTime.ValueInt64()))
+	}
+	if !block.Priority.IsNull() {
+		configSet = append(configSet, setPrefix+"priority "+
+			utils.ConvI64toa(block.Priority.ValueInt64()))
+	}
+
+	return configSet
+}
+
+func (rscData *pimData) read(
+	_ context.Context, routingInstance string, junSess *junos.Session,
+) (
+	err error,
+) {
+	showPrefix := junos.CmdShowConfig
+	if routingInstance != "" && routingInstance != junos.DefaultW {
+		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
+	}
+	showConfig, err := junSess.Command(showPrefix + "protocols pim" + junos.PipeDisplaySetRelative)
+	if err != nil {
+		return err
+	}
+	if showConfig != junos.EmptyW {
+		if routingInstance == "" {
+			rscData.RoutingInstance = types.StringValue(junos.DefaultW)
+		} else {
+			rscData.RoutingInstance = types.StringValue(routingInstance)
+		}
+		rscData.fillID()
+		for _, item := range strings.Split(showConfig, "\n") {
+			if strings.Contains(item, junos.XMLStartTagConfigOut) {
+				continue
+			}
+			if strings.Contains(item, junos.XMLEndTagConfigOut) {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, junos.SetLS)
+			switch {
+			case balt.CutPrefixInString(&itemTrim, "interface "):
+				name := tfdata.FirstElementOfJunosLine(itemTrim)
+				var iface pimBlockInterface
+				rscData.Interface, iface = tfdata.ExtractBlockWithTFTypesString(
+					rscData.Interface, "Name", name,
+				)
+				iface.Name = types.StringValue(name)
+				balt.CutPrefixInString(&itemTrim, name+" ")
+				if err := iface.read(itemTrim); err != nil {
+					return err
+				}
+				rscData.Interface = append(rscData.Interface, iface)
+			case balt.CutPrefixInString(&itemTrim, "rp "):
+				if rscData.Rp == nil {
+					rscData.Rp = &pimBlockRp{}
+				}
+				if err := rscData.Rp.read(itemTrim); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (block *pimBlockInterface) read(itemTrim string) (err error) {
+	switch {
+	case itemTrim == "accept-remote-source":
+		block.AcceptRemoteSource = types.BoolValue(true)
+	case itemTrim == "disable":
+		block.Disable = types.BoolValue(true)
+	case itemTrim == "distributed-dr":
+		block.DistributedDR = types.BoolValue(true)
+	case balt.CutPrefixInString(&itemTrim, "hello-interval "):
+		block.HelloInterval, err = tfdata.ConvAtoi64Value(itemTrim)
+	case balt.CutPrefixInString(&itemTrim, "mode "):
+		block.Mode = types.StringValue(itemTrim)
+	case balt.CutPrefixInString(&itemTrim, "priority "):
+		block.Priority, err = tfdata.ConvAtoi64Value(itemTrim)
+	}
+
+	return err
+}
+
+func (block *pimBlockRp) read(itemTrim string) (err error) {
+	switch {
+	case balt.CutPrefixInString(&itemTrim, "bootstrap family inet priority "):
+		block.BootstrapFamilyInetPriority, err = tfdata.ConvAtoi64Value(itemTrim)
+	case balt.CutPrefixInString(&itemTrim, "bootstrap family inet6 priority "):
+		block.BootstrapFamilyInet6Priority, err = tfdata.ConvAtoi64Value(itemTrim)
+	case balt.CutPrefixInString(&itemTrim, "local family inet6 "):
+		if block.LocalInet6 == nil {
+			block.LocalInet6 = &pimBlockRpBlockLocal{}
+		}
+		err = block.LocalInet6.read(itemTrim)
+	case balt.CutPrefixInString(&itemTrim, "local "):
+		if block.Local == nil {
+			block.Local = &pimBlockRpBlockLocal{}
+		}
+		err = block.Local.read(itemTrim)
+	case balt.CutPrefixInString(&itemTrim, "static address "):
+		address := tfdata.FirstElementOfJunosLine(itemTrim)
+		var static pimBlockRpBlockStatic
+		block.Static, static = tfdata.ExtractBlockWithTFTypesString(
+			block.Static, "Address", address,
+		)
+		static.Address = types.StringValue(address)
+		balt.CutPrefixInString(&itemTrim, address+" ")
+		switch {
+		case balt.CutPrefixInString(&itemTrim, "group-ranges "):
+			static.GroupRanges = append(static.GroupRanges, types.StringValue(itemTrim))
+		case itemTrim == "override":
+			static.Override = types.BoolValue(true)
+		}
+		block.Static = append(block.Static, static)
+	}
+
+	return err
+}
+
+func (block *pimBlockRpBlockLocal) read(itemTrim string) (err error) {
+	switch {
+	case balt.CutPrefixInString(&itemTrim, "address "):
+		block.Address = types.StringValue(itemTrim)
+	case balt.CutPrefixInString(&itemTrim, "group-ranges "):
+		block.GroupRanges = append(block.GroupRanges, types.StringValue(itemTrim))
+	case balt.CutPrefixInString(&itemTrim, "hold-time "):
+		block.HoldTime, err = tfdata.ConvAtoi64Value(itemTrim)
+	case balt.CutPrefixInString(&itemTrim, "priority "):
+		block.Priority, err = tfdata.ConvAtoi64Value(itemTrim)
+	}
+
+	return err
+}
+
+func (rscData *pimData) del(
+	_ context.Context, junSess *junos.Session,
+) error {
+	configSet := []string{
+		junos.DeleteLS + "protocols pim",
+	}
+	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
+		configSet = []string{
+			junos.DelRoutingInstances + v + " protocols pim",
+		}
+	}
+
+	return junSess.ConfigSet(configSet)
+}
@@ -0,0 +1,140 @@
+package providerfwk_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// export TESTACC_INTERFACE=<inteface> for choose interface available else it's ge-0/0/3.
+func TestAccJunosPim_basic(t *testing.T) {
+	testaccInterface := junos.DefaultInterfaceTestAcc
+	if iface := os.Getenv("TESTACC_INTERFACE"); iface != "" {
+		testaccInterface = iface
+	}
+	if os.Getenv("TESTACC_ROUTER") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosPimConfigCreate(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_pim.testacc_pim",
+							"id", junos.DefaultW),
+						resource.TestCheckResourceAttr("junos_pim.testacc_pim",
+							"interface.#", "2"),
+						resource.TestCheckResourceAttr("junos_pim.testacc_pim_ri",
+							"id", "testacc_pim"),
+					),
+				},
+				{
+					Config: testAccJunosPimConfigUpdate(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_pim.testacc_pim",
+							"rp.static.#", "2"),
+					),
+				},
+				{
+					ResourceName:      "junos_pim.testacc_pim",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					ResourceName:      "junos_pim.testacc_pim_ri",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosPimConfigCreate(interFace string) string {
+	return fmt.Sprintf(`
+resource "junos_interface_logical" "testacc_pim" {
+  name = "%s.0"
+  family_inet {
+    address {
+      cidr_ip = "192.0.2.1/25"
+    }
+  }
+}
+resource "junos_pim" "testacc_pim" {
+  interface {
+    name = "all"
+    mode = "sparse"
+  }
+  interface {
+    name                 = junos_interface_logical.testacc_pim.name
+    accept_remote_source = true
+    hello_interval       = 20
+    priority             = 10
+  }
+  rp {
+    bootstrap_family_inet_priority = 10
+    local {
+      address      = "192.0.2.1"
+      group_ranges = ["239.0.0.0/8"]
+      priority     = 10
+    }
+  }
+}
+resource "junos_routing_instance" "testacc_pim" {
+  name = "testacc_pim"
+  type = "virtual-router"
+}
+resource "junos_pim" "testacc_pim_ri" {
+  routing_instance = junos_routing_instance.testacc_pim.name
+  rp {
+    static {
+      address = "192.0.2.129"
+    }
+  }
+}
+`, interFace)
+}
+
+func testAccJunosPimConfigUpdate(interFace string) string {
+	return fmt.Sprintf(`
+resource "junos_interface_logical" "testacc_pim" {
+  name = "%s.0"
+  family_inet {
+    address {
+      cidr_ip = "192.0.2.1/25"
+    }
+  }
+}
+resource "junos_pim" "testacc_pim" {
+  interface {
+    name           = junos_interface_logical.testacc_pim.name
+    mode           = "sparse"
+    distributed_dr = true
+  }
+  rp {
+    static {
+      address      = "192.0.2.10"
+      group_ranges = ["239.1.0.0/16", "239.2.0.0/16"]
+    }
+    static {
+      address  = "192.0.2.11"
+      override = true
+    }
+  }
+}
+resource "junos_routing_instance" "testacc_pim" {
+  name = "testacc_pim"
+  type = "virtual-router"
+}
+resource "junos_pim" "testacc_pim_ri" {
+  routing_instance = junos_routing_instance.testacc_pim.name
+  interface {
+    name = "all"
+  }
+}
+`, interFace)
+}
@@ -70,7 +70,7 @@ func (rsc *policyoptionsASPath) Configure(
 }
 
 func (rsc *policyoptionsASPath) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -109,14 +109,18 @@ func (rsc *policyoptionsASPath) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
 type policyoptionsASPathData struct {
-	DynamicDB types.Bool   `tfsdk:"dynamic_db"`
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Path      types.String `tfsdk:"path"`
+	DynamicDB types.Bool       `tfsdk:"dynamic_db"`
+	ID        types.String     `tfsdk:"id"`
+	Name      types.String     `tfsdk:"name"`
+	Path      types.String     `tfsdk:"path"`
+	Timeouts  resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *policyoptionsASPath) ValidateConfig(
@@ -72,7 +72,7 @@ func (rsc *policyoptionsASPathGroup) Configure(
 }
 
 func (rsc *policyoptionsASPathGroup) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -127,6 +127,7 @@ func (rsc *policyoptionsASPathGroup) Schema(
 					},
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -136,13 +137,15 @@ type policyoptionsASPathGroupData struct {
 	ID        types.String                          `tfsdk:"id"`
 	Name      types.String                          `tfsdk:"name"`
 	ASPath    []policyoptionsASPathGroupBlockASPAth `tfsdk:"as_path"`
+	Timeouts  resourceTimeouts                      `tfsdk:"timeouts"`
 }
 
 type policyoptionsASPathGroupConfig struct {
-	DynamicDB types.Bool   `tfsdk:"dynamic_db"`
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	ASPath    types.List   `tfsdk:"as_path"`
+	DynamicDB types.Bool       `tfsdk:"dynamic_db"`
+	ID        types.String     `tfsdk:"id"`
+	Name      types.String     `tfsdk:"name"`
+	ASPath    types.List       `tfsdk:"as_path"`
+	Timeouts  resourceTimeouts `tfsdk:"timeouts"`
 }
 
 type policyoptionsASPathGroupBlockASPAth struct {
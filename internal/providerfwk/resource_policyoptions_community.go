@@ -72,7 +72,7 @@ func (rsc *policyoptionsCommunity) Configure(
 }
 
 func (rsc *policyoptionsCommunity) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -122,23 +122,28 @@ func (rsc *policyoptionsCommunity) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
 type policyoptionsCommunityData struct {
-	DynamicDB   types.Bool     `tfsdk:"dynamic_db"`
-	InvertMatch types.Bool     `tfsdk:"invert_match"`
-	ID          types.String   `tfsdk:"id"`
-	Name        types.String   `tfsdk:"name"`
-	Members     []types.String `tfsdk:"members"`
+	DynamicDB   types.Bool       `tfsdk:"dynamic_db"`
+	InvertMatch types.Bool       `tfsdk:"invert_match"`
+	ID          types.String     `tfsdk:"id"`
+	Name        types.String     `tfsdk:"name"`
+	Members     []types.String   `tfsdk:"members"`
+	Timeouts    resourceTimeouts `tfsdk:"timeouts"`
 }
 
 type policyoptionsCommunityConfig struct {
-	DynamicDB   types.Bool   `tfsdk:"dynamic_db"`
-	InvertMatch types.Bool   `tfsdk:"invert_match"`
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Members     types.List   `tfsdk:"members"`
+	DynamicDB   types.Bool       `tfsdk:"dynamic_db"`
+	InvertMatch types.Bool       `tfsdk:"invert_match"`
+	ID          types.String     `tfsdk:"id"`
+	Name        types.String     `tfsdk:"name"`
+	Members     types.List       `tfsdk:"members"`
+	Timeouts    resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *policyoptionsCommunity) ValidateConfig(
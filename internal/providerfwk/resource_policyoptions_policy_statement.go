@@ -79,7 +79,7 @@ func (rsc *policyoptionsPolicyStatement) Configure(
 }
 
 func (rsc *policyoptionsPolicyStatement) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -182,6 +182,7 @@ func (rsc *policyoptionsPolicyStatement) Schema(
 					},
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -901,6 +902,7 @@ type policyoptionsPolicyStatementData struct {
 	To                           *policyoptionsPolicyStatementBlockTo    `tfsdk:"to"`
 	Then                         *policyoptionsPolicyStatementBlockThen  `tfsdk:"then"`
 	Term                         []policyoptionsPolicyStatementBlockTerm `tfsdk:"term"`
+	Timeouts                     resourceTimeouts                        `tfsdk:"timeouts"`
 }
 
 type policyoptionsPolicyStatementConfig struct {
@@ -912,6 +914,7 @@ type policyoptionsPolicyStatementConfig struct {
 	To                           *policyoptionsPolicyStatementBlockToConfig   `tfsdk:"to"`
 	Then                         *policyoptionsPolicyStatementBlockThenConfig `tfsdk:"then"`
 	Term                         types.List                                   `tfsdk:"term"`
+	Timeouts                     resourceTimeouts                             `tfsdk:"timeouts"`
 }
 
 type policyoptionsPolicyStatementBlockTerm struct {
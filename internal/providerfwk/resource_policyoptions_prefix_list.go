@@ -72,7 +72,7 @@ func (rsc *policyoptionsPrefixList) Configure(
 }
 
 func (rsc *policyoptionsPrefixList) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -122,15 +122,19 @@ func (rsc *policyoptionsPrefixList) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
 type policyoptionsPrefixListData struct {
-	DynamicDB types.Bool     `tfsdk:"dynamic_db"`
-	ID        types.String   `tfsdk:"id"`
-	Name      types.String   `tfsdk:"name"`
-	ApplyPath types.String   `tfsdk:"apply_path"`
-	Prefix    []types.String `tfsdk:"prefix"`
+	DynamicDB types.Bool       `tfsdk:"dynamic_db"`
+	ID        types.String     `tfsdk:"id"`
+	Name      types.String     `tfsdk:"name"`
+	ApplyPath types.String     `tfsdk:"apply_path"`
+	Prefix    []types.String   `tfsdk:"prefix"`
+	Timeouts  resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *policyoptionsPrefixList) Create(
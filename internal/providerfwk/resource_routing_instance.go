@@ -75,7 +75,7 @@ func (rsc *routingInstance) Configure(
 }
 
 func (rsc *routingInstance) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -252,50 +252,55 @@ func (rsc *routingInstance) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
 type routingInstanceData struct {
-	ConfigureRDVrfOptSingly types.Bool     `tfsdk:"configure_rd_vrfopts_singly"`
-	ConfigureTypeSingly     types.Bool     `tfsdk:"configure_type_singly"`
-	VRFTargetAuto           types.Bool     `tfsdk:"vrf_target_auto"`
-	ID                      types.String   `tfsdk:"id"`
-	Name                    types.String   `tfsdk:"name"`
-	Type                    types.String   `tfsdk:"type"`
-	AS                      types.String   `tfsdk:"as"`
-	Description             types.String   `tfsdk:"description"`
-	InstanceExport          []types.String `tfsdk:"instance_export"`
-	InstanceImport          []types.String `tfsdk:"instance_import"`
-	RouteDistinguisher      types.String   `tfsdk:"route_distinguisher"`
-	RouterID                types.String   `tfsdk:"router_id"`
-	VRFExport               []types.String `tfsdk:"vrf_export"`
-	VRFImport               []types.String `tfsdk:"vrf_import"`
-	VRFTarget               types.String   `tfsdk:"vrf_target"`
-	VRFTargetExport         types.String   `tfsdk:"vrf_target_export"`
-	VRFTargetImport         types.String   `tfsdk:"vrf_target_import"`
-	VTEPSourceInterface     types.String   `tfsdk:"vtep_source_interface"`
-	Interface               []types.String `tfsdk:"-"` // to data source
+	ConfigureRDVrfOptSingly types.Bool       `tfsdk:"configure_rd_vrfopts_singly"`
+	ConfigureTypeSingly     types.Bool       `tfsdk:"configure_type_singly"`
+	VRFTargetAuto           types.Bool       `tfsdk:"vrf_target_auto"`
+	ID                      types.String     `tfsdk:"id"`
+	Name                    types.String     `tfsdk:"name"`
+	Type                    types.String     `tfsdk:"type"`
+	AS                      types.String     `tfsdk:"as"`
+	Description             types.String     `tfsdk:"description"`
+	InstanceExport          []types.String   `tfsdk:"instance_export"`
+	InstanceImport          []types.String   `tfsdk:"instance_import"`
+	RouteDistinguisher      types.String     `tfsdk:"route_distinguisher"`
+	RouterID                types.String     `tfsdk:"router_id"`
+	VRFExport               []types.String   `tfsdk:"vrf_export"`
+	VRFImport               []types.String   `tfsdk:"vrf_import"`
+	VRFTarget               types.String     `tfsdk:"vrf_target"`
+	VRFTargetExport         types.String     `tfsdk:"vrf_target_export"`
+	VRFTargetImport         types.String     `tfsdk:"vrf_target_import"`
+	VTEPSourceInterface     types.String     `tfsdk:"vtep_source_interface"`
+	Interface               []types.String   `tfsdk:"-"` // to data source
+	Timeouts                resourceTimeouts `tfsdk:"timeouts"`
 }
 
 type routingInstanceConfig struct {
-	ConfigureRDVrfOptSingly types.Bool   `tfsdk:"configure_rd_vrfopts_singly"`
-	ConfigureTypeSingly     types.Bool   `tfsdk:"configure_type_singly"`
-	VRFTargetAuto           types.Bool   `tfsdk:"vrf_target_auto"`
-	ID                      types.String `tfsdk:"id"`
-	Name                    types.String `tfsdk:"name"`
-	Type                    types.String `tfsdk:"type"`
-	AS                      types.String `tfsdk:"as"`
-	Description             types.String `tfsdk:"description"`
-	InstanceExport          types.List   `tfsdk:"instance_export"`
-	InstanceImport          types.List   `tfsdk:"instance_import"`
-	RouteDistinguisher      types.String `tfsdk:"route_distinguisher"`
-	RouterID                types.String `tfsdk:"router_id"`
-	VRFExport               types.List   `tfsdk:"vrf_export"`
-	VRFImport               types.List   `tfsdk:"vrf_import"`
-	VRFTarget               types.String `tfsdk:"vrf_target"`
-	VRFTargetExport         types.String `tfsdk:"vrf_target_export"`
-	VRFTargetImport         types.String `tfsdk:"vrf_target_import"`
-	VTEPSourceInterface     types.String `tfsdk:"vtep_source_interface"`
+	ConfigureRDVrfOptSingly types.Bool       `tfsdk:"configure_rd_vrfopts_singly"`
+	ConfigureTypeSingly     types.Bool       `tfsdk:"configure_type_singly"`
+	VRFTargetAuto           types.Bool       `tfsdk:"vrf_target_auto"`
+	ID                      types.String     `tfsdk:"id"`
+	Name                    types.String     `tfsdk:"name"`
+	Type                    types.String     `tfsdk:"type"`
+	AS                      types.String     `tfsdk:"as"`
+	Description             types.String     `tfsdk:"description"`
+	InstanceExport          types.List       `tfsdk:"instance_export"`
+	InstanceImport          types.List       `tfsdk:"instance_import"`
+	RouteDistinguisher      types.String     `tfsdk:"route_distinguisher"`
+	RouterID                types.String     `tfsdk:"router_id"`
+	VRFExport               types.List       `tfsdk:"vrf_export"`
+	VRFImport               types.List       `tfsdk:"vrf_import"`
+	VRFTarget               types.String     `tfsdk:"vrf_target"`
+	VRFTargetExport         types.String     `tfsdk:"vrf_target_export"`
+	VRFTargetImport         types.String     `tfsdk:"vrf_target_import"`
+	VTEPSourceInterface     types.String     `tfsdk:"vtep_source_interface"`
+	Timeouts                resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *routingInstance) ValidateConfig(
@@ -79,7 +79,7 @@ func (rsc *security) Configure(
 }
 
 func (rsc *security) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -1271,6 +1271,7 @@ func (rsc *security) Schema(
 					tfplanmodifier.BlockRemoveNull(),
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -1290,6 +1291,7 @@ type securityData struct {
 	Policies                     *securityBlockPolicies                     `tfsdk:"policies"`
 	UserIdentificationAuthSource *securityBlockUserIdentificationAuthSource `tfsdk:"user_identification_auth_source"`
 	Utm                          *securityBlockUtm                          `tfsdk:"utm"`
+	Timeouts                     resourceTimeouts                           `tfsdk:"timeouts"`
 }
 
 type securityConfig struct {
@@ -1307,6 +1309,7 @@ type securityConfig struct {
 	Policies                     *securityBlockPolicies                     `tfsdk:"policies"`
 	UserIdentificationAuthSource *securityBlockUserIdentificationAuthSource `tfsdk:"user_identification_auth_source"`
 	Utm                          *securityBlockUtm                          `tfsdk:"utm"`
+	Timeouts                     resourceTimeouts                           `tfsdk:"timeouts"`
 }
 
 type securityBlockAlg struct {
@@ -75,7 +75,7 @@ func (rsc *securityAddressBook) Configure(
 }
 
 func (rsc *securityAddressBook) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -311,6 +311,7 @@ func (rsc *securityAddressBook) Schema(
 					},
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -325,18 +326,20 @@ type securityAddressBookData struct {
 	RangeAddress    []securityAddressBookBlockRangeAddress    `tfsdk:"range_address"`
 	WildcardAddress []securityAddressBookBlockWildcardAddress `tfsdk:"wildcard_address"`
 	AddressSet      []securityAddressBookBlockAddressSet      `tfsdk:"address_set"`
+	Timeouts        resourceTimeouts                          `tfsdk:"timeouts"`
 }
 
 type securityAddressBookConfig struct {
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	Description     types.String `tfsdk:"description"`
-	AttachZone      types.List   `tfsdk:"attach_zone"`
-	NetworkAddress  types.Set    `tfsdk:"network_address"`
-	DNSName         types.Set    `tfsdk:"dns_name"`
-	RangeAddress    types.Set    `tfsdk:"range_address"`
-	WildcardAddress types.Set    `tfsdk:"wildcard_address"`
-	AddressSet      types.Set    `tfsdk:"address_set"`
+	ID              types.String     `tfsdk:"id"`
+	Name            types.String     `tfsdk:"name"`
+	Description     types.String     `tfsdk:"description"`
+	AttachZone      types.List       `tfsdk:"attach_zone"`
+	NetworkAddress  types.Set        `tfsdk:"network_address"`
+	DNSName         types.Set        `tfsdk:"dns_name"`
+	RangeAddress    types.Set        `tfsdk:"range_address"`
+	WildcardAddress types.Set        `tfsdk:"wildcard_address"`
+	AddressSet      types.Set        `tfsdk:"address_set"`
+	Timeouts        resourceTimeouts `tfsdk:"timeouts"`
 }
 
 type securityAddressBookBlockNetworkAddress struct {
@@ -76,7 +76,7 @@ func (rsc *securityGlobalPolicy) Configure(
 }
 
 func (rsc *securityGlobalPolicy) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -359,18 +359,21 @@ func (rsc *securityGlobalPolicy) Schema(
 					},
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
 
 type securityGlobalPolicyData struct {
-	ID     types.String                      `tfsdk:"id"`
-	Policy []securityGlobalPolicyBlockPolicy `tfsdk:"policy"`
+	ID       types.String                      `tfsdk:"id"`
+	Policy   []securityGlobalPolicyBlockPolicy `tfsdk:"policy"`
+	Timeouts resourceTimeouts                  `tfsdk:"timeouts"`
 }
 
 type securityGlobalPolicyConfig struct {
-	ID     types.String `tfsdk:"id"`
-	Policy types.List   `tfsdk:"policy"`
+	ID       types.String     `tfsdk:"id"`
+	Policy   types.List       `tfsdk:"policy"`
+	Timeouts resourceTimeouts `tfsdk:"timeouts"`
 }
 
 //nolint:lll
@@ -559,9 +562,13 @@ func (rsc *securityGlobalPolicy) Update(
 }
 
 func (rsc *securityGlobalPolicy) Delete(
-	ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse,
+	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
 ) {
 	var empty securityGlobalPolicyData
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &empty.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	defaultResourceDelete(
 		ctx,
@@ -77,7 +77,7 @@ func (rsc *securityIkeGateway) Configure(
 }
 
 func (rsc *securityIkeGateway) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -368,6 +368,7 @@ func (rsc *securityIkeGateway) Schema(
 					tfplanmodifier.BlockRemoveNull(),
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -387,6 +388,7 @@ type securityIkeGatewayData struct {
 	DynamicRemote     *securityIkeGatewayBlockDynamicRemote     `tfsdk:"dynamic_remote"`
 	LocalIdentity     *securityIkeGatewayBlockLocalIdentity     `tfsdk:"local_identity"`
 	RemoteIdentity    *securityIkeGatewayBlockRemoteIdentity    `tfsdk:"remote_identity"`
+	Timeouts          resourceTimeouts                          `tfsdk:"timeouts"`
 }
 
 type securityIkeGatewayConfig struct {
@@ -404,6 +406,7 @@ type securityIkeGatewayConfig struct {
 	DynamicRemote     *securityIkeGatewayBlockDynamicRemote     `tfsdk:"dynamic_remote"`
 	LocalIdentity     *securityIkeGatewayBlockLocalIdentity     `tfsdk:"local_identity"`
 	RemoteIdentity    *securityIkeGatewayBlockRemoteIdentity    `tfsdk:"remote_identity"`
+	Timeouts          resourceTimeouts                          `tfsdk:"timeouts"`
 }
 
 //nolint:lll
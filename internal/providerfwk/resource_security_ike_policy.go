@@ -76,7 +76,7 @@ func (rsc *securityIkePolicy) Configure(
 }
 
 func (rsc *securityIkePolicy) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -169,31 +169,36 @@ func (rsc *securityIkePolicy) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
 type securityIkePolicyData struct {
-	ID               types.String   `tfsdk:"id"`
-	Name             types.String   `tfsdk:"name"`
-	Description      types.String   `tfsdk:"description"`
-	Mode             types.String   `tfsdk:"mode"`
-	PreSharedKeyHexa types.String   `tfsdk:"pre_shared_key_hexa"`
-	PreSharedKeyText types.String   `tfsdk:"pre_shared_key_text"`
-	Proposals        []types.String `tfsdk:"proposals"`
-	ProposalSet      types.String   `tfsdk:"proposal_set"`
-	ReauthFrequency  types.Int64    `tfsdk:"reauth_frequency"`
+	ID               types.String     `tfsdk:"id"`
+	Name             types.String     `tfsdk:"name"`
+	Description      types.String     `tfsdk:"description"`
+	Mode             types.String     `tfsdk:"mode"`
+	PreSharedKeyHexa types.String     `tfsdk:"pre_shared_key_hexa"`
+	PreSharedKeyText types.String     `tfsdk:"pre_shared_key_text"`
+	Proposals        []types.String   `tfsdk:"proposals"`
+	ProposalSet      types.String     `tfsdk:"proposal_set"`
+	ReauthFrequency  types.Int64      `tfsdk:"reauth_frequency"`
+	Timeouts         resourceTimeouts `tfsdk:"timeouts"`
 }
 
 type securityIkePolicyConfig struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Description      types.String `tfsdk:"description"`
-	Mode             types.String `tfsdk:"mode"`
-	PreSharedKeyHexa types.String `tfsdk:"pre_shared_key_hexa"`
-	PreSharedKeyText types.String `tfsdk:"pre_shared_key_text"`
-	Proposals        types.List   `tfsdk:"proposals"`
-	ProposalSet      types.String `tfsdk:"proposal_set"`
-	ReauthFrequency  types.Int64  `tfsdk:"reauth_frequency"`
+	ID               types.String     `tfsdk:"id"`
+	Name             types.String     `tfsdk:"name"`
+	Description      types.String     `tfsdk:"description"`
+	Mode             types.String     `tfsdk:"mode"`
+	PreSharedKeyHexa types.String     `tfsdk:"pre_shared_key_hexa"`
+	PreSharedKeyText types.String     `tfsdk:"pre_shared_key_text"`
+	Proposals        types.List       `tfsdk:"proposals"`
+	ProposalSet      types.String     `tfsdk:"proposal_set"`
+	ReauthFrequency  types.Int64      `tfsdk:"reauth_frequency"`
+	Timeouts         resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *securityIkePolicy) ValidateConfig(
@@ -74,7 +74,7 @@ func (rsc *securityIkeProposal) Configure(
 }
 
 func (rsc *securityIkeProposal) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -147,18 +147,22 @@ func (rsc *securityIkeProposal) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
 type securityIkeProposalData struct {
-	ID                      types.String `tfsdk:"id"`
-	Name                    types.String `tfsdk:"name"`
-	AuthenticationAlgorithm types.String `tfsdk:"authentication_algorithm"`
-	AuthenticationMethod    types.String `tfsdk:"authentication_method"`
-	Description             types.String `tfsdk:"description"`
-	DhGroup                 types.String `tfsdk:"dh_group"`
-	EncryptionAlgorithm     types.String `tfsdk:"encryption_algorithm"`
-	LifetimeSeconds         types.Int64  `tfsdk:"lifetime_seconds"`
+	ID                      types.String     `tfsdk:"id"`
+	Name                    types.String     `tfsdk:"name"`
+	AuthenticationAlgorithm types.String     `tfsdk:"authentication_algorithm"`
+	AuthenticationMethod    types.String     `tfsdk:"authentication_method"`
+	Description             types.String     `tfsdk:"description"`
+	DhGroup                 types.String     `tfsdk:"dh_group"`
+	EncryptionAlgorithm     types.String     `tfsdk:"encryption_algorithm"`
+	LifetimeSeconds         types.Int64      `tfsdk:"lifetime_seconds"`
+	Timeouts                resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *securityIkeProposal) Create(
@@ -72,7 +72,7 @@ func (rsc *securityIpsecPolicy) Configure(
 }
 
 func (rsc *securityIpsecPolicy) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -139,25 +139,30 @@ func (rsc *securityIpsecPolicy) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
 type securityIpsecPolicyData struct {
-	ID          types.String   `tfsdk:"id"`
-	Name        types.String   `tfsdk:"name"`
-	Description types.String   `tfsdk:"description"`
-	PfsKeys     types.String   `tfsdk:"pfs_keys"`
-	Proposals   []types.String `tfsdk:"proposals"`
-	ProposalSet types.String   `tfsdk:"proposal_set"`
+	ID          types.String     `tfsdk:"id"`
+	Name        types.String     `tfsdk:"name"`
+	Description types.String     `tfsdk:"description"`
+	PfsKeys     types.String     `tfsdk:"pfs_keys"`
+	Proposals   []types.String   `tfsdk:"proposals"`
+	ProposalSet types.String     `tfsdk:"proposal_set"`
+	Timeouts    resourceTimeouts `tfsdk:"timeouts"`
 }
 
 type securityIpsecPolicyConfig struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	PfsKeys     types.String `tfsdk:"pfs_keys"`
-	Proposals   types.List   `tfsdk:"proposals"`
-	ProposalSet types.String `tfsdk:"proposal_set"`
+	ID          types.String     `tfsdk:"id"`
+	Name        types.String     `tfsdk:"name"`
+	Description types.String     `tfsdk:"description"`
+	PfsKeys     types.String     `tfsdk:"pfs_keys"`
+	Proposals   types.List       `tfsdk:"proposals"`
+	ProposalSet types.String     `tfsdk:"proposal_set"`
+	Timeouts    resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *securityIpsecPolicy) ValidateConfig(
@@ -73,7 +73,7 @@ func (rsc *securityIpsecProposal) Configure(
 }
 
 func (rsc *securityIpsecProposal) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -142,18 +142,22 @@ func (rsc *securityIpsecProposal) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
 type securityIpsecProposalData struct {
-	ID                      types.String `tfsdk:"id"`
-	Name                    types.String `tfsdk:"name"`
-	AuthenticationAlgorithm types.String `tfsdk:"authentication_algorithm"`
-	EncryptionAlgorithm     types.String `tfsdk:"encryption_algorithm"`
-	Description             types.String `tfsdk:"description"`
-	LifetimeSeconds         types.Int64  `tfsdk:"lifetime_seconds"`
-	LifetimeKilobytes       types.Int64  `tfsdk:"lifetime_kilobytes"`
-	Protocol                types.String `tfsdk:"protocol"`
+	ID                      types.String     `tfsdk:"id"`
+	Name                    types.String     `tfsdk:"name"`
+	AuthenticationAlgorithm types.String     `tfsdk:"authentication_algorithm"`
+	EncryptionAlgorithm     types.String     `tfsdk:"encryption_algorithm"`
+	Description             types.String     `tfsdk:"description"`
+	LifetimeSeconds         types.Int64      `tfsdk:"lifetime_seconds"`
+	LifetimeKilobytes       types.Int64      `tfsdk:"lifetime_kilobytes"`
+	Protocol                types.String     `tfsdk:"protocol"`
+	Timeouts                resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *securityIpsecProposal) Create(
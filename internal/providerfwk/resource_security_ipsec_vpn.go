@@ -79,7 +79,7 @@ func (rsc *securityIpsecVpn) Configure(
 }
 
 func (rsc *securityIpsecVpn) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -370,6 +370,7 @@ func (rsc *securityIpsecVpn) Schema(
 					tfplanmodifier.BlockRemoveNull(),
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -387,6 +388,7 @@ type securityIpsecVpnData struct {
 	TrafficSelector        []securityIpsecVpnBlockTrafficSelector `tfsdk:"traffic_selector"`
 	UDPEncapsulate         *securityIpsecVpnBlockUDPEncapsulate   `tfsdk:"udp_encapsulate"`
 	VpnMonitor             *securityIpsecVpnBlockVpnMonitor       `tfsdk:"vpn_monitor"`
+	Timeouts               resourceTimeouts                       `tfsdk:"timeouts"`
 }
 
 type securityIpsecVpnConfig struct {
@@ -402,6 +404,7 @@ type securityIpsecVpnConfig struct {
 	TrafficSelector        types.List                           `tfsdk:"traffic_selector"`
 	UDPEncapsulate         *securityIpsecVpnBlockUDPEncapsulate `tfsdk:"udp_encapsulate"`
 	VpnMonitor             *securityIpsecVpnBlockVpnMonitor     `tfsdk:"vpn_monitor"`
+	Timeouts               resourceTimeouts                     `tfsdk:"timeouts"`
 }
 
 type securityIpsecVpnBlockIke struct {
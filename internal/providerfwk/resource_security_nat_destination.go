@@ -76,7 +76,7 @@ func (rsc *securityNatDestination) Configure(
 }
 
 func (rsc *securityNatDestination) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -256,6 +256,7 @@ func (rsc *securityNatDestination) Schema(
 					},
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -266,6 +267,7 @@ type securityNatDestinationData struct {
 	Description types.String                      `tfsdk:"description"`
 	From        *securityNatDestinationBlockFrom  `tfsdk:"from"`
 	Rule        []securityNatDestinationBlockRule `tfsdk:"rule"`
+	Timeouts    resourceTimeouts                  `tfsdk:"timeouts"`
 }
 
 type securityNatDestinationConfig struct {
@@ -274,6 +276,7 @@ type securityNatDestinationConfig struct {
 	Description types.String                           `tfsdk:"description"`
 	From        *securityNatDestinationBlockFromConfig `tfsdk:"from"`
 	Rule        types.List                             `tfsdk:"rule"`
+	Timeouts    resourceTimeouts                       `tfsdk:"timeouts"`
 }
 
 type securityNatDestinationBlockFrom struct {
@@ -3,6 +3,7 @@ package providerfwk
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -74,7 +76,7 @@ func (rsc *securityNatDestinationPool) Configure(
 }
 
 func (rsc *securityNatDestinationPool) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -126,6 +128,17 @@ func (rsc *securityNatDestinationPool) Schema(
 					tfvalidator.StringDoubleQuoteExclusion(),
 				},
 			},
+			"proxy_arp_interface": schema.StringAttribute{
+				Optional: true,
+				Description: "Logical interface on which to add proxy-arp (or proxy-ndp for IPv6) " +
+					"entry for address (or range of address) of pool.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					tfvalidator.StringFormat(tfvalidator.InterfaceFormat),
+					stringvalidator.RegexMatches(regexp.MustCompile(`\.\d+$`),
+						"must be a logical interface (with unit)"),
+				},
+			},
 			"routing_instance": schema.StringAttribute{
 				Optional:    true,
 				Description: "Name of routing instance to switch instance with nat.",
@@ -135,17 +148,22 @@ func (rsc *securityNatDestinationPool) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
 type securityNatDestinationPoolData struct {
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	Address         types.String `tfsdk:"address"`
-	AddressPort     types.Int64  `tfsdk:"address_port"`
-	AddressTo       types.String `tfsdk:"address_to"`
-	Description     types.String `tfsdk:"description"`
-	RoutingInstance types.String `tfsdk:"routing_instance"`
+	ID                types.String     `tfsdk:"id"`
+	Name              types.String     `tfsdk:"name"`
+	Address           types.String     `tfsdk:"address"`
+	AddressPort       types.Int64      `tfsdk:"address_port"`
+	AddressTo         types.String     `tfsdk:"address_to"`
+	Description       types.String     `tfsdk:"description"`
+	ProxyArpInterface types.String     `tfsdk:"proxy_arp_interface"`
+	RoutingInstance   types.String     `tfsdk:"routing_instance"`
+	Timeouts          resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *securityNatDestinationPool) ValidateConfig(
@@ -212,6 +230,10 @@ func (rsc *securityNatDestinationPool) Create(
 
 				return false
 			}
+			resp.Diagnostics.Append(checkSecurityNatProxyEntriesFree(fnCtx, &plan, nil, junSess)...)
+			if resp.Diagnostics.HasError() {
+				return false
+			}
 
 			return true
 		},
@@ -248,6 +270,8 @@ func (rsc *securityNatDestinationPool) Read(
 		return
 	}
 
+	// proxy-arp entry is only managed (and read) when proxy_arp_interface is set
+	data.ProxyArpInterface = state.ProxyArpInterface
 	var _ resourceDataReadFrom1String = &data
 	defaultResourceRead(
 		ctx,
@@ -271,6 +295,7 @@ func (rsc *securityNatDestinationPool) Update(
 		return
 	}
 
+	var _ resourceDataPreCheckUpdate = &plan
 	defaultResourceUpdate(
 		ctx,
 		rsc,
@@ -361,12 +386,19 @@ func (rscData *securityNatDestinationPoolData) set(
 	if v := rscData.RoutingInstance.ValueString(); v != "" {
 		configSet = append(configSet, setPrefix+"routing-instance "+v)
 	}
+	if v := rscData.ProxyArpInterface.ValueString(); v != "" {
+		proxyLine := securityNatProxyLine("set", v, rscData.Address.ValueString())
+		if vTo := rscData.AddressTo.ValueString(); vTo != "" {
+			proxyLine += " to " + vTo
+		}
+		configSet = append(configSet, proxyLine)
+	}
 
 	return path.Empty(), junSess.ConfigSet(configSet)
 }
 
 func (rscData *securityNatDestinationPoolData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
 	showConfig, err := junSess.Command(junos.CmdShowConfig +
 		"security nat destination pool " + name + junos.PipeDisplaySetRelative)
@@ -401,17 +433,47 @@ func (rscData *securityNatDestinationPoolData) read(
 				rscData.RoutingInstance = types.StringValue(itemTrim)
 			}
 		}
+		// proxy-arp entry is only read when proxy_arp_interface is already known (from state)
+		if v, addresses := rscData.proxyEntries(); v != "" {
+			proxyExists, err := checkSecurityNatProxyAddresses(ctx, v, addresses, junSess)
+			if err != nil {
+				return err
+			}
+			if !proxyExists {
+				rscData.ProxyArpInterface = types.StringNull()
+			}
+		}
 	}
 
 	return nil
 }
 
+func (rscData *securityNatDestinationPoolData) proxyEntries() (string, []string) {
+	var addresses []string
+	if v := rscData.Address.ValueString(); v != "" {
+		addresses = append(addresses, v)
+	}
+
+	return rscData.ProxyArpInterface.ValueString(), addresses
+}
+
+func (rscData *securityNatDestinationPoolData) preCheckUpdate(
+	ctx context.Context, state resourceDataDel, junSess *junos.Session,
+) diag.Diagnostics {
+	stateProxy, _ := state.(securityNatProxyEntries)
+
+	return checkSecurityNatProxyEntriesFree(ctx, rscData, stateProxy, junSess)
+}
+
 func (rscData *securityNatDestinationPoolData) del(
 	_ context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security nat destination pool " + rscData.Name.ValueString(),
 	}
+	if v := rscData.ProxyArpInterface.ValueString(); v != "" {
+		configSet = append(configSet, securityNatProxyLine("delete", v, rscData.Address.ValueString()))
+	}
 
 	return junSess.ConfigSet(configSet)
 }
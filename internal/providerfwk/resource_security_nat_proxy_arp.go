@@ -0,0 +1,478 @@
+package providerfwk
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	balt "github.com/jeremmfr/go-utils/basicalter"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &securityNatProxyArp{}
+	_ resource.ResourceWithConfigure   = &securityNatProxyArp{}
+	_ resource.ResourceWithImportState = &securityNatProxyArp{}
+)
+
+type securityNatProxyArp struct {
+	client *junos.Client
+}
+
+func newSecurityNatProxyArpResource() resource.Resource {
+	return &securityNatProxyArp{}
+}
+
+func (rsc *securityNatProxyArp) typeName() string {
+	return providerName + "_security_nat_proxy_arp"
+}
+
+func (rsc *securityNatProxyArp) junosName() string {
+	return "security nat proxy-arp"
+}
+
+func (rsc *securityNatProxyArp) junosClient() *junos.Client {
+	return rsc.client
+}
+
+func (rsc *securityNatProxyArp) Metadata(
+	_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse,
+) {
+	resp.TypeName = rsc.typeName()
+}
+
+func (rsc *securityNatProxyArp) Configure(
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedResourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	rsc.client = client
+}
+
+func (rsc *securityNatProxyArp) Schema(
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a " + rsc.junosName() + " address (or address range) on an interface.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				Description: "An identifier for the resource with format " +
+					"`<interface>" + junos.IDSeparator + "<address>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Required:    true,
+				Description: "Logical interface name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					tfvalidator.StringFormat(tfvalidator.InterfaceFormat),
+					stringvalidator.RegexMatches(regexp.MustCompile(`\.\d+$`),
+						"must be a logical interface (with unit)"),
+				},
+			},
+			"address": schema.StringAttribute{
+				Required:    true,
+				Description: "CIDR address or lower limit of address range.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					tfvalidator.StringCIDR().IPv4Only(),
+				},
+			},
+			"address_to": schema.StringAttribute{
+				Optional:    true,
+				Description: "CIDR upper limit of address range.",
+				Validators: []validator.String{
+					tfvalidator.StringCIDR().IPv4Only(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
+	}
+}
+
+type securityNatProxyArpData struct {
+	ID        types.String     `tfsdk:"id"`
+	Interface types.String     `tfsdk:"interface"`
+	Address   types.String     `tfsdk:"address"`
+	AddressTo types.String     `tfsdk:"address_to"`
+	Timeouts  resourceTimeouts `tfsdk:"timeouts"`
+}
+
+func (rsc *securityNatProxyArp) Create(
+	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
+) {
+	var plan securityNatProxyArpData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Interface.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("interface"),
+			"Empty Interface",
+			"could not create "+rsc.junosName()+" with empty interface",
+		)
+
+		return
+	}
+	if plan.Address.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("address"),
+			"Empty Address",
+			"could not create "+rsc.junosName()+" with empty address",
+		)
+
+		return
+	}
+
+	defaultResourceCreate(
+		ctx,
+		rsc,
+		func(fnCtx context.Context, junSess *junos.Session) bool {
+			if !junSess.CheckCompatibilitySecurity() {
+				resp.Diagnostics.AddError(
+					tfdiag.CompatibilityErrSummary,
+					fmt.Sprintf(rsc.junosName()+" not compatible "+
+						"with Junos device %q", junSess.SystemInformation.HardwareModel),
+				)
+
+				return false
+			}
+			proxyArpExists, err := checkSecurityNatProxyArpExists(
+				fnCtx,
+				plan.Interface.ValueString(),
+				plan.Address.ValueString(),
+				junSess,
+			)
+			if err != nil {
+				resp.Diagnostics.AddError(tfdiag.PreCheckErrSummary, err.Error())
+
+				return false
+			}
+			if proxyArpExists {
+				resp.Diagnostics.AddError(
+					tfdiag.DuplicateConfigErrSummary,
+					fmt.Sprintf(rsc.junosName()+" interface %q address %q already exists",
+						plan.Interface.ValueString(), plan.Address.ValueString()),
+				)
+
+				return false
+			}
+
+			return true
+		},
+		func(fnCtx context.Context, junSess *junos.Session) bool {
+			proxyArpExists, err := checkSecurityNatProxyArpExists(
+				fnCtx,
+				plan.Interface.ValueString(),
+				plan.Address.ValueString(),
+				junSess,
+			)
+			if err != nil {
+				resp.Diagnostics.AddError(tfdiag.PostCheckErrSummary, err.Error())
+
+				return false
+			}
+			if !proxyArpExists {
+				resp.Diagnostics.AddError(
+					tfdiag.NotFoundErrSummary,
+					fmt.Sprintf(rsc.junosName()+" interface %q address %q does not exists after commit "+
+						"=> check your config", plan.Interface.ValueString(), plan.Address.ValueString()),
+				)
+
+				return false
+			}
+
+			return true
+		},
+		&plan,
+		resp,
+	)
+}
+
+func (rsc *securityNatProxyArp) Read(
+	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse,
+) {
+	var state, data securityNatProxyArpData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var _ resourceDataReadFrom2String = &data
+	defaultResourceRead(
+		ctx,
+		rsc,
+		[]string{
+			state.Interface.ValueString(),
+			state.Address.ValueString(),
+		},
+		&data,
+		nil,
+		resp,
+	)
+}
+
+func (rsc *securityNatProxyArp) Update(
+	ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
+) {
+	var plan, state securityNatProxyArpData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceUpdate(
+		ctx,
+		rsc,
+		&state,
+		&plan,
+		resp,
+	)
+}
+
+func (rsc *securityNatProxyArp) Delete(
+	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
+) {
+	var state securityNatProxyArpData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceDelete(
+		ctx,
+		rsc,
+		&state,
+		resp,
+	)
+}
+
+func (rsc *securityNatProxyArp) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
+) {
+	var data securityNatProxyArpData
+
+	var _ resourceDataReadFrom2String = &data
+	defaultResourceImportState(
+		ctx,
+		rsc,
+		&data,
+		req,
+		resp,
+		fmt.Sprintf("don't find "+rsc.junosName()+" with id %q "+
+			"(id must be <interface>"+junos.IDSeparator+"<address>)", req.ID),
+	)
+}
+
+func checkSecurityNatProxyArpExists(
+	_ context.Context, iface, address string, junSess *junos.Session,
+) (
+	bool, error,
+) {
+	showConfig, err := junSess.Command(junos.CmdShowConfig +
+		"security nat proxy-arp interface " + iface + " address " + address + junos.PipeDisplaySet)
+	if err != nil {
+		return false, err
+	}
+	if showConfig == junos.EmptyW {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (rscData *securityNatProxyArpData) fillID() {
+	rscData.ID = types.StringValue(rscData.Interface.ValueString() + junos.IDSeparator + rscData.Address.ValueString())
+}
+
+func (rscData *securityNatProxyArpData) nullID() bool {
+	return rscData.ID.IsNull()
+}
+
+func (rscData *securityNatProxyArpData) set(
+	_ context.Context, junSess *junos.Session,
+) (
+	path.Path, error,
+) {
+	setLine := "set security nat proxy-arp interface " + rscData.Interface.ValueString() +
+		" address " + rscData.Address.ValueString()
+	if v := rscData.AddressTo.ValueString(); v != "" {
+		setLine += " to " + v
+	}
+
+	return path.Empty(), junSess.ConfigSet([]string{setLine})
+}
+
+func (rscData *securityNatProxyArpData) read(
+	_ context.Context, iface, address string, junSess *junos.Session,
+) (
+	err error,
+) {
+	showConfig, err := junSess.Command(junos.CmdShowConfig +
+		"security nat proxy-arp interface " + iface + junos.PipeDisplaySetRelative)
+	if err != nil {
+		return err
+	}
+	if showConfig != junos.EmptyW {
+		for _, item := range strings.Split(showConfig, "\n") {
+			if strings.Contains(item, junos.XMLStartTagConfigOut) {
+				continue
+			}
+			if strings.Contains(item, junos.XMLEndTagConfigOut) {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, junos.SetLS)
+			if balt.CutPrefixInString(&itemTrim, "address "+address) {
+				if itemTrim != "" && !strings.HasPrefix(itemTrim, " to ") {
+					continue
+				}
+				rscData.Interface = types.StringValue(iface)
+				rscData.Address = types.StringValue(address)
+				rscData.fillID()
+				if balt.CutPrefixInString(&itemTrim, " to ") {
+					rscData.AddressTo = types.StringValue(itemTrim)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (rscData *securityNatProxyArpData) del(
+	_ context.Context, junSess *junos.Session,
+) error {
+	configSet := []string{
+		"delete security nat proxy-arp interface " + rscData.Interface.ValueString() +
+			" address " + rscData.Address.ValueString(),
+	}
+
+	return junSess.ConfigSet(configSet)
+}
+
+// securityNatProxyLine generate the proxy-arp (IPv4) or proxy-ndp (IPv6) config line
+// for an address on an interface.
+// prefix need to be 'set' or 'delete'.
+func securityNatProxyLine(prefix, iface, address string) string {
+	return prefix + " " + securityNatProxyStatement(iface, address)
+}
+
+// securityNatProxyStatement generate the proxy-arp (IPv4) or proxy-ndp (IPv6) statement
+// for an address on an interface.
+func securityNatProxyStatement(iface, address string) string {
+	if ip, _, err := net.ParseCIDR(address); err == nil && ip.To4() == nil {
+		return "security nat proxy-ndp interface " + iface + " address " + address
+	}
+
+	return "security nat proxy-arp interface " + iface + " address " + address
+}
+
+// checkSecurityNatProxyAddresses check that all addresses are in proxy-arp (IPv4) or proxy-ndp (IPv6)
+// of the interface.
+func checkSecurityNatProxyAddresses(
+	_ context.Context, iface string, addresses []string, junSess *junos.Session,
+) (
+	bool, error,
+) {
+	if len(addresses) == 0 {
+		return false, nil
+	}
+	for _, address := range addresses {
+		showConfig, err := junSess.Command(junos.CmdShowConfig +
+			securityNatProxyStatement(iface, address) + junos.PipeDisplaySet)
+		if err != nil {
+			return false, err
+		}
+		if showConfig == junos.EmptyW {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// securityNatProxyEntries is implemented by resource data
+// which add proxy-arp (or proxy-ndp) entries with the proxy_arp_interface argument.
+type securityNatProxyEntries interface {
+	proxyEntries() (iface string, addresses []string)
+}
+
+// checkSecurityNatProxyEntriesFree check that the proxy-arp (IPv4) or proxy-ndp (IPv6) entries of plan
+// don't already exist, except those added by the resource itself (state, nil when creating),
+// to not delete entries managed elsewhere when the resource is destroyed.
+func checkSecurityNatProxyEntriesFree(
+	_ context.Context, plan, state securityNatProxyEntries, junSess *junos.Session,
+) (
+	diags diag.Diagnostics,
+) {
+	iface, addresses := plan.proxyEntries()
+	if iface == "" {
+		return diags
+	}
+	owned := make(map[string]struct{})
+	if state != nil {
+		if stateIface, stateAddresses := state.proxyEntries(); stateIface == iface {
+			for _, address := range stateAddresses {
+				owned[address] = struct{}{}
+			}
+		}
+	}
+	for _, address := range addresses {
+		if _, ok := owned[address]; ok {
+			continue
+		}
+		statement := securityNatProxyStatement(iface, address)
+		showConfig, err := junSess.Command(junos.CmdShowConfig + statement + junos.PipeDisplaySet)
+		if err != nil {
+			diags.AddError(tfdiag.PreCheckErrSummary, err.Error())
+
+			return diags
+		}
+		if showConfig != junos.EmptyW {
+			diags.AddAttributeError(
+				path.Root("proxy_arp_interface"),
+				tfdiag.DuplicateConfigErrSummary,
+				fmt.Sprintf("%q already exists", statement),
+			)
+
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,112 @@
+package providerfwk_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// export TESTACC_INTERFACE=<inteface> for choose interface available else it's ge-0/0/3.
+func TestAccJunosSecurityNatProxyArp_basic(t *testing.T) {
+	testaccInterface := junos.DefaultInterfaceTestAcc
+	if iface := os.Getenv("TESTACC_INTERFACE"); iface != "" {
+		testaccInterface = iface
+	}
+	if os.Getenv("TESTACC_SRX") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosSecurityNatProxyArpConfigCreate(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_security_nat_proxy_arp.testacc_proxyArp",
+							"id", testaccInterface+".0"+junos.IDSeparator+"192.0.2.10/32"),
+						resource.TestCheckResourceAttr("junos_security_nat_source_pool.testacc_proxyArp",
+							"proxy_arp_interface", testaccInterface+".0"),
+						resource.TestCheckResourceAttr("junos_security_nat_destination_pool.testacc_proxyArp",
+							"proxy_arp_interface", testaccInterface+".0"),
+					),
+				},
+				{
+					Config: testAccJunosSecurityNatProxyArpConfigUpdate(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_security_nat_proxy_arp.testacc_proxyArp",
+							"address_to", "192.0.2.20/32"),
+					),
+				},
+				{
+					ResourceName:      "junos_security_nat_proxy_arp.testacc_proxyArp",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					ResourceName:            "junos_security_nat_source_pool.testacc_proxyArp",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"proxy_arp_interface"},
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosSecurityNatProxyArpConfigCreate(interFace string) string {
+	return fmt.Sprintf(`
+resource "junos_interface_logical" "testacc_proxyArp" {
+  name = "%s.0"
+  family_inet {
+    address {
+      cidr_ip = "192.0.2.1/25"
+    }
+  }
+}
+resource "junos_security_nat_proxy_arp" "testacc_proxyArp" {
+  interface = junos_interface_logical.testacc_proxyArp.name
+  address   = "192.0.2.10/32"
+}
+resource "junos_security_nat_source_pool" "testacc_proxyArp" {
+  name                = "testacc_proxyArp"
+  address             = ["192.0.2.64/32", "192.0.2.65/32"]
+  proxy_arp_interface = junos_interface_logical.testacc_proxyArp.name
+}
+resource "junos_security_nat_destination_pool" "testacc_proxyArp" {
+  name                = "testacc_proxyArp"
+  address             = "192.0.2.96/32"
+  proxy_arp_interface = junos_interface_logical.testacc_proxyArp.name
+}
+`, interFace)
+}
+
+func testAccJunosSecurityNatProxyArpConfigUpdate(interFace string) string {
+	return fmt.Sprintf(`
+resource "junos_interface_logical" "testacc_proxyArp" {
+  name = "%s.0"
+  family_inet {
+    address {
+      cidr_ip = "192.0.2.1/25"
+    }
+  }
+}
+resource "junos_security_nat_proxy_arp" "testacc_proxyArp" {
+  interface  = junos_interface_logical.testacc_proxyArp.name
+  address    = "192.0.2.10/32"
+  address_to = "192.0.2.20/32"
+}
+resource "junos_security_nat_source_pool" "testacc_proxyArp" {
+  name                = "testacc_proxyArp"
+  address             = ["192.0.2.64/32", "192.0.2.66/32"]
+  proxy_arp_interface = junos_interface_logical.testacc_proxyArp.name
+}
+resource "junos_security_nat_destination_pool" "testacc_proxyArp" {
+  name                = "testacc_proxyArp"
+  address             = "192.0.2.96/32"
+  address_to          = "192.0.2.98/32"
+  proxy_arp_interface = junos_interface_logical.testacc_proxyArp.name
+}
+`, interFace)
+}
@@ -0,0 +1,384 @@
+package providerfwk
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	balt "github.com/jeremmfr/go-utils/basicalter"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &securityNatProxyNdp{}
+	_ resource.ResourceWithConfigure   = &securityNatProxyNdp{}
+	_ resource.ResourceWithImportState = &securityNatProxyNdp{}
+)
+
+type securityNatProxyNdp struct {
+	client *junos.Client
+}
+
+func newSecurityNatProxyNdpResource() resource.Resource {
+	return &securityNatProxyNdp{}
+}
+
+func (rsc *securityNatProxyNdp) typeName() string {
+	return providerName + "_security_nat_proxy_ndp"
+}
+
+func (rsc *securityNatProxyNdp) junosName() string {
+	return "security nat proxy-ndp"
+}
+
+func (rsc *securityNatProxyNdp) junosClient() *junos.Client {
+	return rsc.client
+}
+
+func (rsc *securityNatProxyNdp) Metadata(
+	_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse,
+) {
+	resp.TypeName = rsc.typeName()
+}
+
+func (rsc *securityNatProxyNdp) Configure(
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedResourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	rsc.client = client
+}
+
+func (rsc *securityNatProxyNdp) Schema(
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a " + rsc.junosName() + " address (or address range) on an interface.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				Description: "An identifier for the resource with format " +
+					"`<interface>" + junos.IDSeparator + "<address>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Required:    true,
+				Description: "Logical interface name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					tfvalidator.StringFormat(tfvalidator.InterfaceFormat),
+					stringvalidator.RegexMatches(regexp.MustCompile(`\.\d+$`),
+						"must be a logical interface (with unit)"),
+				},
+			},
+			"address": schema.StringAttribute{
+				Required:    true,
+				Description: "CIDR address or lower limit of address range.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					tfvalidator.StringCIDR().IPv6Only(),
+				},
+			},
+			"address_to": schema.StringAttribute{
+				Optional:    true,
+				Description: "CIDR upper limit of address range.",
+				Validators: []validator.String{
+					tfvalidator.StringCIDR().IPv6Only(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
+	}
+}
+
+type securityNatProxyNdpData struct {
+	ID        types.String     `tfsdk:"id"`
+	Interface types.String     `tfsdk:"interface"`
+	Address   types.String     `tfsdk:"address"`
+	AddressTo types.String     `tfsdk:"address_to"`
+	Timeouts  resourceTimeouts `tfsdk:"timeouts"`
+}
+
+func (rsc *securityNatProxyNdp) Create(
+	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
+) {
+	var plan securityNatProxyNdpData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Interface.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("interface"),
+			"Empty Interface",
+			"could not create "+rsc.junosName()+" with empty interface",
+		)
+
+		return
+	}
+	if plan.Address.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("address"),
+			"Empty Address",
+			"could not create "+rsc.junosName()+" with empty address",
+		)
+
+		return
+	}
+
+	defaultResourceCreate(
+		ctx,
+		rsc,
+		func(fnCtx context.Context, junSess *junos.Session) bool {
+			if !junSess.CheckCompatibilitySecurity() {
+				resp.Diagnostics.AddError(
+					tfdiag.CompatibilityErrSummary,
+					fmt.Sprintf(rsc.junosName()+" not compatible "+
+						"with Junos device %q", junSess.SystemInformation.HardwareModel),
+				)
+
+				return false
+			}
+			proxyNdpExists, err := checkSecurityNatProxyNdpExists(
+				fnCtx,
+				plan.Interface.ValueString(),
+				plan.Address.ValueString(),
+				junSess,
+			)
+			if err != nil {
+				resp.Diagnostics.AddError(tfdiag.PreCheckErrSummary, err.Error())
+
+				return false
+			}
+			if proxyNdpExists {
+				resp.Diagnostics.AddError(
+					tfdiag.DuplicateConfigErrSummary,
+					fmt.Sprintf(rsc.junosName()+" interface %q address %q already exists",
+						plan.Interface.ValueString(), plan.Address.ValueString()),
+				)
+
+				return false
+			}
+
+			return true
+		},
+		func(fnCtx context.Context, junSess *junos.Session) bool {
+			proxyNdpExists, err := checkSecurityNatProxyNdpExists(
+				fnCtx,
+				plan.Interface.ValueString(),
+				plan.Address.ValueString(),
+				junSess,
+			)
+			if err != nil {
+				resp.Diagnostics.AddError(tfdiag.PostCheckErrSummary, err.Error())
+
+				return false
+			}
+			if !proxyNdpExists {
+				resp.Diagnostics.AddError(
+					tfdiag.NotFoundErrSummary,
+					fmt.Sprintf(rsc.junosName()+" interface %q address %q does not exists after commit "+
+						"=> check your config", plan.Interface.ValueString(), plan.Address.ValueString()),
+				)
+
+				return false
+			}
+
+			return true
+		},
+		&plan,
+		resp,
+	)
+}
+
+func (rsc *securityNatProxyNdp) Read(
+	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse,
+) {
+	var state, data securityNatProxyNdpData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var _ resourceDataReadFrom2String = &data
+	defaultResourceRead(
+		ctx,
+		rsc,
+		[]string{
+			state.Interface.ValueString(),
+			state.Address.ValueString(),
+		},
+		&data,
+		nil,
+		resp,
+	)
+}
+
+func (rsc *securityNatProxyNdp) Update(
+	ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
+) {
+	var plan, state securityNatProxyNdpData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceUpdate(
+		ctx,
+		rsc,
+		&state,
+		&plan,
+		resp,
+	)
+}
+
+func (rsc *securityNatProxyNdp) Delete(
+	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
+) {
+	var state securityNatProxyNdpData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceDelete(
+		ctx,
+		rsc,
+		&state,
+		resp,
+	)
+}
+
+func (rsc *securityNatProxyNdp) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
+) {
+	var data securityNatProxyNdpData
+
+	var _ resourceDataReadFrom2String = &data
+	defaultResourceImportState(
+		ctx,
+		rsc,
+		&data,
+		req,
+		resp,
+		fmt.Sprintf("don't find "+rsc.junosName()+" with id %q "+
+			"(id must be <interface>"+junos.IDSeparator+"<address>)", req.ID),
+	)
+}
+
+func checkSecurityNatProxyNdpExists(
+	_ context.Context, iface, address string, junSess *junos.Session,
+) (
+	bool, error,
+) {
+	showConfig, err := junSess.Command(junos.CmdShowConfig +
+		"security nat proxy-ndp interface " + iface + " address " + address + junos.PipeDisplaySet)
+	if err != nil {
+		return false, err
+	}
+	if showConfig == junos.EmptyW {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (rscData *securityNatProxyNdpData) fillID() {
+	rscData.ID = types.StringValue(rscData.Interface.ValueString() + junos.IDSeparator + rscData.Address.ValueString())
+}
+
+func (rscData *securityNatProxyNdpData) nullID() bool {
+	return rscData.ID.IsNull()
+}
+
+func (rscData *securityNatProxyNdpData) set(
+	_ context.Context, junSess *junos.Session,
+) (
+	path.Path, error,
+) {
+	setLine := "set security nat proxy-ndp interface " + rscData.Interface.ValueString() +
+		" address " + rscData.Address.ValueString()
+	if v := rscData.AddressTo.ValueString(); v != "" {
+		setLine += " to " + v
+	}
+
+	return path.Empty(), junSess.ConfigSet([]string{setLine})
+}
+
+func (rscData *securityNatProxyNdpData) read(
+	_ context.Context, iface, address string, junSess *junos.Session,
+) (
+	err error,
+) {
+	showConfig, err := junSess.Command(junos.CmdShowConfig +
+		"security nat proxy-ndp interface " + iface + junos.PipeDisplaySetRelative)
+	if err != nil {
+		return err
+	}
+	if showConfig != junos.EmptyW {
+		for _, item := range strings.Split(showConfig, "\n") {
+			if strings.Contains(item, junos.XMLStartTagConfigOut) {
+				continue
+			}
+			if strings.Contains(item, junos.XMLEndTagConfigOut) {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, junos.SetLS)
+			if balt.CutPrefixInString(&itemTrim, "address "+address) {
+				if itemTrim != "" && !strings.HasPrefix(itemTrim, " to ") {
+					continue
+				}
+				rscData.Interface = types.StringValue(iface)
+				rscData.Address = types.StringValue(address)
+				rscData.fillID()
+				if balt.CutPrefixInString(&itemTrim, " to ") {
+					rscData.AddressTo = types.StringValue(itemTrim)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (rscData *securityNatProxyNdpData) del(
+	_ context.Context, junSess *junos.Session,
+) error {
+	configSet := []string{
+		"delete security nat proxy-ndp interface " + rscData.Interface.ValueString() +
+			" address " + rscData.Address.ValueString(),
+	}
+
+	return junSess.ConfigSet(configSet)
+}
@@ -0,0 +1,81 @@
+package providerfwk_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// export TESTACC_INTERFACE=<inteface> for choose interface available else it's ge-0/0/3.
+func TestAccJunosSecurityNatProxyNdp_basic(t *testing.T) {
+	testaccInterface := junos.DefaultInterfaceTestAcc
+	if iface := os.Getenv("TESTACC_INTERFACE"); iface != "" {
+		testaccInterface = iface
+	}
+	if os.Getenv("TESTACC_SRX") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosSecurityNatProxyNdpConfig(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_security_nat_proxy_ndp.testacc_proxyNdp",
+							"id", testaccInterface+".0"+junos.IDSeparator+"2001:db8::10/128"),
+						resource.TestCheckResourceAttr("junos_security_nat_static_rule.testacc_proxyNdp",
+							"proxy_arp_interface", testaccInterface+".0"),
+					),
+				},
+				{
+					ResourceName:      "junos_security_nat_proxy_ndp.testacc_proxyNdp",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosSecurityNatProxyNdpConfig(interFace string) string {
+	return fmt.Sprintf(`
+resource "junos_interface_logical" "testacc_proxyNdp" {
+  name          = "%s.0"
+  security_zone = junos_security_zone.testacc_proxyNdp.name
+  family_inet6 {
+    address {
+      cidr_ip = "2001:db8::1/64"
+    }
+  }
+}
+resource "junos_security_zone" "testacc_proxyNdp" {
+  name = "testacc_proxyNdp"
+}
+resource "junos_security_nat_proxy_ndp" "testacc_proxyNdp" {
+  interface  = junos_interface_logical.testacc_proxyNdp.name
+  address    = "2001:db8::10/128"
+  address_to = "2001:db8::20/128"
+}
+resource "junos_security_nat_static" "testacc_proxyNdp" {
+  name = "testacc_proxyNdp"
+  from {
+    type  = "zone"
+    value = [junos_security_zone.testacc_proxyNdp.name]
+  }
+  configure_rules_singly = true
+}
+resource "junos_security_nat_static_rule" "testacc_proxyNdp" {
+  name                = "testacc_proxyNdp"
+  rule_set            = junos_security_nat_static.testacc_proxyNdp.name
+  destination_address = "2001:db8::30/128"
+  proxy_arp_interface = junos_interface_logical.testacc_proxyNdp.name
+  then {
+    type   = "prefix"
+    prefix = "2001:db8:1::30/128"
+  }
+}
+`, interFace)
+}
@@ -76,7 +76,7 @@ func (rsc *securityNatSource) Configure(
 }
 
 func (rsc *securityNatSource) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -316,6 +316,7 @@ func (rsc *securityNatSource) Schema(
 					},
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -327,6 +328,7 @@ type securityNatSourceData struct {
 	From        *securityNatSourceBlockFromTo `tfsdk:"from"`
 	To          *securityNatSourceBlockFromTo `tfsdk:"to"`
 	Rule        []securityNatSourceBlockRule  `tfsdk:"rule"`
+	Timeouts    resourceTimeouts              `tfsdk:"timeouts"`
 }
 
 type securityNatSourceConfig struct {
@@ -336,6 +338,7 @@ type securityNatSourceConfig struct {
 	From        *securityNatSourceBlockFromToConfig `tfsdk:"from"`
 	To          *securityNatSourceBlockFromToConfig `tfsdk:"to"`
 	Rule        types.List                          `tfsdk:"rule"`
+	Timeouts    resourceTimeouts                    `tfsdk:"timeouts"`
 }
 
 type securityNatSourceBlockFromTo struct {
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -77,7 +78,7 @@ func (rsc *securityNatSourcePool) Configure(
 }
 
 func (rsc *securityNatSourcePool) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -161,6 +162,17 @@ func (rsc *securityNatSourcePool) Schema(
 					attributeSecurityNatSourcePoolPortRangeValidator{},
 				},
 			},
+			"proxy_arp_interface": schema.StringAttribute{
+				Optional: true,
+				Description: "Logical interface on which to add proxy-arp (or proxy-ndp for IPv6) " +
+					"entries for each address of pool.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					tfvalidator.StringFormat(tfvalidator.InterfaceFormat),
+					stringvalidator.RegexMatches(regexp.MustCompile(`\.\d+$`),
+						"must be a logical interface (with unit)"),
+				},
+			},
 			"routing_instance": schema.StringAttribute{
 				Optional:    true,
 				Description: "Name of routing instance to switch instance with nat.",
@@ -170,6 +182,9 @@ func (rsc *securityNatSourcePool) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -248,31 +263,35 @@ func (v attributeSecurityNatSourcePoolPortRangeValidator) ValidateString(
 }
 
 type securityNatSourcePoolData struct {
-	ID                                 types.String   `tfsdk:"id"`
-	Name                               types.String   `tfsdk:"name"`
-	Address                            []types.String `tfsdk:"address"`
-	AddressPooling                     types.String   `tfsdk:"address_pooling"`
-	Description                        types.String   `tfsdk:"description"`
-	PoolUtilizationAlarmClearThreshold types.Int64    `tfsdk:"pool_utilization_alarm_clear_threshold"`
-	PoolUtilizationAlarmRaiseThreshold types.Int64    `tfsdk:"pool_utilization_alarm_raise_threshold"`
-	PortNoTranslation                  types.Bool     `tfsdk:"port_no_translation"`
-	PortOverloadingFactor              types.Int64    `tfsdk:"port_overloading_factor"`
-	PortRange                          types.String   `tfsdk:"port_range"`
-	RoutingInstance                    types.String   `tfsdk:"routing_instance"`
+	ID                                 types.String     `tfsdk:"id"`
+	Name                               types.String     `tfsdk:"name"`
+	Address                            []types.String   `tfsdk:"address"`
+	AddressPooling                     types.String     `tfsdk:"address_pooling"`
+	Description                        types.String     `tfsdk:"description"`
+	PoolUtilizationAlarmClearThreshold types.Int64      `tfsdk:"pool_utilization_alarm_clear_threshold"`
+	PoolUtilizationAlarmRaiseThreshold types.Int64      `tfsdk:"pool_utilization_alarm_raise_threshold"`
+	PortNoTranslation                  types.Bool       `tfsdk:"port_no_translation"`
+	PortOverloadingFactor              types.Int64      `tfsdk:"port_overloading_factor"`
+	PortRange                          types.String     `tfsdk:"port_range"`
+	ProxyArpInterface                  types.String     `tfsdk:"proxy_arp_interface"`
+	RoutingInstance                    types.String     `tfsdk:"routing_instance"`
+	Timeouts                           resourceTimeouts `tfsdk:"timeouts"`
 }
 
 type securityNatSourcePoolConfig struct {
-	ID                                 types.String `tfsdk:"id"`
-	Name                               types.String `tfsdk:"name"`
-	Address                            types.List   `tfsdk:"address"`
-	AddressPooling                     types.String `tfsdk:"address_pooling"`
-	Description                        types.String `tfsdk:"description"`
-	PoolUtilizationAlarmClearThreshold types.Int64  `tfsdk:"pool_utilization_alarm_clear_threshold"`
-	PoolUtilizationAlarmRaiseThreshold types.Int64  `tfsdk:"pool_utilization_alarm_raise_threshold"`
-	PortNoTranslation                  types.Bool   `tfsdk:"port_no_translation"`
-	PortOverloadingFactor              types.Int64  `tfsdk:"port_overloading_factor"`
-	PortRange                          types.String `tfsdk:"port_range"`
-	RoutingInstance                    types.String `tfsdk:"routing_instance"`
+	ID                                 types.String     `tfsdk:"id"`
+	Name                               types.String     `tfsdk:"name"`
+	Address                            types.List       `tfsdk:"address"`
+	AddressPooling                     types.String     `tfsdk:"address_pooling"`
+	Description                        types.String     `tfsdk:"description"`
+	PoolUtilizationAlarmClearThreshold types.Int64      `tfsdk:"pool_utilization_alarm_clear_threshold"`
+	PoolUtilizationAlarmRaiseThreshold types.Int64      `tfsdk:"pool_utilization_alarm_raise_threshold"`
+	PortNoTranslation                  types.Bool       `tfsdk:"port_no_translation"`
+	PortOverloadingFactor              types.Int64      `tfsdk:"port_overloading_factor"`
+	PortRange                          types.String     `tfsdk:"port_range"`
+	ProxyArpInterface                  types.String     `tfsdk:"proxy_arp_interface"`
+	RoutingInstance                    types.String     `tfsdk:"routing_instance"`
+	Timeouts                           resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *securityNatSourcePool) ValidateConfig(
@@ -363,6 +382,10 @@ func (rsc *securityNatSourcePool) Create(
 
 				return false
 			}
+			resp.Diagnostics.Append(checkSecurityNatProxyEntriesFree(fnCtx, &plan, nil, junSess)...)
+			if resp.Diagnostics.HasError() {
+				return false
+			}
 
 			return true
 		},
@@ -399,6 +422,8 @@ func (rsc *securityNatSourcePool) Read(
 		return
 	}
 
+	// proxy-arp entries are only managed (and read) when proxy_arp_interface is set
+	data.ProxyArpInterface = state.ProxyArpInterface
 	var _ resourceDataReadFrom1String = &data
 	defaultResourceRead(
 		ctx,
@@ -422,6 +447,7 @@ func (rsc *securityNatSourcePool) Update(
 		return
 	}
 
+	var _ resourceDataPreCheckUpdate = &plan
 	defaultResourceUpdate(
 		ctx,
 		rsc,
@@ -533,12 +559,17 @@ func (rscData *securityNatSourcePoolData) set(
 	if v := rscData.RoutingInstance.ValueString(); v != "" {
 		configSet = append(configSet, setPrefix+"routing-instance "+v)
 	}
+	if v := rscData.ProxyArpInterface.ValueString(); v != "" {
+		for _, address := range rscData.Address {
+			configSet = append(configSet, securityNatProxyLine("set", v, address.ValueString()))
+		}
+	}
 
 	return path.Empty(), junSess.ConfigSet(configSet)
 }
 
 func (rscData *securityNatSourcePoolData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
 	showConfig, err := junSess.Command(junos.CmdShowConfig +
 		"security nat source pool " + name + junos.PipeDisplaySetRelative)
@@ -588,17 +619,49 @@ func (rscData *securityNatSourcePoolData) read(
 				rscData.RoutingInstance = types.StringValue(itemTrim)
 			}
 		}
+		// proxy-arp entries are only read when proxy_arp_interface is already known (from state)
+		if v, addresses := rscData.proxyEntries(); v != "" {
+			proxyExists, err := checkSecurityNatProxyAddresses(ctx, v, addresses, junSess)
+			if err != nil {
+				return err
+			}
+			if !proxyExists {
+				rscData.ProxyArpInterface = types.StringNull()
+			}
+		}
 	}
 
 	return nil
 }
 
+func (rscData *securityNatSourcePoolData) proxyEntries() (string, []string) {
+	addresses := make([]string, len(rscData.Address))
+	for i, address := range rscData.Address {
+		addresses[i] = address.ValueString()
+	}
+
+	return rscData.ProxyArpInterface.ValueString(), addresses
+}
+
+func (rscData *securityNatSourcePoolData) preCheckUpdate(
+	ctx context.Context, state resourceDataDel, junSess *junos.Session,
+) diag.Diagnostics {
+	stateProxy, _ := state.(securityNatProxyEntries)
+
+	return checkSecurityNatProxyEntriesFree(ctx, rscData, stateProxy, junSess)
+}
+
 func (rscData *securityNatSourcePoolData) del(
 	_ context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security nat source pool " + rscData.Name.ValueString(),
 	}
+	if v := rscData.ProxyArpInterface.ValueString(); v != "" {
+		for _, address := range rscData.Address {
+			configSet = append(configSet, securityNatProxyLine("delete", v, address.ValueString()))
+		}
+	}
 
 	return junSess.ConfigSet(configSet)
 }
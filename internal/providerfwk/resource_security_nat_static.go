@@ -78,7 +78,7 @@ func (rsc *securityNatStatic) Configure(
 }
 
 func (rsc *securityNatStatic) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -282,6 +282,7 @@ func (rsc *securityNatStatic) Schema(
 					},
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -293,6 +294,7 @@ type securityNatStaticData struct {
 	Description          types.String                 `tfsdk:"description"`
 	From                 *securityNatStaticBlockFrom  `tfsdk:"from"`
 	Rule                 []securityNatStaticBlockRule `tfsdk:"rule"`
+	Timeouts             resourceTimeouts             `tfsdk:"timeouts"`
 }
 
 type securityNatStaticConfig struct {
@@ -302,6 +304,7 @@ type securityNatStaticConfig struct {
 	Description          types.String                      `tfsdk:"description"`
 	From                 *securityNatStaticBlockFromConfig `tfsdk:"from"`
 	Rule                 types.List                        `tfsdk:"rule"`
+	Timeouts             resourceTimeouts                  `tfsdk:"timeouts"`
 }
 
 type securityNatStaticBlockFrom struct {
@@ -592,6 +595,14 @@ func (rsc *securityNatStatic) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultResourceUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	configureRulesSingly := plan.ConfigureRulesSingly.ValueBool()
 	if !plan.ConfigureRulesSingly.Equal(state.ConfigureRulesSingly) {
 		if state.ConfigureRulesSingly.ValueBool() {
@@ -648,6 +659,7 @@ func (rsc *securityNatStatic) Update(
 		return
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(state.ID.ValueString())
 	if err := junSess.ConfigLock(ctx); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigLockErrSummary, err.Error())
 
@@ -708,6 +720,9 @@ func (rsc *securityNatStatic) Delete(
 func (rsc *securityNatStatic) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	ctx, cancel := context.WithTimeout(ctx, defaultResourceReadTimeout)
+	defer cancel()
+
 	junSess, err := rsc.junosClient().StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
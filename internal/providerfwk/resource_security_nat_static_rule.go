@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -78,7 +79,7 @@ func (rsc *securityNatStaticRule) Configure(
 }
 
 func (rsc *securityNatStaticRule) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -142,6 +143,17 @@ func (rsc *securityNatStaticRule) Schema(
 					int64validator.Between(1, 65535),
 				},
 			},
+			"proxy_arp_interface": schema.StringAttribute{
+				Optional: true,
+				Description: "Logical interface on which to add proxy-arp (or proxy-ndp for IPv6) " +
+					"entry for destination_address.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					tfvalidator.StringFormat(tfvalidator.InterfaceFormat),
+					stringvalidator.RegexMatches(regexp.MustCompile(`\.\d+$`),
+						"must be a logical interface (with unit)"),
+				},
+			},
 			"source_address": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -231,6 +243,7 @@ func (rsc *securityNatStaticRule) Schema(
 					tfplanmodifier.BlockRemoveNull(),
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -243,10 +256,12 @@ type securityNatStaticRuleData struct {
 	DestinationAddressName types.String                    `tfsdk:"destination_address_name"`
 	DestinationPort        types.Int64                     `tfsdk:"destination_port"`
 	DestiantionPortTo      types.Int64                     `tfsdk:"destination_port_to"`
+	ProxyArpInterface      types.String                    `tfsdk:"proxy_arp_interface"`
 	SourceAddress          []types.String                  `tfsdk:"source_address"`
 	SourceAddressName      []types.String                  `tfsdk:"source_address_name"`
 	SourcePort             []types.String                  `tfsdk:"source_port"`
 	Then                   *securityNatStaticRuleBlockThen `tfsdk:"then"`
+	Timeouts               resourceTimeouts                `tfsdk:"timeouts"`
 }
 
 type securityNatStaticRuleConfig struct {
@@ -257,10 +272,12 @@ type securityNatStaticRuleConfig struct {
 	DestinationAddressName types.String                    `tfsdk:"destination_address_name"`
 	DestinationPort        types.Int64                     `tfsdk:"destination_port"`
 	DestiantionPortTo      types.Int64                     `tfsdk:"destination_port_to"`
+	ProxyArpInterface      types.String                    `tfsdk:"proxy_arp_interface"`
 	SourceAddress          types.Set                       `tfsdk:"source_address"`
 	SourceAddressName      types.Set                       `tfsdk:"source_address_name"`
 	SourcePort             types.Set                       `tfsdk:"source_port"`
 	Then                   *securityNatStaticRuleBlockThen `tfsdk:"then"`
+	Timeouts               resourceTimeouts                `tfsdk:"timeouts"`
 }
 
 type securityNatStaticRuleBlockThen struct {
@@ -296,6 +313,14 @@ func (rsc *securityNatStaticRule) ValidateConfig(
 			"only one of destination_address or destination_address_name must be specified",
 		)
 	}
+	if !config.ProxyArpInterface.IsNull() &&
+		config.DestinationAddress.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("proxy_arp_interface"),
+			tfdiag.MissingConfigErrSummary,
+			"destination_address must be specified with proxy_arp_interface",
+		)
+	}
 	if !config.DestiantionPortTo.IsNull() &&
 		config.DestinationPort.IsNull() {
 		resp.Diagnostics.AddAttributeError(
@@ -448,6 +473,10 @@ func (rsc *securityNatStaticRule) Create(
 
 				return false
 			}
+			resp.Diagnostics.Append(checkSecurityNatProxyEntriesFree(fnCtx, &plan, nil, junSess)...)
+			if resp.Diagnostics.HasError() {
+				return false
+			}
 
 			return true
 		},
@@ -489,6 +518,8 @@ func (rsc *securityNatStaticRule) Read(
 		return
 	}
 
+	// proxy-arp entry is only managed (and read) when proxy_arp_interface is set
+	data.ProxyArpInterface = state.ProxyArpInterface
 	var _ resourceDataReadFrom2String = &data
 	defaultResourceRead(
 		ctx,
@@ -513,6 +544,7 @@ func (rsc *securityNatStaticRule) Update(
 		return
 	}
 
+	var _ resourceDataPreCheckUpdate = &plan
 	defaultResourceUpdate(
 		ctx,
 		rsc,
@@ -597,6 +629,9 @@ func (rscData *securityNatStaticRuleData) set(
 
 	if v := rscData.DestinationAddress.ValueString(); v != "" {
 		configSet = append(configSet, setPrefix+"match destination-address "+v)
+		if vProxy := rscData.ProxyArpInterface.ValueString(); vProxy != "" {
+			configSet = append(configSet, securityNatProxyLine("set", vProxy, v))
+		}
 	}
 	if v := rscData.DestinationAddressName.ValueString(); v != "" {
 		configSet = append(configSet, setPrefix+"match destination-address-name \""+v+"\"")
@@ -683,7 +718,7 @@ func (rscData *securityNatStaticRuleData) set(
 }
 
 func (rscData *securityNatStaticRuleData) read(
-	_ context.Context, ruleSet, name string, junSess *junos.Session,
+	ctx context.Context, ruleSet, name string, junSess *junos.Session,
 ) error {
 	showConfig, err := junSess.Command(junos.CmdShowConfig +
 		"security nat static rule-set " + ruleSet + " rule " + name + junos.PipeDisplaySetRelative)
@@ -761,17 +796,47 @@ func (rscData *securityNatStaticRuleData) read(
 				}
 			}
 		}
+		// proxy-arp entry is only read when proxy_arp_interface is already known (from state)
+		if v, addresses := rscData.proxyEntries(); v != "" {
+			proxyExists, err := checkSecurityNatProxyAddresses(ctx, v, addresses, junSess)
+			if err != nil {
+				return err
+			}
+			if !proxyExists {
+				rscData.ProxyArpInterface = types.StringNull()
+			}
+		}
 	}
 
 	return nil
 }
 
+func (rscData *securityNatStaticRuleData) proxyEntries() (string, []string) {
+	var destinationAddresses []string
+	if v := rscData.DestinationAddress.ValueString(); v != "" {
+		destinationAddresses = append(destinationAddresses, v)
+	}
+
+	return rscData.ProxyArpInterface.ValueString(), destinationAddresses
+}
+
+func (rscData *securityNatStaticRuleData) preCheckUpdate(
+	ctx context.Context, state resourceDataDel, junSess *junos.Session,
+) diag.Diagnostics {
+	stateProxy, _ := state.(securityNatProxyEntries)
+
+	return checkSecurityNatProxyEntriesFree(ctx, rscData, stateProxy, junSess)
+}
+
 func (rscData *securityNatStaticRuleData) del(
 	_ context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security nat static rule-set " + rscData.RuleSet.ValueString() + " rule " + rscData.Name.ValueString(),
 	}
+	if v := rscData.ProxyArpInterface.ValueString(); v != "" && rscData.DestinationAddress.ValueString() != "" {
+		configSet = append(configSet, securityNatProxyLine("delete", v, rscData.DestinationAddress.ValueString()))
+	}
 
 	return junSess.ConfigSet(configSet)
 }
@@ -0,0 +1,852 @@
+package providerfwk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdata"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfplanmodifier"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	balt "github.com/jeremmfr/go-utils/basicalter"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &securityPkiCaProfile{}
+	_ resource.ResourceWithConfigure      = &securityPkiCaProfile{}
+	_ resource.ResourceWithValidateConfig = &securityPkiCaProfile{}
+	_ resource.ResourceWithImportState    = &securityPkiCaProfile{}
+)
+
+type securityPkiCaProfile struct {
+	client *junos.Client
+}
+
+func newSecurityPkiCaProfileResource() resource.Resource {
+	return &securityPkiCaProfile{}
+}
+
+func (rsc *securityPkiCaProfile) typeName() string {
+	return providerName + "_security_pki_ca_profile"
+}
+
+func (rsc *securityPkiCaProfile) junosName() string {
+	return "security pki ca-profile"
+}
+
+func (rsc *securityPkiCaProfile) junosClient() *junos.Client {
+	return rsc.client
+}
+
+func (rsc *securityPkiCaProfile) Metadata(
+	_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse,
+) {
+	resp.TypeName = rsc.typeName()
+}
+
+func (rsc *securityPkiCaProfile) Configure(
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedResourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	rsc.client = client
+}
+
+func (rsc *securityPkiCaProfile) Schema(
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a " + rsc.junosName() + ".",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the resource with format `<name>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of certificate authority profile.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+			"ca_identity": schema.StringAttribute{
+				Required:    true,
+				Description: "Certificate authority identifier.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					tfvalidator.StringDoubleQuoteExclusion(),
+				},
+			},
+			"administrator_email_address": schema.StringAttribute{
+				Optional:    true,
+				Description: "Administrator e-mail to which to send certificate requests.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					tfvalidator.StringDoubleQuoteExclusion(),
+				},
+			},
+			"proxy_profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "Use specified proxy server.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+					tfvalidator.StringDoubleQuoteExclusion(),
+				},
+			},
+			"routing_instance": schema.StringAttribute{
+				Optional:    true,
+				Description: "Routing instance name.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+			"source_address": schema.StringAttribute{
+				Optional:    true,
+				Description: "Use specified address as source address.",
+				Validators: []validator.String{
+					tfvalidator.StringIPAddress(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"enrollment": schema.SingleNestedBlock{
+				Description: "Enrollment parameters for certificate authority.",
+				Attributes: map[string]schema.Attribute{
+					"retry": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Number of enrollment retry attempts before aborting.",
+						Validators: []validator.Int64{
+							int64validator.Between(0, 1080),
+						},
+					},
+					"retry_interval": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Interval in seconds between the enrollment retries.",
+						Validators: []validator.Int64{
+							int64validator.Between(0, 3600),
+						},
+					},
+					"url": schema.StringAttribute{
+						Optional:    true,
+						Description: "Enrollment URL of certificate authority.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							tfvalidator.StringDoubleQuoteExclusion(),
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					tfplanmodifier.BlockRemoveNull(),
+				},
+			},
+			"revocation_check": schema.SingleNestedBlock{
+				Description: "Method for checking certificate revocations.",
+				Attributes: map[string]schema.Attribute{
+					"disable": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Disable revocation check.",
+						Validators: []validator.Bool{
+							tfvalidator.BoolTrue(),
+						},
+					},
+					"use_crl": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Use CRL for revocation check.",
+						Validators: []validator.Bool{
+							tfvalidator.BoolTrue(),
+						},
+					},
+					"use_ocsp": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Use OCSP for revocation check.",
+						Validators: []validator.Bool{
+							tfvalidator.BoolTrue(),
+						},
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"crl": schema.SingleNestedBlock{
+						Description: "Certificate revocation list configuration.",
+						Attributes: map[string]schema.Attribute{
+							"disable_on_download_failure": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Check revocation status with existing CRL file (if present).",
+								Validators: []validator.Bool{
+									tfvalidator.BoolTrue(),
+								},
+							},
+							"refresh_interval": schema.Int64Attribute{
+								Optional:    true,
+								Description: "CRL refresh interval (hours).",
+								Validators: []validator.Int64{
+									int64validator.Between(0, 8784),
+								},
+							},
+							"url": schema.StringAttribute{
+								Optional:    true,
+								Description: "URL of CRL distribution point for certificate authority.",
+								Validators: []validator.String{
+									stringvalidator.LengthAtLeast(1),
+									tfvalidator.StringDoubleQuoteExclusion(),
+								},
+							},
+						},
+						PlanModifiers: []planmodifier.Object{
+							tfplanmodifier.BlockRemoveNull(),
+						},
+					},
+					"ocsp": schema.SingleNestedBlock{
+						Description: "Online Certificate Status Protocol (OCSP) configuration.",
+						Attributes: map[string]schema.Attribute{
+							"connection_failure": schema.StringAttribute{
+								Optional:    true,
+								Description: "Actions on failure to connect to OCSP Responder.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("disable", "fallback-crl"),
+								},
+							},
+							"disable_responder_revocation_check": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Disable OCSP responder certificate revocation check.",
+								Validators: []validator.Bool{
+									tfvalidator.BoolTrue(),
+								},
+							},
+							"nonce_payload": schema.StringAttribute{
+								Optional:    true,
+								Description: "Include nonce payload in OCSP requests.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("disable", "enable"),
+								},
+							},
+							"url": schema.ListAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+								Description: "HTTP URL for OCSP access location.",
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+									listvalidator.ValueStringsAre(
+										stringvalidator.LengthAtLeast(1),
+										tfvalidator.StringDoubleQuoteExclusion(),
+									),
+								},
+							},
+						},
+						PlanModifiers: []planmodifier.Object{
+							tfplanmodifier.BlockRemoveNull(),
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					tfplanmodifier.BlockRemoveNull(),
+				},
+			},
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
+	}
+}
+
+type securityPkiCaProfileData struct {
+	ID                        types.String                              `tfsdk:"id"`
+	Name                      types.String                              `tfsdk:"name"`
+	CaIdentity                types.String                              `tfsdk:"ca_identity"`
+	AdministratorEmailAddress types.String                              `tfsdk:"administrator_email_address"`
+	ProxyProfile              types.String                              `tfsdk:"proxy_profile"`
+	RoutingInstance           types.String                              `tfsdk:"routing_instance"`
+	SourceAddress             types.String                              `tfsdk:"source_address"`
+	Enrollment                *securityPkiCaProfileBlockEnrollment      `tfsdk:"enrollment"`
+	RevocationCheck           *securityPkiCaProfileBlockRevocationCheck `tfsdk:"revocation_check"`
+	Timeouts                  resourceTimeouts                          `tfsdk:"timeouts"`
+}
+
+type securityPkiCaProfileConfig struct {
+	ID                        types.String                                    `tfsdk:"id"`
+	Name                      types.String                                    `tfsdk:"name"`
+	CaIdentity                types.String                                    `tfsdk:"ca_identity"`
+	AdministratorEmailAddress types.String                                    `tfsdk:"administrator_email_address"`
+	ProxyProfile              types.String                                    `tfsdk:"proxy_profile"`
+	RoutingInstance           types.String                                    `tfsdk:"routing_instance"`
+	SourceAddress             types.String                                    `tfsdk:"source_address"`
+	Enrollment                *securityPkiCaProfileBlockEnrollment            `tfsdk:"enrollment"`
+	RevocationCheck           *securityPkiCaProfileBlockRevocationCheckConfig `tfsdk:"revocation_check"`
+	Timeouts                  resourceTimeouts                                `tfsdk:"timeouts"`
+}
+
+type securityPkiCaProfileBlockEnrollment struct {
+	Retry         types.Int64  `tfsdk:"retry"`
+	RetryInterval types.Int64  `tfsdk:"retry_interval"`
+	URL           types.String `tfsdk:"url"`
+}
+
+func (block *securityPkiCaProfileBlockEnrollment) isEmpty() bool {
+	switch {
+	case !block.Retry.IsNull():
+		return false
+	case !block.RetryInterval.IsNull():
+		return false
+	case !block.URL.IsNull():
+		return false
+	default:
+		return true
+	}
+}
+
+type securityPkiCaProfileBlockRevocationCheck struct {
+	Disable types.Bool                                         `tfsdk:"disable"`
+	UseCrl  types.Bool                                         `tfsdk:"use_crl"`
+	UseOcsp types.Bool                                         `tfsdk:"use_ocsp"`
+	Crl     *securityPkiCaProfileBlockRevocationCheckBlockCrl  `tfsdk:"crl"`
+	Ocsp    *securityPkiCaProfileBlockRevocationCheckBlockOcsp `tfsdk:"ocsp"`
+}
+
+type securityPkiCaProfileBlockRevocationCheckConfig struct {
+	Disable types.Bool                                               `tfsdk:"disable"`
+	UseCrl  types.Bool                                               `tfsdk:"use_crl"`
+	UseOcsp types.Bool                                               `tfsdk:"use_ocsp"`
+	Crl     *securityPkiCaProfileBlockRevocationCheckBlockCrl        `tfsdk:"crl"`
+	Ocsp    *securityPkiCaProfileBlockRevocationCheckBlockOcspConfig `tfsdk:"ocsp"`
+}
+
+func (block *securityPkiCaProfileBlockRevocationCheckConfig) isEmpty() bool {
+	switch {
+	case !block.Disable.IsNull():
+		return false
+	case !block.UseCrl.IsNull():
+		return false
+	case !block.UseOcsp.IsNull():
+		return false
+	case block.Crl != nil:
+		return false
+	case block.Ocsp != nil:
+		return false
+	default:
+		return true
+	}
+}
+
+type securityPkiCaProfileBlockRevocationCheckBlockCrl struct {
+	DisableOnDownloadFailure types.Bool   `tfsdk:"disable_on_download_failure"`
+	RefreshInterval          types.Int64  `tfsdk:"refresh_interval"`
+	URL                      types.String `tfsdk:"url"`
+}
+
+func (block *securityPkiCaProfileBlockRevocationCheckBlockCrl) isEmpty() bool {
+	switch {
+	case !block.DisableOnDownloadFailure.IsNull():
+		return false
+	case !block.RefreshInterval.IsNull():
+		return false
+	case !block.URL.IsNull():
+		return false
+	default:
+		return true
+	}
+}
+
+type securityPkiCaProfileBlockRevocationCheckBlockOcsp struct {
+	ConnectionFailure               types.String   `tfsdk:"connection_failure"`
+	DisableResponderRevocationCheck types.Bool     `tfsdk:"disable_responder_revocation_check"`
+	NoncePayload                    types.String   `tfsdk:"nonce_payload"`
+	URL                             []types.String `tfsdk:"url"`
+}
+
+type securityPkiCaProfileBlockRevocationCheckBlockOcspConfig struct {
+	ConnectionFailure               types.String `tfsdk:"connection_failure"`
+	DisableResponderRevocationCheck types.Bool   `tfsdk:"disable_responder_revocation_check"`
+	NoncePayload                    types.String `tfsdk:"nonce_payload"`
+	URL                             types.List   `tfsdk:"url"`
+}
+
+func (block *securityPkiCaProfileBlockRevocationCheckBlockOcspConfig) isEmpty() bool {
+	switch {
+	case !block.ConnectionFailure.IsNull():
+		return false
+	case !block.DisableResponderRevocationCheck.IsNull():
+		return false
+	case !block.NoncePayload.IsNull():
+		return false
+	case !block.URL.IsNull():
+		return false
+	default:
+		return true
+	}
+}
+
+func (rsc *securityPkiCaProfile) ValidateConfig(
+	ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse,
+) {
+	var config securityPkiCaProfileConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Enrollment != nil {
+		if config.Enrollment.isEmpty() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("enrollment").AtName("*"),
+				tfdiag.MissingConfigErrSummary,
+				"enrollment block is empty",
+			)
+		}
+	}
+	if config.RevocationCheck != nil {
+		if config.RevocationCheck.isEmpty() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("revocation_check").AtName("*"),
+				tfdiag.MissingConfigErrSummary,
+				"revocation_check block is empty",
+			)
+		}
+		if !config.RevocationCheck.Disable.IsNull() &&
+			(!config.RevocationCheck.UseCrl.IsNull() || !config.RevocationCheck.UseOcsp.IsNull()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("revocation_check").AtName("disable"),
+				tfdiag.ConflictConfigErrSummary,
+				"only one of disable, use_crl or use_ocsp can be specified in revocation_check block",
+			)
+		}
+		if !config.RevocationCheck.UseCrl.IsNull() && !config.RevocationCheck.UseOcsp.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("revocation_check").AtName("use_crl"),
+				tfdiag.ConflictConfigErrSummary,
+				"only one of disable, use_crl or use_ocsp can be specified in revocation_check block",
+			)
+		}
+		if config.RevocationCheck.Crl != nil {
+			if config.RevocationCheck.Crl.isEmpty() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("revocation_check").AtName("crl").AtName("*"),
+					tfdiag.MissingConfigErrSummary,
+					"crl block in revocation_check block is empty",
+				)
+			}
+		}
+		if config.RevocationCheck.Ocsp != nil {
+			if config.RevocationCheck.Ocsp.isEmpty() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("revocation_check").AtName("ocsp").AtName("*"),
+					tfdiag.MissingConfigErrSummary,
+					"ocsp block in revocation_check block is empty",
+				)
+			}
+		}
+	}
+}
+
+func (rsc *securityPkiCaProfile) Create(
+	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
+) {
+	var plan securityPkiCaProfileData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Name.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Empty Name",
+			"could not create "+rsc.junosName()+" with empty name",
+		)
+
+		return
+	}
+
+	defaultResourceCreate(
+		ctx,
+		rsc,
+		func(fnCtx context.Context, junSess *junos.Session) bool {
+			profileExists, err := checkSecurityPkiCaProfileExists(fnCtx, plan.Name.ValueString(), junSess)
+			if err != nil {
+				resp.Diagnostics.AddError(tfdiag.PreCheckErrSummary, err.Error())
+
+				return false
+			}
+			if profileExists {
+				resp.Diagnostics.AddError(
+					tfdiag.DuplicateConfigErrSummary,
+					fmt.Sprintf(rsc.junosName()+" %q already exists", plan.Name.ValueString()),
+				)
+
+				return false
+			}
+
+			return true
+		},
+		func(fnCtx context.Context, junSess *junos.Session) bool {
+			profileExists, err := checkSecurityPkiCaProfileExists(fnCtx, plan.Name.ValueString(), junSess)
+			if err != nil {
+				resp.Diagnostics.AddError(tfdiag.PostCheckErrSummary, err.Error())
+
+				return false
+			}
+			if !profileExists {
+				resp.Diagnostics.AddError(
+					tfdiag.NotFoundErrSummary,
+					fmt.Sprintf(rsc.junosName()+" %q does not exists after commit "+
+						"=> check your config", plan.Name.ValueString()),
+				)
+
+				return false
+			}
+
+			return true
+		},
+		&plan,
+		resp,
+	)
+}
+
+func (rsc *securityPkiCaProfile) Read(
+	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse,
+) {
+	var state, data securityPkiCaProfileData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var _ resourceDataReadFrom1String = &data
+	defaultResourceRead(
+		ctx,
+		rsc,
+		[]string{
+			state.Name.ValueString(),
+		},
+		&data,
+		nil,
+		resp,
+	)
+}
+
+func (rsc *securityPkiCaProfile) Update(
+	ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
+) {
+	var plan, state securityPkiCaProfileData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceUpdate(
+		ctx,
+		rsc,
+		&state,
+		&plan,
+		resp,
+	)
+}
+
+func (rsc *securityPkiCaProfile) Delete(
+	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
+) {
+	var state securityPkiCaProfileData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceDelete(
+		ctx,
+		rsc,
+		&state,
+		resp,
+	)
+}
+
+func (rsc *securityPkiCaProfile) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
+) {
+	var data securityPkiCaProfileData
+
+	var _ resourceDataReadFrom1String = &data
+	defaultResourceImportState(
+		ctx,
+		rsc,
+		&data,
+		req,
+		resp,
+		fmt.Sprintf("don't find "+rsc.junosName()+" with id %q "+
+			"(id must be <name>)", req.ID),
+	)
+}
+
+func checkSecurityPkiCaProfileExists(
+	_ context.Context, name string, junSess *junos.Session,
+) (
+	bool, error,
+) {
+	showConfig, err := junSess.Command(junos.CmdShowConfig +
+		"security pki ca-profile " + name + junos.PipeDisplaySet)
+	if err != nil {
+		return false, err
+	}
+	if showConfig == junos.EmptyW {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (rscData *securityPkiCaProfileData) fillID() {
+	rscData.ID = types.StringValue(rscData.Name.ValueString())
+}
+
+func (rscData *securityPkiCaProfileData) nullID() bool {
+	return rscData.ID.IsNull()
+}
+
+func (rscData *securityPkiCaProfileData) set(
+	_ context.Context, junSess *junos.Session,
+) (
+	path.Path, error,
+) {
+	configSet := make([]string, 0)
+	setPrefix := "set security pki ca-profile " + rscData.Name.ValueString() + " "
+
+	configSet = append(configSet, setPrefix+"ca-identity \""+rscData.CaIdentity.ValueString()+"\"")
+	if v := rscData.AdministratorEmailAddress.ValueString(); v != "" {
+		configSet = append(configSet, setPrefix+"administrator email-address \""+v+"\"")
+	}
+	if rscData.Enrollment != nil {
+		if rscData.Enrollment.isEmpty() {
+			return path.Root("enrollment").AtName("*"),
+				fmt.Errorf("enrollment block is empty")
+		}
+
+		if !rscData.Enrollment.Retry.IsNull() {
+			configSet = append(configSet, setPrefix+"enrollment retry "+
+				utils.ConvI64toa(rscData.Enrollment.Retry.ValueInt64()))
+		}
+		if !rscData.Enrollment.RetryInterval.IsNull() {
+			configSet = append(configSet, setPrefix+"enrollment retry-interval "+
+				utils.ConvI64toa(rscData.Enrollment.RetryInterval.ValueInt64()))
+		}
+		if v := rscData.Enrollment.URL.ValueString(); v != "" {
+			configSet = append(configSet, setPrefix+"enrollment url \""+v+"\"")
+		}
+	}
+	if v := rscData.ProxyProfile.ValueString(); v != "" {
+		configSet = append(configSet, setPrefix+"proxy-profile \""+v+"\"")
+	}
+	if rscData.RevocationCheck != nil {
+		blockSet, pathErr, err := rscData.RevocationCheck.configSet(setPrefix)
+		if err != nil {
+			return pathErr, err
+		}
+		configSet = append(configSet, blockSet...)
+	}
+	if v := rscData.RoutingInstance.ValueString(); v != "" {
+		configSet = append(configSet, setPrefix+"routing-instance "+v)
+	}
+	if v := rscData.SourceAddress.ValueString(); v != "" {
+		configSet = append(configSet, setPrefix+"source-address "+v)
+	}
+
+	return path.Empty(), junSess.ConfigSet(configSet)
+}
+
+func (block *securityPkiCaProfileBlockRevocationCheck) configSet(
+	setPrefix string,
+) (
+	[]string, // configSet
+	path.Path, // pathErr
+	error, // error
+) {
+	configSet := make([]string, 0)
+	setPrefix += "revocation-check "
+
+	if block.Disable.ValueBool() {
+		configSet = append(configSet, setPrefix+"disable")
+	}
+	if block.UseCrl.ValueBool() {
+		configSet = append(configSet, setPrefix+"use-crl")
+	}
+	if block.UseOcsp.ValueBool() {
+		configSet = append(configSet, setPrefix+"use-ocsp")
+	}
+	if block.Crl != nil {
+		if block.Crl.isEmpty() {
+			return configSet,
+				path.Root("revocation_check").AtName("crl").AtName("*"),
+				fmt.Errorf("crl block in revocation_check block is empty")
+		}
+
+		if block.Crl.DisableOnDownloadFailure.ValueBool() {
+			configSet = append(configSet, setPrefix+"crl disable on-download-failure")
+		}
+		if !block.Crl.RefreshInterval.IsNull() {
+			configSet = append(configSet, setPrefix+"crl refresh-interval "+
+				utils.ConvI64toa(block.Crl.RefreshInterval.ValueInt64()))
+		}
+		if v := block.Crl.URL.ValueString(); v != "" {
+			configSet = append(configSet, setPrefix+"crl url \""+v+"\"")
+		}
+	}
+	if block.Ocsp != nil {
+		configSet = append(configSet, setPrefix+"ocsp")
+
+		if v := block.Ocsp.ConnectionFailure.ValueString(); v != "" {
+			configSet = append(configSet, setPrefix+"ocsp connection-failure "+v)
+		}
+		if block.Ocsp.DisableResponderRevocationCheck.ValueBool() {
+			configSet = append(configSet, setPrefix+"ocsp disable-responder-revocation-check")
+		}
+		if v := block.Ocsp.NoncePayload.ValueString(); v != "" {
+			configSet = append(configSet, setPrefix+"ocsp nonce-payload "+v)
+		}
+		for _, v := range block.Ocsp.URL {
+			configSet = append(configSet, setPrefix+"ocsp url \""+v.ValueString()+"\"")
+		}
+	}
+
+	return configSet, path.Empty(), nil
+}
+
+func (rscData *securityPkiCaProfileData) read(
+	_ context.Context, name string, junSess *junos.Session,
+) (
+	err error,
+) {
+	showConfig, err := junSess.Command(junos.CmdShowConfig +
+		"security pki ca-profile " + name + junos.PipeDisplaySetRelative)
+	if err != nil {
+		return err
+	}
+	if showConfig != junos.EmptyW {
+		rscData.Name = types.StringValue(name)
+		rscData.fillID()
+		for _, item := range strings.Split(showConfig, "\n") {
+			if strings.Contains(item, junos.XMLStartTagConfigOut) {
+				continue
+			}
+			if strings.Contains(item, junos.XMLEndTagConfigOut) {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, junos.SetLS)
+			switch {
+			case balt.CutPrefixInString(&itemTrim, "ca-identity "):
+				rscData.CaIdentity = types.StringValue(strings.Trim(itemTrim, "\""))
+			case balt.CutPrefixInString(&itemTrim, "administrator email-address "):
+				rscData.AdministratorEmailAddress = types.StringValue(strings.Trim(itemTrim, "\""))
+			case balt.CutPrefixInString(&itemTrim, "enrollment "):
+				if rscData.Enrollment == nil {
+					rscData.Enrollment = &securityPkiCaProfileBlockEnrollment{}
+				}
+				switch {
+				case balt.CutPrefixInString(&itemTrim, "retry "):
+					rscData.Enrollment.Retry, err = tfdata.ConvAtoi64Value(itemTrim)
+					if err != nil {
+						return err
+					}
+				case balt.CutPrefixInString(&itemTrim, "retry-interval "):
+					rscData.Enrollment.RetryInterval, err = tfdata.ConvAtoi64Value(itemTrim)
+					if err != nil {
+						return err
+					}
+				case balt.CutPrefixInString(&itemTrim, "url "):
+					rscData.Enrollment.URL = types.StringValue(strings.Trim(itemTrim, "\""))
+				}
+			case balt.CutPrefixInString(&itemTrim, "proxy-profile "):
+				rscData.ProxyProfile = types.StringValue(strings.Trim(itemTrim, "\""))
+			case balt.CutPrefixInString(&itemTrim, "revocation-check "):
+				if rscData.RevocationCheck == nil {
+					rscData.RevocationCheck = &securityPkiCaProfileBlockRevocationCheck{}
+				}
+				if err := rscData.RevocationCheck.read(itemTrim); err != nil {
+					return err
+				}
+			case balt.CutPrefixInString(&itemTrim, "routing-instance "):
+				rscData.RoutingInstance = types.StringValue(itemTrim)
+			case balt.CutPrefixInString(&itemTrim, "source-address "):
+				rscData.SourceAddress = types.StringValue(itemTrim)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (block *securityPkiCaProfileBlockRevocationCheck) read(itemTrim string) (err error) {
+	switch {
+	case itemTrim == "disable":
+		block.Disable = types.BoolValue(true)
+	case itemTrim == "use-crl":
+		block.UseCrl = types.BoolValue(true)
+	case itemTrim == "use-ocsp":
+		block.UseOcsp = types.BoolValue(true)
+	case balt.CutPrefixInString(&itemTrim, "crl "):
+		if block.Crl == nil {
+			block.Crl = &securityPkiCaProfileBlockRevocationCheckBlockCrl{}
+		}
+		switch {
+		case itemTrim == "disable on-download-failure":
+			block.Crl.DisableOnDownloadFailure = types.BoolValue(true)
+		case balt.CutPrefixInString(&itemTrim, "refresh-interval "):
+			block.Crl.RefreshInterval, err = tfdata.ConvAtoi64Value(itemTrim)
+			if err != nil {
+				return err
+			}
+		case balt.CutPrefixInString(&itemTrim, "url "):
+			block.Crl.URL = types.StringValue(strings.Trim(itemTrim, "\""))
+		}
+	case balt.CutPrefixInString(&itemTrim, "ocsp"):
+		if block.Ocsp == nil {
+			block.Ocsp = &securityPkiCaProfileBlockRevocationCheckBlockOcsp{}
+		}
+		switch {
+		case balt.CutPrefixInString(&itemTrim, " connection-failure "):
+			block.Ocsp.ConnectionFailure = types.StringValue(itemTrim)
+		case itemTrim == " disable-responder-revocation-check":
+			block.Ocsp.DisableResponderRevocationCheck = types.BoolValue(true)
+		case balt.CutPrefixInString(&itemTrim, " nonce-payload "):
+			block.Ocsp.NoncePayload = types.StringValue(itemTrim)
+		case balt.CutPrefixInString(&itemTrim, " url "):
+			block.Ocsp.URL = append(block.Ocsp.URL, types.StringValue(strings.Trim(itemTrim, "\"")))
+		}
+	}
+
+	return nil
+}
+
+func (rscData *securityPkiCaProfileData) del(
+	_ context.Context, junSess *junos.Session,
+) error {
+	configSet := []string{
+		"delete security pki ca-profile " + rscData.Name.ValueString(),
+	}
+
+	return junSess.ConfigSet(configSet)
+}
@@ -0,0 +1,93 @@
+package providerfwk_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJunosSecurityPkiCaProfile_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SRX") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosSecurityPkiCaProfileConfigCreate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_security_pki_ca_profile.testacc_pkiCaProfile",
+							"ca_identity", "testacc"),
+						resource.TestCheckResourceAttr("junos_security_pki_ca_profile.testacc_pkiCaProfile",
+							"enrollment.url", "http://192.0.2.1/scep"),
+						resource.TestCheckResourceAttr("junos_security_pki_ca_profile.testacc_pkiCaProfile",
+							"revocation_check.disable", "true"),
+					),
+				},
+				{
+					Config: testAccJunosSecurityPkiCaProfileConfigUpdate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_security_pki_ca_profile.testacc_pkiCaProfile",
+							"revocation_check.use_ocsp", "true"),
+						resource.TestCheckResourceAttr("junos_security_pki_ca_profile.testacc_pkiCaProfile",
+							"revocation_check.ocsp.url.#", "2"),
+					),
+				},
+				{
+					ResourceName:      "junos_security_pki_ca_profile.testacc_pkiCaProfile",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosSecurityPkiCaProfileConfigCreate() string {
+	return `
+resource "junos_security_pki_ca_profile" "testacc_pkiCaProfile" {
+  name        = "testacc_pkiCaProfile"
+  ca_identity = "testacc"
+  enrollment {
+    url = "http://192.0.2.1/scep"
+  }
+  revocation_check {
+    disable = true
+  }
+}
+`
+}
+
+func testAccJunosSecurityPkiCaProfileConfigUpdate() string {
+	return `
+resource "junos_routing_instance" "testacc_pkiCaProfile" {
+  name = "testacc_pkiCaProfile"
+}
+resource "junos_security_pki_ca_profile" "testacc_pkiCaProfile" {
+  name                        = "testacc_pkiCaProfile"
+  ca_identity                 = "testacc"
+  administrator_email_address = "admin@example.com"
+  routing_instance            = junos_routing_instance.testacc_pkiCaProfile.name
+  source_address              = "192.0.2.2"
+  enrollment {
+    url            = "http://192.0.2.1/scep"
+    retry          = 5
+    retry_interval = 60
+  }
+  revocation_check {
+    use_ocsp = true
+    crl {
+      disable_on_download_failure = true
+      refresh_interval            = 24
+      url                         = "http://192.0.2.1/crl"
+    }
+    ocsp {
+      connection_failure                 = "fallback-crl"
+      disable_responder_revocation_check = true
+      nonce_payload                      = "enable"
+      url                                = ["http://192.0.2.1/ocsp", "http://192.0.2.3/ocsp"]
+    }
+  }
+}
+`
+}
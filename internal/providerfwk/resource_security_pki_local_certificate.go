@@ -0,0 +1,655 @@
+package providerfwk
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfplanmodifier"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &securityPkiLocalCertificate{}
+	_ resource.ResourceWithConfigure      = &securityPkiLocalCertificate{}
+	_ resource.ResourceWithValidateConfig = &securityPkiLocalCertificate{}
+)
+
+const (
+	securityPkiLocalCertificateTmpPrefix = "/var/tmp/terraform-junos-pki-"
+	securityPkiCertificateRequestBegin   = "-----BEGIN CERTIFICATE REQUEST-----"
+	securityPkiCertificateRequestEnd     = "-----END CERTIFICATE REQUEST-----"
+)
+
+type securityPkiLocalCertificate struct {
+	client *junos.Client
+}
+
+func newSecurityPkiLocalCertificateResource() resource.Resource {
+	return &securityPkiLocalCertificate{}
+}
+
+func (rsc *securityPkiLocalCertificate) typeName() string {
+	return providerName + "_security_pki_local_certificate"
+}
+
+func (rsc *securityPkiLocalCertificate) junosName() string {
+	return "security pki local-certificate"
+}
+
+func (rsc *securityPkiLocalCertificate) Metadata(
+	_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse,
+) {
+	resp.TypeName = rsc.typeName()
+}
+
+func (rsc *securityPkiLocalCertificate) Configure(
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedResourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	rsc.client = client
+}
+
+func (rsc *securityPkiLocalCertificate) Schema(
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Generate a key-pair and/or load a local certificate with operational commands " +
+			"(`" + rsc.junosName() + "`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the resource with format `<certificate_id>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Certificate identifier.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+			"key_pair_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Type of key pair to generate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("dsa", "ecdsa", "rsa"),
+				},
+			},
+			"key_pair_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Size of key pair to generate.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.OneOf(256, 384, 521, 1024, 2048, 4096),
+				},
+			},
+			"private_key_pem": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Private key in PEM format to load with the certificate instead of generating a key pair.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"certificate_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "Local certificate in PEM format to load.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							// the certificate can be loaded on a previously generated key pair
+							// without re-generating it
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Change of certificate requires replacement when a certificate was already loaded.",
+						"Change of certificate requires replacement when a certificate was already loaded.",
+					),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"certificate_request_pem": schema.StringAttribute{
+				Computed:    true,
+				Description: "Certificate request in PEM format generated with `certificate_request` block.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"certificate_request": schema.SingleNestedBlock{
+				Description: "Generate a certificate request (PKCS #10) after the key pair generation.",
+				Attributes: map[string]schema.Attribute{
+					"subject": schema.StringAttribute{
+						Required:    false, // true when SingleNestedBlock is specified
+						Optional:    true,
+						Description: "Distinguished name (DN) of the subject.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							tfvalidator.StringRuneExclusion('"', '\n', '\r'),
+						},
+					},
+					"domain_name": schema.StringAttribute{
+						Optional:    true,
+						Description: "Fully qualified domain name.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							tfvalidator.StringFormat(tfvalidator.DNSNameFormat),
+						},
+					},
+					"email": schema.StringAttribute{
+						Optional:    true,
+						Description: "E-mail address.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							tfvalidator.StringRuneExclusion('"', '\n', '\r'),
+						},
+					},
+					"ip_address": schema.StringAttribute{
+						Optional:    true,
+						Description: "IP address.",
+						Validators: []validator.String{
+							tfvalidator.StringIPAddress(),
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					tfplanmodifier.BlockRemoveNull(),
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
+	}
+}
+
+type securityPkiLocalCertificateData struct {
+	ID                    types.String                                        `tfsdk:"id"`
+	CertificateID         types.String                                        `tfsdk:"certificate_id"`
+	KeyPairType           types.String                                        `tfsdk:"key_pair_type"`
+	KeyPairSize           types.Int64                                         `tfsdk:"key_pair_size"`
+	PrivateKeyPem         types.String                                        `tfsdk:"private_key_pem"`
+	CertificatePem        types.String                                        `tfsdk:"certificate_pem"`
+	CertificateRequestPem types.String                                        `tfsdk:"certificate_request_pem"`
+	CertificateRequest    *securityPkiLocalCertificateBlockCertificateRequest `tfsdk:"certificate_request"`
+	Timeouts              resourceTimeouts                                    `tfsdk:"timeouts"`
+}
+
+type securityPkiLocalCertificateBlockCertificateRequest struct {
+	Subject    types.String `tfsdk:"subject"`
+	DomainName types.String `tfsdk:"domain_name"`
+	Email      types.String `tfsdk:"email"`
+	IPAddress  types.String `tfsdk:"ip_address"`
+}
+
+func (rsc *securityPkiLocalCertificate) ValidateConfig(
+	ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse,
+) {
+	var config securityPkiLocalCertificateData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.PrivateKeyPem.IsNull() {
+		if config.CertificatePem.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_key_pem"),
+				tfdiag.MissingConfigErrSummary,
+				"certificate_pem must be specified with private_key_pem",
+			)
+		}
+		if !config.KeyPairType.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_key_pem"),
+				tfdiag.ConflictConfigErrSummary,
+				"private_key_pem and key_pair_type cannot be configured together",
+			)
+		}
+		if !config.KeyPairSize.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_key_pem"),
+				tfdiag.ConflictConfigErrSummary,
+				"private_key_pem and key_pair_size cannot be configured together",
+			)
+		}
+		if config.CertificateRequest != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_key_pem"),
+				tfdiag.ConflictConfigErrSummary,
+				"private_key_pem and certificate_request block cannot be configured together",
+			)
+		}
+	}
+	if config.CertificateRequest != nil {
+		if config.CertificateRequest.Subject.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("certificate_request").AtName("subject"),
+				tfdiag.MissingConfigErrSummary,
+				"subject must be specified in certificate_request block",
+			)
+		}
+	}
+}
+
+func (rsc *securityPkiLocalCertificate) Create(
+	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
+) {
+	var plan securityPkiLocalCertificateData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultResourceCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if plan.CertificateID.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("certificate_id"),
+			"Empty Certificate ID",
+			"could not create "+rsc.junosName()+" with empty certificate_id",
+		)
+
+		return
+	}
+
+	junSess, err := rsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+	if !junSess.CheckCompatibilitySecurity() {
+		resp.Diagnostics.AddError(
+			tfdiag.CompatibilityErrSummary,
+			rsc.junosName()+" not compatible "+
+				"with Junos device "+junSess.SystemInformation.HardwareModel,
+		)
+
+		return
+	}
+	certExists, err := checkSecurityPkiLocalCertificateExists(ctx, plan.CertificateID.ValueString(), junSess)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.PreCheckErrSummary, err.Error())
+
+		return
+	}
+	if certExists {
+		resp.Diagnostics.AddError(
+			tfdiag.DuplicateConfigErrSummary,
+			fmt.Sprintf(rsc.junosName()+" %q already exists", plan.CertificateID.ValueString()),
+		)
+
+		return
+	}
+
+	if plan.PrivateKeyPem.IsNull() {
+		if err := plan.generateKeyPair(junSess); err != nil {
+			resp.Diagnostics.AddError("Key Pair Generation Error", err.Error())
+
+			return
+		}
+		if plan.CertificateRequest != nil {
+			if err := plan.generateCertificateRequest(junSess); err != nil {
+				resp.Diagnostics.AddError("Certificate Request Generation Error", err.Error())
+				_ = plan.clear(false, junSess)
+
+				return
+			}
+		} else {
+			plan.CertificateRequestPem = types.StringNull()
+		}
+	} else {
+		plan.CertificateRequestPem = types.StringNull()
+	}
+	if !plan.CertificatePem.IsNull() {
+		if err := plan.load(junSess); err != nil {
+			resp.Diagnostics.AddError("Certificate Load Error", err.Error())
+			_ = plan.clear(false, junSess)
+
+			return
+		}
+
+		certExists, err := checkSecurityPkiLocalCertificateExists(ctx, plan.CertificateID.ValueString(), junSess)
+		if err != nil {
+			resp.Diagnostics.AddError(tfdiag.PostCheckErrSummary, err.Error())
+			_ = plan.clear(true, junSess)
+
+			return
+		}
+		if !certExists {
+			resp.Diagnostics.AddError(
+				tfdiag.NotFoundErrSummary,
+				fmt.Sprintf(rsc.junosName()+" %q does not exists after load "+
+					"=> check your certificate", plan.CertificateID.ValueString()),
+			)
+			_ = plan.clear(false, junSess)
+
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(plan.CertificateID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (rsc *securityPkiLocalCertificate) Read(
+	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse,
+) {
+	var state securityPkiLocalCertificateData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultResourceReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// without loaded certificate, only a key pair exists and can't be read
+	if state.CertificatePem.IsNull() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+		return
+	}
+
+	junSess, err := rsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	junos.MutexLock()
+	certExists, err := checkSecurityPkiLocalCertificateExists(ctx, state.CertificateID.ValueString(), junSess)
+	junos.MutexUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
+
+		return
+	}
+	if !certExists {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (rsc *securityPkiLocalCertificate) Update(
+	ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
+) {
+	var plan, state securityPkiLocalCertificateData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultResourceUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// only the load of a certificate on a generated key pair is possible without replacement
+	if !plan.CertificatePem.IsNull() && state.CertificatePem.IsNull() {
+		junSess, err := rsc.client.StartNewSession(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+			return
+		}
+		defer junSess.Close()
+
+		if err := plan.load(junSess); err != nil {
+			resp.Diagnostics.AddError("Certificate Load Error", err.Error())
+
+			return
+		}
+		certExists, err := checkSecurityPkiLocalCertificateExists(ctx, plan.CertificateID.ValueString(), junSess)
+		if err != nil {
+			resp.Diagnostics.AddError(tfdiag.PostCheckErrSummary, err.Error())
+
+			return
+		}
+		if !certExists {
+			resp.Diagnostics.AddError(
+				tfdiag.NotFoundErrSummary,
+				fmt.Sprintf(rsc.junosName()+" %q does not exists after load "+
+					"=> check your certificate", plan.CertificateID.ValueString()),
+			)
+
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (rsc *securityPkiLocalCertificate) Delete(
+	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
+) {
+	var state securityPkiLocalCertificateData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultResourceDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	junSess, err := rsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	if err := state.clear(!state.CertificatePem.IsNull(), junSess); err != nil {
+		resp.Diagnostics.AddError("Certificate Clear Error", err.Error())
+
+		return
+	}
+}
+
+func checkSecurityPkiLocalCertificateExists(
+	_ context.Context, certificateID string, junSess *junos.Session,
+) (
+	bool, error,
+) {
+	replyData, err := junSess.CommandXML(fmt.Sprintf(junos.RPCGetPKILocalCertificateIDInformation, certificateID))
+	if err != nil {
+		if strings.Contains(err.Error(), "does not exist") {
+			return false, nil
+		}
+
+		return false, err
+	}
+	var reply junos.GetPKILocalCertificateReply
+	err = xml.Unmarshal([]byte(replyData), &reply.PKILocalCertificateInfo)
+	if err != nil {
+		return false, fmt.Errorf("unmarshaling xml reply %q: %w", replyData, err)
+	}
+	for _, cert := range reply.PKILocalCertificateInfo.Certificate {
+		if strings.TrimSpace(cert.Identifier) == certificateID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (rscData *securityPkiLocalCertificateData) generateKeyPair(junSess *junos.Session) error {
+	cmd := "request security pki generate-key-pair certificate-id " + rscData.CertificateID.ValueString()
+	if !rscData.KeyPairSize.IsNull() {
+		cmd += " size " + utils.ConvI64toa(rscData.KeyPairSize.ValueInt64())
+	}
+	if v := rscData.KeyPairType.ValueString(); v != "" {
+		cmd += " type " + v
+	}
+	output, err := junSess.Command(cmd)
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(output, "Generated key pair") {
+		return errors.New(strings.TrimSpace(output))
+	}
+
+	return nil
+}
+
+func (rscData *securityPkiLocalCertificateData) generateCertificateRequest(junSess *junos.Session) error {
+	for _, v := range []types.String{
+		rscData.CertificateRequest.Subject,
+		rscData.CertificateRequest.DomainName,
+		rscData.CertificateRequest.Email,
+		rscData.CertificateRequest.IPAddress,
+	} {
+		if strings.ContainsAny(v.ValueString(), "\"\n\r") {
+			return fmt.Errorf("unauthorized character (double quote or newline) in %q", v.ValueString())
+		}
+	}
+	// the command is sent as text of a XML element
+	cmd := "request security pki generate-certificate-request certificate-id " +
+		rscData.CertificateID.ValueString() +
+		" subject \"" + html.EscapeString(rscData.CertificateRequest.Subject.ValueString()) + "\""
+	if v := rscData.CertificateRequest.DomainName.ValueString(); v != "" {
+		cmd += " domain-name " + html.EscapeString(v)
+	}
+	if v := rscData.CertificateRequest.Email.ValueString(); v != "" {
+		cmd += " email \"" + html.EscapeString(v) + "\""
+	}
+	if v := rscData.CertificateRequest.IPAddress.ValueString(); v != "" {
+		cmd += " ip-address " + html.EscapeString(v)
+	}
+	output, err := junSess.Command(cmd)
+	if err != nil {
+		return err
+	}
+	_, csr, found := strings.Cut(output, securityPkiCertificateRequestBegin)
+	if !found {
+		return errors.New(strings.TrimSpace(output))
+	}
+	csr, _, found = strings.Cut(csr, securityPkiCertificateRequestEnd)
+	if !found {
+		return fmt.Errorf("end of certificate request not found in output: %s", output)
+	}
+	rscData.CertificateRequestPem = types.StringValue(securityPkiCertificateRequestBegin + "\n" +
+		strings.TrimSpace(csr) + "\n" +
+		securityPkiCertificateRequestEnd + "\n")
+
+	return nil
+}
+
+// load put the certificate (and private key) in temporary files on device,
+// load them in the certificate-id and remove the temporary files.
+func (rscData *securityPkiLocalCertificateData) load(junSess *junos.Session) (err error) {
+	certFile := securityPkiLocalCertificateTmpPrefix + rscData.CertificateID.ValueString() + ".cert"
+	if err := junSess.FilePut(certFile, "0600", []byte(rscData.CertificatePem.ValueString())); err != nil {
+		return err
+	}
+	defer func() {
+		if errDel := junSess.FileDelete(certFile); errDel != nil && err == nil {
+			err = errDel
+		}
+	}()
+	cmd := "request security pki local-certificate load certificate-id " + rscData.CertificateID.ValueString() +
+		" filename " + certFile
+	if v := rscData.PrivateKeyPem.ValueString(); v != "" {
+		keyFile := securityPkiLocalCertificateTmpPrefix + rscData.CertificateID.ValueString() + ".key"
+		if err := junSess.FilePut(keyFile, "0600", []byte(v)); err != nil {
+			return err
+		}
+		defer func() {
+			if errDel := junSess.FileDelete(keyFile); errDel != nil && err == nil {
+				err = errDel
+			}
+		}()
+		cmd += " key " + keyFile
+	}
+	output, err := junSess.Command(cmd)
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(output, "loaded successfully") {
+		return errors.New(strings.TrimSpace(output))
+	}
+
+	return nil
+}
+
+// clear remove the local certificate and its key pair
+// or only the key pair if no certificate has been loaded.
+func (rscData *securityPkiLocalCertificateData) clear(certificateLoaded bool, junSess *junos.Session) error {
+	if certificateLoaded {
+		_, err := junSess.Command("clear security pki local-certificate certificate-id " +
+			rscData.CertificateID.ValueString())
+
+		return err
+	}
+	_, err := junSess.Command("clear security pki key-pair certificate-id " +
+		rscData.CertificateID.ValueString())
+
+	return err
+}
@@ -0,0 +1,43 @@
+package providerfwk_test
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJunosSecurityPkiLocalCertificate_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SRX") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosSecurityPkiLocalCertificateConfigCreate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_security_pki_local_certificate.testacc_pkiLocalCert",
+							"id", "testacc_pkiLocalCert"),
+						resource.TestMatchResourceAttr("junos_security_pki_local_certificate.testacc_pkiLocalCert",
+							"certificate_request_pem", regexp.MustCompile("^-----BEGIN CERTIFICATE REQUEST-----")),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosSecurityPkiLocalCertificateConfigCreate() string {
+	return `
+resource "junos_security_pki_local_certificate" "testacc_pkiLocalCert" {
+  certificate_id = "testacc_pkiLocalCert"
+  key_pair_type  = "rsa"
+  key_pair_size  = 2048
+  certificate_request {
+    subject     = "CN=testacc,O=Example"
+    domain_name = "testacc.example.com"
+  }
+}
+`
+}
@@ -76,7 +76,7 @@ func (rsc *securityPolicy) Configure(
 }
 
 func (rsc *securityPolicy) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -365,6 +365,7 @@ func (rsc *securityPolicy) Schema(
 					},
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -374,13 +375,15 @@ type securityPolicyData struct {
 	FromZone types.String                `tfsdk:"from_zone"`
 	ToZone   types.String                `tfsdk:"to_zone"`
 	Policy   []securityPolicyBlockPolicy `tfsdk:"policy"`
+	Timeouts resourceTimeouts            `tfsdk:"timeouts"`
 }
 
 type securityPolicyConfig struct {
-	ID       types.String `tfsdk:"id"`
-	FromZone types.String `tfsdk:"from_zone"`
-	ToZone   types.String `tfsdk:"to_zone"`
-	Policy   types.List   `tfsdk:"policy"`
+	ID       types.String     `tfsdk:"id"`
+	FromZone types.String     `tfsdk:"from_zone"`
+	ToZone   types.String     `tfsdk:"to_zone"`
+	Policy   types.List       `tfsdk:"policy"`
+	Timeouts resourceTimeouts `tfsdk:"timeouts"`
 }
 
 //nolint:lll
@@ -667,6 +670,13 @@ func (rsc *securityPolicy) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultResourceUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	if rsc.client.FakeUpdateAlso() {
 		junSess := rsc.client.NewSessionWithoutNetconf(ctx)
@@ -698,6 +708,7 @@ func (rsc *securityPolicy) Update(
 		return
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(state.ID.ValueString())
 	if err := junSess.ConfigLock(ctx); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigLockErrSummary, err.Error())
 
@@ -69,7 +69,7 @@ func (rsc *securityPolicyTunnelPairPolicy) Configure(
 }
 
 func (rsc *securityPolicyTunnelPairPolicy) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a tunnel pair policy resource options in each policy.",
@@ -129,15 +129,19 @@ func (rsc *securityPolicyTunnelPairPolicy) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
 type securityPolicyTunnelPairPolicyData struct {
-	ID         types.String `tfsdk:"id"`
-	ZoneA      types.String `tfsdk:"zone_a"`
-	ZoneB      types.String `tfsdk:"zone_b"`
-	PolicyAtoB types.String `tfsdk:"policy_a_to_b"`
-	PolicyBtoA types.String `tfsdk:"policy_b_to_a"`
+	ID         types.String     `tfsdk:"id"`
+	ZoneA      types.String     `tfsdk:"zone_a"`
+	ZoneB      types.String     `tfsdk:"zone_b"`
+	PolicyAtoB types.String     `tfsdk:"policy_a_to_b"`
+	PolicyBtoA types.String     `tfsdk:"policy_b_to_a"`
+	Timeouts   resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *securityPolicyTunnelPairPolicy) Create(
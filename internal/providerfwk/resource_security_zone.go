@@ -73,7 +73,7 @@ func (rsc *securityZone) Configure(
 }
 
 func (rsc *securityZone) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -371,6 +371,7 @@ func (rsc *securityZone) Schema(
 					},
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -394,26 +395,28 @@ type securityZoneData struct {
 	AddressBookSet                   []securityZoneBlockAddressBookSet      `tfsdk:"address_book_set"`
 	AddressBookWildcard              []securityZoneBlockAddressBookWildcard `tfsdk:"address_book_wildcard"`
 	Interface                        []securityZoneDataSourceBlockInterface `tfsdk:"-"` // to data source
+	Timeouts                         resourceTimeouts                       `tfsdk:"timeouts"`
 }
 
 type securityZoneConfig struct {
-	AddressBookConfigureSingly       types.Bool   `tfsdk:"address_book_configure_singly"`
-	ApplicationTracking              types.Bool   `tfsdk:"application_tracking"`
-	ReverseReroute                   types.Bool   `tfsdk:"reverse_reroute"`
-	SourceIdentityLog                types.Bool   `tfsdk:"source_identity_log"`
-	TCPRst                           types.Bool   `tfsdk:"tcp_rst"`
-	ID                               types.String `tfsdk:"id"`
-	Name                             types.String `tfsdk:"name"`
-	AdvancePolicyBasedRoutingProfile types.String `tfsdk:"advance_policy_based_routing_profile"`
-	Description                      types.String `tfsdk:"description"`
-	Screen                           types.String `tfsdk:"screen"`
-	InboundProtocols                 types.Set    `tfsdk:"inbound_protocols"`
-	InboundServices                  types.Set    `tfsdk:"inbound_services"`
-	AddressBook                      types.Set    `tfsdk:"address_book"`
-	AddressBookDNS                   types.Set    `tfsdk:"address_book_dns"`
-	AddressBookRange                 types.Set    `tfsdk:"address_book_range"`
-	AddressBookSet                   types.Set    `tfsdk:"address_book_set"`
-	AddressBookWildcard              types.Set    `tfsdk:"address_book_wildcard"`
+	AddressBookConfigureSingly       types.Bool       `tfsdk:"address_book_configure_singly"`
+	ApplicationTracking              types.Bool       `tfsdk:"application_tracking"`
+	ReverseReroute                   types.Bool       `tfsdk:"reverse_reroute"`
+	SourceIdentityLog                types.Bool       `tfsdk:"source_identity_log"`
+	TCPRst                           types.Bool       `tfsdk:"tcp_rst"`
+	ID                               types.String     `tfsdk:"id"`
+	Name                             types.String     `tfsdk:"name"`
+	AdvancePolicyBasedRoutingProfile types.String     `tfsdk:"advance_policy_based_routing_profile"`
+	Description                      types.String     `tfsdk:"description"`
+	Screen                           types.String     `tfsdk:"screen"`
+	InboundProtocols                 types.Set        `tfsdk:"inbound_protocols"`
+	InboundServices                  types.Set        `tfsdk:"inbound_services"`
+	AddressBook                      types.Set        `tfsdk:"address_book"`
+	AddressBookDNS                   types.Set        `tfsdk:"address_book_dns"`
+	AddressBookRange                 types.Set        `tfsdk:"address_book_range"`
+	AddressBookSet                   types.Set        `tfsdk:"address_book_set"`
+	AddressBookWildcard              types.Set        `tfsdk:"address_book_wildcard"`
+	Timeouts                         resourceTimeouts `tfsdk:"timeouts"`
 }
 
 type securityZoneBlockAddressBook struct {
@@ -725,6 +728,14 @@ func (rsc *securityZone) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultResourceUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	addressBookConfiguredSingly := plan.AddressBookConfigureSingly.ValueBool()
 	if !plan.AddressBookConfigureSingly.Equal(state.AddressBookConfigureSingly) {
 		if state.AddressBookConfigureSingly.ValueBool() {
@@ -775,6 +786,7 @@ func (rsc *securityZone) Update(
 		return
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(state.ID.ValueString())
 	if err := junSess.ConfigLock(ctx); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigLockErrSummary, err.Error())
 
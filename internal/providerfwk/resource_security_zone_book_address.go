@@ -71,7 +71,7 @@ func (rsc *securityZoneBookAddress) Configure(
 }
 
 func (rsc *securityZoneBookAddress) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides an address resource in address-book of security zone.",
@@ -164,21 +164,25 @@ func (rsc *securityZoneBookAddress) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
 type securityZoneBookAddressData struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Zone        types.String `tfsdk:"zone"`
-	CIDR        types.String `tfsdk:"cidr"`
-	Description types.String `tfsdk:"description"`
-	DNSIPv4Only types.Bool   `tfsdk:"dns_ipv4_only"`
-	DNSIPv6Only types.Bool   `tfsdk:"dns_ipv6_only"`
-	DNSName     types.String `tfsdk:"dns_name"`
-	RangeFrom   types.String `tfsdk:"range_from"`
-	RangeTo     types.String `tfsdk:"range_to"`
-	Wildcard    types.String `tfsdk:"wildcard"`
+	ID          types.String     `tfsdk:"id"`
+	Name        types.String     `tfsdk:"name"`
+	Zone        types.String     `tfsdk:"zone"`
+	CIDR        types.String     `tfsdk:"cidr"`
+	Description types.String     `tfsdk:"description"`
+	DNSIPv4Only types.Bool       `tfsdk:"dns_ipv4_only"`
+	DNSIPv6Only types.Bool       `tfsdk:"dns_ipv6_only"`
+	DNSName     types.String     `tfsdk:"dns_name"`
+	RangeFrom   types.String     `tfsdk:"range_from"`
+	RangeTo     types.String     `tfsdk:"range_to"`
+	Wildcard    types.String     `tfsdk:"wildcard"`
+	Timeouts    resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *securityZoneBookAddress) ValidateConfig(
@@ -72,7 +72,7 @@ func (rsc *securityZoneBookAddressSet) Configure(
 }
 
 func (rsc *securityZoneBookAddressSet) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides an address-set resource in address-book of security zone.",
@@ -139,25 +139,30 @@ func (rsc *securityZoneBookAddressSet) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
 type securityZoneBookAddressSetData struct {
-	ID          types.String   `tfsdk:"id"`
-	Name        types.String   `tfsdk:"name"`
-	Zone        types.String   `tfsdk:"zone"`
-	Description types.String   `tfsdk:"description"`
-	Address     []types.String `tfsdk:"address"`
-	AddressSet  []types.String `tfsdk:"address_set"`
+	ID          types.String     `tfsdk:"id"`
+	Name        types.String     `tfsdk:"name"`
+	Zone        types.String     `tfsdk:"zone"`
+	Description types.String     `tfsdk:"description"`
+	Address     []types.String   `tfsdk:"address"`
+	AddressSet  []types.String   `tfsdk:"address_set"`
+	Timeouts    resourceTimeouts `tfsdk:"timeouts"`
 }
 
 type securityZoneBookAddressSetConfig struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Zone        types.String `tfsdk:"zone"`
-	Description types.String `tfsdk:"description"`
-	Address     types.Set    `tfsdk:"address"`
-	AddressSet  types.Set    `tfsdk:"address_set"`
+	ID          types.String     `tfsdk:"id"`
+	Name        types.String     `tfsdk:"name"`
+	Zone        types.String     `tfsdk:"zone"`
+	Description types.String     `tfsdk:"description"`
+	Address     types.Set        `tfsdk:"address"`
+	AddressSet  types.Set        `tfsdk:"address_set"`
+	Timeouts    resourceTimeouts `tfsdk:"timeouts"`
 }
 
 func (rsc *securityZoneBookAddressSet) ValidateConfig(
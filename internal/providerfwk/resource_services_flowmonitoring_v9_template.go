@@ -78,7 +78,7 @@ func (rsc *servicesFlowMonitoringV9Template) Configure(
 }
 
 func (rsc *servicesFlowMonitoringV9Template) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a " + rsc.junosName() + ".",
@@ -266,6 +266,7 @@ func (rsc *servicesFlowMonitoringV9Template) Schema(
 					tfplanmodifier.BlockRemoveNull(),
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -290,6 +291,7 @@ type servicesFlowMonitoringV9TemplateData struct {
 	TemplateID                types.Int64                                       `tfsdk:"template_id"`
 	OptionRefreshRate         *servicesFlowMonitoringV9TemplateBlockRefreshRate `tfsdk:"option_refresh_rate"`
 	TemplateRefreshRate       *servicesFlowMonitoringV9TemplateBlockRefreshRate `tfsdk:"template_refresh_rate"`
+	Timeouts                  resourceTimeouts                                  `tfsdk:"timeouts"`
 }
 
 type servicesFlowMonitoringV9TemplateConfig struct {
@@ -312,6 +314,7 @@ type servicesFlowMonitoringV9TemplateConfig struct {
 	TemplateID                types.Int64                                       `tfsdk:"template_id"`
 	OptionRefreshRate         *servicesFlowMonitoringV9TemplateBlockRefreshRate `tfsdk:"option_refresh_rate"`
 	TemplateRefreshRate       *servicesFlowMonitoringV9TemplateBlockRefreshRate `tfsdk:"template_refresh_rate"`
+	Timeouts                  resourceTimeouts                                  `tfsdk:"timeouts"`
 }
 
 type servicesFlowMonitoringV9TemplateBlockRefreshRate struct {
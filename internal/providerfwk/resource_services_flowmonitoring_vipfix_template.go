@@ -79,7 +79,7 @@ func (rsc *servicesFlowMonitoringVIPFixTemplate) Configure(
 }
 
 func (rsc *servicesFlowMonitoringVIPFixTemplate) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Version:     1,
@@ -262,6 +262,7 @@ func (rsc *servicesFlowMonitoringVIPFixTemplate) Schema(
 					tfplanmodifier.BlockRemoveNull(),
 				},
 			},
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -286,6 +287,7 @@ type servicesFlowMonitoringVIPFixTemplateData struct {
 	TemplateID                types.Int64                                           `tfsdk:"template_id"`
 	OptionRefreshRate         *servicesFlowMonitoringVIPFixTemplateBlockRefreshRate `tfsdk:"option_refresh_rate"`
 	TemplateRefreshRate       *servicesFlowMonitoringVIPFixTemplateBlockRefreshRate `tfsdk:"template_refresh_rate"`
+	Timeouts                  resourceTimeouts                                      `tfsdk:"timeouts"`
 }
 
 type servicesFlowMonitoringVIPFixTemplateConfig struct {
@@ -308,6 +310,7 @@ type servicesFlowMonitoringVIPFixTemplateConfig struct {
 	TemplateID                types.Int64                                           `tfsdk:"template_id"`
 	OptionRefreshRate         *servicesFlowMonitoringVIPFixTemplateBlockRefreshRate `tfsdk:"option_refresh_rate"`
 	TemplateRefreshRate       *servicesFlowMonitoringVIPFixTemplateBlockRefreshRate `tfsdk:"template_refresh_rate"`
+	Timeouts                  resourceTimeouts                                      `tfsdk:"timeouts"`
 }
 
 type servicesFlowMonitoringVIPFixTemplateBlockRefreshRate struct {
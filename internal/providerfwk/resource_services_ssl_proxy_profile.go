@@ -0,0 +1,821 @@
+package providerfwk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfplanmodifier"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	balt "github.com/jeremmfr/go-utils/basicalter"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &servicesSSLProxyProfile{}
+	_ resource.ResourceWithConfigure      = &servicesSSLProxyProfile{}
+	_ resource.ResourceWithValidateConfig = &servicesSSLProxyProfile{}
+	_ resource.ResourceWithImportState    = &servicesSSLProxyProfile{}
+)
+
+type servicesSSLProxyProfile struct {
+	client *junos.Client
+}
+
+func newServicesSSLProxyProfileResource() resource.Resource {
+	return &servicesSSLProxyProfile{}
+}
+
+func (rsc *servicesSSLProxyProfile) typeName() string {
+	return providerName + "_services_ssl_proxy_profile"
+}
+
+func (rsc *servicesSSLProxyProfile) junosName() string {
+	return "services ssl proxy profile"
+}
+
+func (rsc *servicesSSLProxyProfile) junosClient() *junos.Client {
+	return rsc.client
+}
+
+func (rsc *servicesSSLProxyProfile) Metadata(
+	_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse,
+) {
+	resp.TypeName = rsc.typeName()
+}
+
+func (rsc *servicesSSLProxyProfile) Configure(
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedResourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	rsc.client = client
+}
+
+func (rsc *servicesSSLProxyProfile) Schema(
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a " + rsc.junosName() + ".",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the resource with format `<name>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Profile name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					tfvalidator.StringDoubleQuoteExclusion(),
+				},
+			},
+			"custom_ciphers": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Custom cipher list.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+						tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+					),
+				},
+			},
+			"enable_flow_tracing": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Enable flow tracing for the profile.",
+				Validators: []validator.Bool{
+					tfvalidator.BoolTrue(),
+				},
+			},
+			"preferred_ciphers": schema.StringAttribute{
+				Optional:    true,
+				Description: "Select preferred ciphers.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("custom", "medium", "strong", "weak"),
+				},
+			},
+			"protocol_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Protocol SSL version accepted.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+			"root_ca": schema.StringAttribute{
+				Optional:    true,
+				Description: "Root certificate for interdicting server certificates in proxy mode (forward proxy).",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+			"server_certificate": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Server certificate identifiers for server protection (reverse proxy).",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthBetween(1, 32),
+						tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+					),
+				},
+			},
+			"trusted_ca": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of trusted certificate authority profiles (or `all`).",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthBetween(1, 32),
+						tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+					),
+				},
+			},
+			"whitelist": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of global address book addresses of exempted destinations.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthBetween(1, 63),
+						tfvalidator.StringDoubleQuoteExclusion(),
+					),
+				},
+			},
+			"whitelist_url_categories": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of URL categories of exempted destinations.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthBetween(1, 63),
+						tfvalidator.StringDoubleQuoteExclusion(),
+					),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"actions": schema.SingleNestedBlock{
+				Description: "Declare actions configuration.",
+				Attributes: map[string]schema.Attribute{
+					"crl_disable": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Disable CRL validation.",
+						Validators: []validator.Bool{
+							tfvalidator.BoolTrue(),
+						},
+					},
+					"crl_if_not_present": schema.StringAttribute{
+						Optional:    true,
+						Description: "Action if CRL information is not present.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("allow", "drop"),
+						},
+					},
+					"crl_ignore_hold_instruction_code": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Ignore 'Hold Instruction Code' present in the CRL entry.",
+						Validators: []validator.Bool{
+							tfvalidator.BoolTrue(),
+						},
+					},
+					"disable_session_resumption": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Disable session resumption.",
+						Validators: []validator.Bool{
+							tfvalidator.BoolTrue(),
+						},
+					},
+					"ignore_server_auth_failure": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Ignore server authentication failure.",
+						Validators: []validator.Bool{
+							tfvalidator.BoolTrue(),
+						},
+					},
+					"log": schema.SetAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "Events to log.",
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ValueStringsAre(
+								stringvalidator.OneOf(
+									"all", "errors", "info", "sessions-allowed", "sessions-dropped",
+									"sessions-ignored", "sessions-whitelisted", "whitelist",
+								),
+							),
+						},
+					},
+					"renegotiation": schema.StringAttribute{
+						Optional:    true,
+						Description: "Renegotiation options.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("allow", "allow-secure", "drop"),
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					tfplanmodifier.BlockRemoveNull(),
+				},
+			},
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
+	}
+}
+
+type servicesSSLProxyProfileData struct {
+	ID                     types.String                         `tfsdk:"id"`
+	Name                   types.String                         `tfsdk:"name"`
+	CustomCiphers          []types.String                       `tfsdk:"custom_ciphers"`
+	EnableFlowTracing      types.Bool                           `tfsdk:"enable_flow_tracing"`
+	PreferredCiphers       types.String                         `tfsdk:"preferred_ciphers"`
+	ProtocolVersion        types.String                         `tfsdk:"protocol_version"`
+	RootCA                 types.String                         `tfsdk:"root_ca"`
+	ServerCertificate      []types.String                       `tfsdk:"server_certificate"`
+	TrustedCA              []types.String                       `tfsdk:"trusted_ca"`
+	Whitelist              []types.String                       `tfsdk:"whitelist"`
+	WhitelistURLCategories []types.String                       `tfsdk:"whitelist_url_categories"`
+	Actions                *servicesSSLProxyProfileBlockActions `tfsdk:"actions"`
+	Timeouts               resourceTimeouts                     `tfsdk:"timeouts"`
+}
+
+type servicesSSLProxyProfileConfig struct {
+	ID                     types.String                               `tfsdk:"id"`
+	Name                   types.String                               `tfsdk:"name"`
+	CustomCiphers          types.Set                                  `tfsdk:"custom_ciphers"`
+	EnableFlowTracing      types.Bool                                 `tfsdk:"enable_flow_tracing"`
+	PreferredCiphers       types.String                               `tfsdk:"preferred_ciphers"`
+	ProtocolVersion        types.String                               `tfsdk:"protocol_version"`
+	RootCA                 types.String                               `tfsdk:"root_ca"`
+	ServerCertificate      types.Set                                  `tfsdk:"server_certificate"`
+	TrustedCA              types.Set                                  `tfsdk:"trusted_ca"`
+	Whitelist              types.Set                                  `tfsdk:"whitelist"`
+	WhitelistURLCategories types.Set                                  `tfsdk:"whitelist_url_categories"`
+	Actions                *servicesSSLProxyProfileBlockActionsConfig `tfsdk:"actions"`
+	Timeouts               resourceTimeouts                           `tfsdk:"timeouts"`
+}
+
+type servicesSSLProxyProfileBlockActions struct {
+	CrlDisable                   types.Bool     `tfsdk:"crl_disable"`
+	CrlIfNotPresent              types.String   `tfsdk:"crl_if_not_present"`
+	CrlIgnoreHoldInstructionCode types.Bool     `tfsdk:"crl_ignore_hold_instruction_code"`
+	DisableSessionResumption     types.Bool     `tfsdk:"disable_session_resumption"`
+	IgnoreServerAuthFailure      types.Bool     `tfsdk:"ignore_server_auth_failure"`
+	Log                          []types.String `tfsdk:"log"`
+	Renegotiation                types.String   `tfsdk:"renegotiation"`
+}
+
+type servicesSSLProxyProfileBlockActionsConfig struct {
+	CrlDisable                   types.Bool   `tfsdk:"crl_disable"`
+	CrlIfNotPresent              types.String `tfsdk:"crl_if_not_present"`
+	CrlIgnoreHoldInstructionCode types.Bool   `tfsdk:"crl_ignore_hold_instruction_code"`
+	DisableSessionResumption     types.Bool   `tfsdk:"disable_session_resumption"`
+	IgnoreServerAuthFailure      types.Bool   `tfsdk:"ignore_server_auth_failure"`
+	Log                          types.Set    `tfsdk:"log"`
+	Renegotiation                types.String `tfsdk:"renegotiation"`
+}
+
+func (block *servicesSSLProxyProfileBlockActionsConfig) isEmpty() bool {
+	switch {
+	case !block.CrlDisable.IsNull():
+		return false
+	case !block.CrlIfNotPresent.IsNull():
+		return false
+	case !block.CrlIgnoreHoldInstructionCode.IsNull():
+		return false
+	case !block.DisableSessionResumption.IsNull():
+		return false
+	case !block.IgnoreServerAuthFailure.IsNull():
+		return false
+	case !block.Log.IsNull():
+		return false
+	case !block.Renegotiation.IsNull():
+		return false
+	default:
+		return true
+	}
+}
+
+func (rsc *servicesSSLProxyProfile) ValidateConfig(
+	ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse,
+) {
+	var config servicesSSLProxyProfileConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.RootCA.IsNull() && config.ServerCertificate.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("root_ca"),
+			tfdiag.MissingConfigErrSummary,
+			"one of root_ca or server_certificate must be specified",
+		)
+	}
+	if !config.RootCA.IsNull() && !config.ServerCertificate.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("root_ca"),
+			tfdiag.ConflictConfigErrSummary,
+			"root_ca and server_certificate cannot be configured together",
+		)
+	}
+	if config.Actions != nil {
+		if config.Actions.isEmpty() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("actions").AtName("*"),
+				tfdiag.MissingConfigErrSummary,
+				"actions block is empty",
+			)
+		}
+		if !config.Actions.CrlDisable.IsNull() &&
+			(!config.Actions.CrlIfNotPresent.IsNull() || !config.Actions.CrlIgnoreHoldInstructionCode.IsNull()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("actions").AtName("crl_disable"),
+				tfdiag.ConflictConfigErrSummary,
+				"crl_disable cannot be configured with crl_if_not_present or crl_ignore_hold_instruction_code"+
+					" in actions block",
+			)
+		}
+	}
+}
+
+func (rsc *servicesSSLProxyProfile) Create(
+	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
+) {
+	var plan servicesSSLProxyProfileData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Name.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Empty Name",
+			"could not create "+rsc.junosName()+" with empty name",
+		)
+
+		return
+	}
+
+	defaultResourceCreate(
+		ctx,
+		rsc,
+		func(fnCtx context.Context, junSess *junos.Session) bool {
+			if !junSess.CheckCompatibilitySecurity() {
+				resp.Diagnostics.AddError(
+					tfdiag.CompatibilityErrSummary,
+					rsc.junosName()+" not compatible "+
+						"with Junos device "+junSess.SystemInformation.HardwareModel,
+				)
+
+				return false
+			}
+			profileExists, err := checkServicesSSLProxyProfileExists(fnCtx, plan.Name.ValueString(), junSess)
+			if err != nil {
+				resp.Diagnostics.AddError(tfdiag.PreCheckErrSummary, err.Error())
+
+				return false
+			}
+			if profileExists {
+				resp.Diagnostics.AddError(
+					tfdiag.DuplicateConfigErrSummary,
+					fmt.Sprintf(rsc.junosName()+" %q already exists", plan.Name.ValueString()),
+				)
+
+				return false
+			}
+			errPath, err := plan.checkCertificates(fnCtx, junSess)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(errPath, tfdiag.PreCheckErrSummary, err.Error())
+
+				return false
+			}
+
+			return true
+		},
+		func(fnCtx context.Context, junSess *junos.Session) bool {
+			profileExists, err := checkServicesSSLProxyProfileExists(fnCtx, plan.Name.ValueString(), junSess)
+			if err != nil {
+				resp.Diagnostics.AddError(tfdiag.PostCheckErrSummary, err.Error())
+
+				return false
+			}
+			if !profileExists {
+				resp.Diagnostics.AddError(
+					tfdiag.NotFoundErrSummary,
+					fmt.Sprintf(rsc.junosName()+" %q does not exists after commit "+
+						"=> check your config", plan.Name.ValueString()),
+				)
+
+				return false
+			}
+
+			return true
+		},
+		&plan,
+		resp,
+	)
+}
+
+func (rsc *servicesSSLProxyProfile) Read(
+	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse,
+) {
+	var state, data servicesSSLProxyProfileData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var _ resourceDataReadFrom1String = &data
+	defaultResourceRead(
+		ctx,
+		rsc,
+		[]string{
+			state.Name.ValueString(),
+		},
+		&data,
+		nil,
+		resp,
+	)
+}
+
+func (rsc *servicesSSLProxyProfile) Update(
+	ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
+) {
+	var plan, state servicesSSLProxyProfileData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultResourceUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if rsc.client.FakeUpdateAlso() {
+		junSess := rsc.client.NewSessionWithoutNetconf(ctx)
+
+		if err := state.del(ctx, junSess); err != nil {
+			resp.Diagnostics.AddError(tfdiag.ConfigDelErrSummary, err.Error())
+
+			return
+		}
+		if errPath, err := plan.set(ctx, junSess); err != nil {
+			if !errPath.Equal(path.Empty()) {
+				resp.Diagnostics.AddAttributeError(errPath, tfdiag.ConfigSetErrSummary, err.Error())
+			} else {
+				resp.Diagnostics.AddError(tfdiag.ConfigSetErrSummary, err.Error())
+			}
+
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+		return
+	}
+
+	junSess, err := rsc.client.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+	junSess.SetResourceID(state.ID.ValueString())
+	if err := junSess.ConfigLock(ctx); err != nil {
+		resp.Diagnostics.AddError(tfdiag.ConfigLockErrSummary, err.Error())
+
+		return
+	}
+	defer func() {
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigClearUnlockWarnSummary, junSess.ConfigClear())...)
+	}()
+
+	if errPath, err := plan.checkCertificates(ctx, junSess); err != nil {
+		resp.Diagnostics.AddAttributeError(errPath, tfdiag.PreCheckErrSummary, err.Error())
+
+		return
+	}
+	if err := state.del(ctx, junSess); err != nil {
+		resp.Diagnostics.AddError(tfdiag.ConfigDelErrSummary, err.Error())
+
+		return
+	}
+	if errPath, err := plan.set(ctx, junSess); err != nil {
+		if !errPath.Equal(path.Empty()) {
+			resp.Diagnostics.AddAttributeError(errPath, tfdiag.ConfigSetErrSummary, err.Error())
+		} else {
+			resp.Diagnostics.AddError(tfdiag.ConfigSetErrSummary, err.Error())
+		}
+
+		return
+	}
+	warns, err := junSess.CommitConf("update resource " + rsc.typeName())
+	resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigCommitWarnSummary, warns)...)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ConfigCommitErrSummary, err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (rsc *servicesSSLProxyProfile) Delete(
+	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
+) {
+	var state servicesSSLProxyProfileData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceDelete(
+		ctx,
+		rsc,
+		&state,
+		resp,
+	)
+}
+
+func (rsc *servicesSSLProxyProfile) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
+) {
+	var data servicesSSLProxyProfileData
+
+	var _ resourceDataReadFrom1String = &data
+	defaultResourceImportState(
+		ctx,
+		rsc,
+		&data,
+		req,
+		resp,
+		fmt.Sprintf("don't find "+rsc.junosName()+" with id %q "+
+			"(id must be <name>)", req.ID),
+	)
+}
+
+func checkServicesSSLProxyProfileExists(
+	_ context.Context, name string, junSess *junos.Session,
+) (
+	bool, error,
+) {
+	showConfig, err := junSess.Command(junos.CmdShowConfig +
+		"services ssl proxy profile \"" + name + "\"" + junos.PipeDisplaySet)
+	if err != nil {
+		return false, err
+	}
+	if showConfig == junos.EmptyW {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// checkCertificates check that the local certificates and CA profiles referenced
+// exist on device.
+func (rscData *servicesSSLProxyProfileData) checkCertificates(
+	ctx context.Context, junSess *junos.Session,
+) (
+	path.Path, error,
+) {
+	if v := rscData.RootCA.ValueString(); v != "" {
+		certExists, err := checkSecurityPkiLocalCertificateExists(ctx, v, junSess)
+		if err != nil {
+			return path.Root("root_ca"), err
+		}
+		if !certExists {
+			return path.Root("root_ca"), fmt.Errorf("local certificate %q doesn't exist on device", v)
+		}
+	}
+	for _, v := range rscData.ServerCertificate {
+		certExists, err := checkSecurityPkiLocalCertificateExists(ctx, v.ValueString(), junSess)
+		if err != nil {
+			return path.Root("server_certificate"), err
+		}
+		if !certExists {
+			return path.Root("server_certificate"),
+				fmt.Errorf("local certificate %q doesn't exist on device", v.ValueString())
+		}
+	}
+	for _, v := range rscData.TrustedCA {
+		if v.ValueString() == "all" {
+			continue
+		}
+		profileExists, err := checkSecurityPkiCaProfileExists(ctx, v.ValueString(), junSess)
+		if err != nil {
+			return path.Root("trusted_ca"), err
+		}
+		if !profileExists {
+			return path.Root("trusted_ca"),
+				fmt.Errorf("security pki ca-profile %q doesn't exist", v.ValueString())
+		}
+	}
+
+	return path.Empty(), nil
+}
+
+func (rscData *servicesSSLProxyProfileData) fillID() {
+	rscData.ID = types.StringValue(rscData.Name.ValueString())
+}
+
+func (rscData *servicesSSLProxyProfileData) nullID() bool {
+	return rscData.ID.IsNull()
+}
+
+func (rscData *servicesSSLProxyProfileData) set(
+	_ context.Context, junSess *junos.Session,
+) (
+	path.Path, error,
+) {
+	configSet := make([]string, 0)
+	setPrefix := "set services ssl proxy profile \"" + rscData.Name.ValueString() + "\" "
+
+	configSet = append(configSet, setPrefix)
+	if rscData.Actions != nil {
+		configSet = append(configSet, rscData.Actions.configSet(setPrefix)...)
+	}
+	for _, v := range rscData.CustomCiphers {
+		configSet = append(configSet, setPrefix+"custom-ciphers "+v.ValueString())
+	}
+	if rscData.EnableFlowTracing.ValueBool() {
+		configSet = append(configSet, setPrefix+"enable-flow-tracing")
+	}
+	if v := rscData.PreferredCiphers.ValueString(); v != "" {
+		configSet = append(configSet, setPrefix+"preferred-ciphers "+v)
+	}
+	if v := rscData.ProtocolVersion.ValueString(); v != "" {
+		configSet = append(configSet, setPrefix+"protocol-version "+v)
+	}
+	if v := rscData.RootCA.ValueString(); v != "" {
+		configSet = append(configSet, setPrefix+"root-ca "+v)
+	}
+	for _, v := range rscData.ServerCertificate {
+		configSet = append(configSet, setPrefix+"server-certificate "+v.ValueString())
+	}
+	for _, v := range rscData.TrustedCA {
+		configSet = append(configSet, setPrefix+"trusted-ca "+v.ValueString())
+	}
+	for _, v := range rscData.Whitelist {
+		configSet = append(configSet, setPrefix+"whitelist \""+v.ValueString()+"\"")
+	}
+	for _, v := range rscData.WhitelistURLCategories {
+		configSet = append(configSet, setPrefix+"whitelist-url-categories \""+v.ValueString()+"\"")
+	}
+
+	return path.Empty(), junSess.ConfigSet(configSet)
+}
+
+func (block *servicesSSLProxyProfileBlockActions) configSet(setPrefix string) []string {
+	setPrefix += "actions "
+
+	configSet := []string{
+		setPrefix,
+	}
+	if block.CrlDisable.ValueBool() {
+		configSet = append(configSet, setPrefix+"crl disable")
+	}
+	if v := block.CrlIfNotPresent.ValueString(); v != "" {
+		configSet = append(configSet, setPrefix+"crl if-not-present "+v)
+	}
+	if block.CrlIgnoreHoldInstructionCode.ValueBool() {
+		configSet = append(configSet, setPrefix+"crl ignore-hold-instruction-code")
+	}
+	if block.DisableSessionResumption.ValueBool() {
+		configSet = append(configSet, setPrefix+"disable-session-resumption")
+	}
+	if block.IgnoreServerAuthFailure.ValueBool() {
+		configSet = append(configSet, setPrefix+"ignore-server-auth-failure")
+	}
+	for _, v := range block.Log {
+		configSet = append(configSet, setPrefix+"log "+v.ValueString())
+	}
+	if v := block.Renegotiation.ValueString(); v != "" {
+		configSet = append(configSet, setPrefix+"renegotiation "+v)
+	}
+
+	return configSet
+}
+
+func (rscData *servicesSSLProxyProfileData) read(
+	_ context.Context, name string, junSess *junos.Session,
+) (
+	err error,
+) {
+	showConfig, err := junSess.Command(junos.CmdShowConfig +
+		"services ssl proxy profile \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	if err != nil {
+		return err
+	}
+	if showConfig != junos.EmptyW {
+		rscData.Name = types.StringValue(name)
+		rscData.fillID()
+		for _, item := range strings.Split(showConfig, "\n") {
+			if strings.Contains(item, junos.XMLStartTagConfigOut) {
+				continue
+			}
+			if strings.Contains(item, junos.XMLEndTagConfigOut) {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, junos.SetLS)
+			switch {
+			case balt.CutPrefixInString(&itemTrim, "actions"):
+				if rscData.Actions == nil {
+					rscData.Actions = &servicesSSLProxyProfileBlockActions{}
+				}
+				if balt.CutPrefixInString(&itemTrim, " ") {
+					rscData.Actions.read(itemTrim)
+				}
+			case balt.CutPrefixInString(&itemTrim, "custom-ciphers "):
+				rscData.CustomCiphers = append(rscData.CustomCiphers, types.StringValue(itemTrim))
+			case itemTrim == "enable-flow-tracing":
+				rscData.EnableFlowTracing = types.BoolValue(true)
+			case balt.CutPrefixInString(&itemTrim, "preferred-ciphers "):
+				rscData.PreferredCiphers = types.StringValue(itemTrim)
+			case balt.CutPrefixInString(&itemTrim, "protocol-version "):
+				rscData.ProtocolVersion = types.StringValue(itemTrim)
+			case balt.CutPrefixInString(&itemTrim, "root-ca "):
+				rscData.RootCA = types.StringValue(itemTrim)
+			case balt.CutPrefixInString(&itemTrim, "server-certificate "):
+				rscData.ServerCertificate = append(rscData.ServerCertificate, types.StringValue(itemTrim))
+			case balt.CutPrefixInString(&itemTrim, "trusted-ca "):
+				rscData.TrustedCA = append(rscData.TrustedCA, types.StringValue(itemTrim))
+			case balt.CutPrefixInString(&itemTrim, "whitelist "):
+				rscData.Whitelist = append(rscData.Whitelist, types.StringValue(strings.Trim(itemTrim, "\"")))
+			case balt.CutPrefixInString(&itemTrim, "whitelist-url-categories "):
+				rscData.WhitelistURLCategories = append(rscData.WhitelistURLCategories,
+					types.StringValue(strings.Trim(itemTrim, "\"")))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (block *servicesSSLProxyProfileBlockActions) read(itemTrim string) {
+	switch {
+	case itemTrim == "crl disable":
+		block.CrlDisable = types.BoolValue(true)
+	case balt.CutPrefixInString(&itemTrim, "crl if-not-present "):
+		block.CrlIfNotPresent = types.StringValue(itemTrim)
+	case itemTrim == "crl ignore-hold-instruction-code":
+		block.CrlIgnoreHoldInstructionCode = types.BoolValue(true)
+	case itemTrim == "disable-session-resumption":
+		block.DisableSessionResumption = types.BoolValue(true)
+	case itemTrim == "ignore-server-auth-failure":
+		block.IgnoreServerAuthFailure = types.BoolValue(true)
+	case balt.CutPrefixInString(&itemTrim, "log "):
+		block.Log = append(block.Log, types.StringValue(itemTrim))
+	case balt.CutPrefixInString(&itemTrim, "renegotiation "):
+		block.Renegotiation = types.StringValue(itemTrim)
+	}
+}
+
+func (rscData *servicesSSLProxyProfileData) del(
+	_ context.Context, junSess *junos.Session,
+) error {
+	configSet := []string{
+		"delete services ssl proxy profile \"" + rscData.Name.ValueString() + "\"",
+	}
+
+	return junSess.ConfigSet(configSet)
+}
@@ -0,0 +1,124 @@
+package providerfwk_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJunosServicesSSLProxyProfile_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SRX") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			ExternalProviders: map[string]resource.ExternalProvider{
+				"tls": {},
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosServicesSSLProxyProfileConfigCreate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_services_ssl_proxy_profile.testacc_sslProxy",
+							"root_ca", "testacc_sslProxy"),
+						resource.TestCheckResourceAttr("junos_services_ssl_proxy_profile.testacc_sslProxy",
+							"trusted_ca.#", "1"),
+					),
+				},
+				{
+					Config: testAccJunosServicesSSLProxyProfileConfigUpdate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_services_ssl_proxy_profile.testacc_sslProxy",
+							"actions.ignore_server_auth_failure", "true"),
+						resource.TestCheckResourceAttr("junos_services_ssl_proxy_profile.testacc_sslProxy",
+							"actions.log.#", "2"),
+						resource.TestCheckResourceAttr("junos_services_ssl_proxy_profile.testacc_sslProxy",
+							"whitelist.#", "1"),
+					),
+				},
+				{
+					ResourceName:      "junos_services_ssl_proxy_profile.testacc_sslProxy",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosServicesSSLProxyProfileConfigCreate() string {
+	return `
+resource "tls_private_key" "testacc_sslProxy" {
+  algorithm = "RSA"
+  rsa_bits  = 2048
+}
+resource "tls_self_signed_cert" "testacc_sslProxy" {
+  private_key_pem = tls_private_key.testacc_sslProxy.private_key_pem
+  subject {
+    common_name = "testacc_sslProxy"
+  }
+  is_ca_certificate     = true
+  validity_period_hours = 24
+  allowed_uses          = ["cert_signing", "digital_signature", "key_encipherment"]
+}
+resource "junos_security_pki_local_certificate" "testacc_sslProxy" {
+  certificate_id  = "testacc_sslProxy"
+  private_key_pem = tls_private_key.testacc_sslProxy.private_key_pem
+  certificate_pem = tls_self_signed_cert.testacc_sslProxy.cert_pem
+}
+resource "junos_services_ssl_proxy_profile" "testacc_sslProxy" {
+  name       = "testacc_sslProxy"
+  root_ca    = "testacc_sslProxy"
+  trusted_ca = ["all"]
+  depends_on = [
+    junos_security_pki_local_certificate.testacc_sslProxy,
+  ]
+}
+`
+}
+
+func testAccJunosServicesSSLProxyProfileConfigUpdate() string {
+	return `
+resource "tls_private_key" "testacc_sslProxy" {
+  algorithm = "RSA"
+  rsa_bits  = 2048
+}
+resource "tls_self_signed_cert" "testacc_sslProxy" {
+  private_key_pem = tls_private_key.testacc_sslProxy.private_key_pem
+  subject {
+    common_name = "testacc_sslProxy"
+  }
+  is_ca_certificate     = true
+  validity_period_hours = 24
+  allowed_uses          = ["cert_signing", "digital_signature", "key_encipherment"]
+}
+resource "junos_security_pki_local_certificate" "testacc_sslProxy" {
+  certificate_id  = "testacc_sslProxy"
+  private_key_pem = tls_private_key.testacc_sslProxy.private_key_pem
+  certificate_pem = tls_self_signed_cert.testacc_sslProxy.cert_pem
+}
+resource "junos_security_address_book" "testacc_sslProxy" {
+  network_address {
+    name  = "testacc_sslProxy"
+    value = "192.0.2.0/25"
+  }
+}
+resource "junos_services_ssl_proxy_profile" "testacc_sslProxy" {
+  name                = "testacc_sslProxy"
+  root_ca             = "testacc_sslProxy"
+  trusted_ca          = ["all"]
+  enable_flow_tracing = true
+  preferred_ciphers   = "strong"
+  whitelist           = ["testacc_sslProxy"]
+  actions {
+    ignore_server_auth_failure = true
+    log                        = ["all", "errors"]
+    renegotiation              = "allow-secure"
+  }
+  depends_on = [
+    junos_security_pki_local_certificate.testacc_sslProxy,
+    junos_security_address_book.testacc_sslProxy,
+  ]
+}
+`
+}
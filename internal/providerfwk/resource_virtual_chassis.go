@@ -0,0 +1,517 @@
+package providerfwk
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdata"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	balt "github.com/jeremmfr/go-utils/basicalter"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &virtualChassis{}
+	_ resource.ResourceWithConfigure      = &virtualChassis{}
+	_ resource.ResourceWithValidateConfig = &virtualChassis{}
+	_ resource.ResourceWithImportState    = &virtualChassis{}
+)
+
+type virtualChassis struct {
+	client *junos.Client
+}
+
+func newVirtualChassisResource() resource.Resource {
+	return &virtualChassis{}
+}
+
+func (rsc *virtualChassis) typeName() string {
+	return providerName + "_virtual_chassis"
+}
+
+func (rsc *virtualChassis) junosName() string {
+	return "virtual-chassis"
+}
+
+func (rsc *virtualChassis) junosClient() *junos.Client {
+	return rsc.client
+}
+
+func (rsc *virtualChassis) Metadata(
+	_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse,
+) {
+	resp.TypeName = rsc.typeName()
+}
+
+func (rsc *virtualChassis) Configure(
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedResourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	rsc.client = client
+}
+
+func (rsc *virtualChassis) Schema(
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Configure static configuration in `" + rsc.junosName() + "` block",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the resource with value `virtual_chassis`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_sw_update": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Auto software update.",
+				Validators: []validator.Bool{
+					tfvalidator.BoolTrue(),
+				},
+			},
+			"auto_sw_update_package_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL or pathname of software package for auto software update.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					tfvalidator.StringDoubleQuoteExclusion(),
+				},
+			},
+			"mac_persistence_timer": schema.StringAttribute{
+				Optional:    true,
+				Description: "MAC persistence time (minutes) or `disable`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(\d+|disable)$`),
+						"must be a number of minutes or 'disable'"),
+				},
+			},
+			"no_split_detection": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Disable split detection.",
+				Validators: []validator.Bool{
+					tfvalidator.BoolTrue(),
+				},
+			},
+			"preprovisioned": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only accept preprovisioned members.",
+				Validators: []validator.Bool{
+					tfvalidator.BoolTrue(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"member": schema.ListNestedBlock{
+				Description: "For each member of virtual chassis.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Required:    true,
+							Description: "Member identifier.",
+							Validators: []validator.Int64{
+								int64validator.Between(0, 9),
+							},
+						},
+						"location": schema.StringAttribute{
+							Optional:    true,
+							Description: "Member's location.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+								tfvalidator.StringDoubleQuoteExclusion(),
+							},
+						},
+						"mastership_priority": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Member's mastership priority.",
+							Validators: []validator.Int64{
+								int64validator.Between(0, 255),
+							},
+						},
+						"no_management_vlan": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Disable management VLAN.",
+							Validators: []validator.Bool{
+								tfvalidator.BoolTrue(),
+							},
+						},
+						"role": schema.StringAttribute{
+							Optional:    true,
+							Description: "Member's role.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("line-card", "routing-engine"),
+							},
+						},
+						"serial_number": schema.StringAttribute{
+							Optional:    true,
+							Description: "Member's serial number.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+								tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
+	}
+}
+
+type virtualChassisData struct {
+	ID                      types.String                `tfsdk:"id"`
+	AutoSwUpdate            types.Bool                  `tfsdk:"auto_sw_update"`
+	AutoSwUpdatePackageName types.String                `tfsdk:"auto_sw_update_package_name"`
+	MacPersistenceTimer     types.String                `tfsdk:"mac_persistence_timer"`
+	NoSplitDetection        types.Bool                  `tfsdk:"no_split_detection"`
+	Preprovisioned          types.Bool                  `tfsdk:"preprovisioned"`
+	Member                  []virtualChassisBlockMember `tfsdk:"member"`
+	Timeouts                resourceTimeouts            `tfsdk:"timeouts"`
+}
+
+type virtualChassisConfig struct {
+	ID                      types.String     `tfsdk:"id"`
+	AutoSwUpdate            types.Bool       `tfsdk:"auto_sw_update"`
+	AutoSwUpdatePackageName types.String     `tfsdk:"auto_sw_update_package_name"`
+	MacPersistenceTimer     types.String     `tfsdk:"mac_persistence_timer"`
+	NoSplitDetection        types.Bool       `tfsdk:"no_split_detection"`
+	Preprovisioned          types.Bool       `tfsdk:"preprovisioned"`
+	Member                  types.List       `tfsdk:"member"`
+	Timeouts                resourceTimeouts `tfsdk:"timeouts"`
+}
+
+type virtualChassisBlockMember struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	Location           types.String `tfsdk:"location"`
+	MastershipPriority types.Int64  `tfsdk:"mastership_priority"`
+	NoManagementVlan   types.Bool   `tfsdk:"no_management_vlan"`
+	Role               types.String `tfsdk:"role"`
+	SerialNumber       types.String `tfsdk:"serial_number"`
+}
+
+func (rsc *virtualChassis) ValidateConfig(
+	ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse,
+) {
+	var config virtualChassisConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.AutoSwUpdatePackageName.IsNull() && config.AutoSwUpdate.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auto_sw_update_package_name"),
+			tfdiag.MissingConfigErrSummary,
+			"auto_sw_update must be specified with auto_sw_update_package_name",
+		)
+	}
+
+	if !config.Member.IsNull() && !config.Member.IsUnknown() {
+		var configMember []virtualChassisBlockMember
+		asDiags := config.Member.ElementsAs(ctx, &configMember, false)
+		if asDiags.HasError() {
+			resp.Diagnostics.Append(asDiags...)
+
+			return
+		}
+		memberID := make(map[int64]struct{})
+		for i, block := range configMember {
+			if !block.ID.IsUnknown() {
+				if _, ok := memberID[block.ID.ValueInt64()]; ok {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("member").AtListIndex(i).AtName("id"),
+						tfdiag.DuplicateConfigErrSummary,
+						fmt.Sprintf("multiple member blocks with the same id %d", block.ID.ValueInt64()),
+					)
+				}
+				memberID[block.ID.ValueInt64()] = struct{}{}
+			}
+			if config.Preprovisioned.IsUnknown() {
+				continue
+			}
+			if config.Preprovisioned.ValueBool() {
+				if block.Role.IsNull() {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("member").AtListIndex(i).AtName("role"),
+						tfdiag.MissingConfigErrSummary,
+						fmt.Sprintf("role must be specified in member block %d when preprovisioned = true",
+							block.ID.ValueInt64()),
+					)
+				}
+				if block.SerialNumber.IsNull() {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("member").AtListIndex(i).AtName("serial_number"),
+						tfdiag.MissingConfigErrSummary,
+						fmt.Sprintf("serial_number must be specified in member block %d when preprovisioned = true",
+							block.ID.ValueInt64()),
+					)
+				}
+				if !block.MastershipPriority.IsNull() {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("member").AtListIndex(i).AtName("mastership_priority"),
+						tfdiag.ConflictConfigErrSummary,
+						fmt.Sprintf("mastership_priority cannot be configured in member block %d when preprovisioned = true",
+							block.ID.ValueInt64()),
+					)
+				}
+			} else if !block.Role.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("member").AtListIndex(i).AtName("role"),
+					tfdiag.MissingConfigErrSummary,
+					fmt.Sprintf("preprovisioned must be specified to configure role in member block %d",
+						block.ID.ValueInt64()),
+				)
+			}
+		}
+	}
+}
+
+func (rsc *virtualChassis) Create(
+	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
+) {
+	var plan virtualChassisData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceCreate(
+		ctx,
+		rsc,
+		nil,
+		nil,
+		&plan,
+		resp,
+	)
+}
+
+func (rsc *virtualChassis) Read(
+	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse,
+) {
+	var data virtualChassisData
+
+	var _ resourceDataReadFrom0String = &data
+	defaultResourceRead(
+		ctx,
+		rsc,
+		nil,
+		&data,
+		nil,
+		resp,
+	)
+}
+
+func (rsc *virtualChassis) Update(
+	ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
+) {
+	var plan, state virtualChassisData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceUpdate(
+		ctx,
+		rsc,
+		&state,
+		&plan,
+		resp,
+	)
+}
+
+func (rsc *virtualChassis) Delete(
+	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
+) {
+	var state virtualChassisData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceDelete(
+		ctx,
+		rsc,
+		&state,
+		resp,
+	)
+}
+
+func (rsc *virtualChassis) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
+) {
+	var data virtualChassisData
+
+	var _ resourceDataReadFrom0String = &data
+	defaultResourceImportState(
+		ctx,
+		rsc,
+		&data,
+		req,
+		resp,
+		"",
+	)
+}
+
+func (rscData *virtualChassisData) fillID() {
+	rscData.ID = types.StringValue("virtual_chassis")
+}
+
+func (rscData *virtualChassisData) nullID() bool {
+	return rscData.ID.IsNull()
+}
+
+func (rscData *virtualChassisData) set(
+	_ context.Context, junSess *junos.Session,
+) (
+	path.Path, error,
+) {
+	setPrefix := "set virtual-chassis "
+	configSet := make([]string, 0)
+
+	if rscData.AutoSwUpdate.ValueBool() {
+		configSet = append(configSet, setPrefix+"auto-sw-update")
+		if v := rscData.AutoSwUpdatePackageName.ValueString(); v != "" {
+			configSet = append(configSet, setPrefix+"auto-sw-update package-name \""+v+"\"")
+		}
+	}
+	if v := rscData.MacPersistenceTimer.ValueString(); v != "" {
+		configSet = append(configSet, setPrefix+"mac-persistence-timer "+v)
+	}
+	if rscData.NoSplitDetection.ValueBool() {
+		configSet = append(configSet, setPrefix+"no-split-detection")
+	}
+	if rscData.Preprovisioned.ValueBool() {
+		configSet = append(configSet, setPrefix+"preprovisioned")
+	}
+	memberID := make(map[int64]struct{})
+	for i, block := range rscData.Member {
+		if _, ok := memberID[block.ID.ValueInt64()]; ok {
+			return path.Root("member").AtListIndex(i).AtName("id"),
+				fmt.Errorf("multiple member blocks with the same id %d", block.ID.ValueInt64())
+		}
+		memberID[block.ID.ValueInt64()] = struct{}{}
+
+		setPrefixMember := setPrefix + "member " + utils.ConvI64toa(block.ID.ValueInt64())
+		configSet = append(configSet, setPrefixMember)
+		setPrefixMember += " "
+		if v := block.Location.ValueString(); v != "" {
+			configSet = append(configSet, setPrefixMember+"location \""+v+"\"")
+		}
+		if !block.MastershipPriority.IsNull() {
+			configSet = append(configSet, setPrefixMember+"mastership-priority "+
+				utils.ConvI64toa(block.MastershipPriority.ValueInt64()))
+		}
+		if block.NoManagementVlan.ValueBool() {
+			configSet = append(configSet, setPrefixMember+"no-management-vlan")
+		}
+		if v := block.Role.ValueString(); v != "" {
+			configSet = append(configSet, setPrefixMember+"role "+v)
+		}
+		if v := block.SerialNumber.ValueString(); v != "" {
+			configSet = append(configSet, setPrefixMember+"serial-number "+v)
+		}
+	}
+
+	return path.Empty(), junSess.ConfigSet(configSet)
+}
+
+func (rscData *virtualChassisData) read(
+	_ context.Context, junSess *junos.Session,
+) (
+	err error,
+) {
+	showConfig, err := junSess.Command(junos.CmdShowConfig + "virtual-chassis" + junos.PipeDisplaySetRelative)
+	if err != nil {
+		return err
+	}
+	rscData.fillID()
+	if showConfig != junos.EmptyW {
+		for _, item := range strings.Split(showConfig, "\n") {
+			if strings.Contains(item, junos.XMLStartTagConfigOut) {
+				continue
+			}
+			if strings.Contains(item, junos.XMLEndTagConfigOut) {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, junos.SetLS)
+			switch {
+			case itemTrim == "auto-sw-update":
+				rscData.AutoSwUpdate = types.BoolValue(true)
+			case balt.CutPrefixInString(&itemTrim, "auto-sw-update package-name "):
+				rscData.AutoSwUpdate = types.BoolValue(true)
+				rscData.AutoSwUpdatePackageName = types.StringValue(strings.Trim(itemTrim, "\""))
+			case balt.CutPrefixInString(&itemTrim, "mac-persistence-timer "):
+				rscData.MacPersistenceTimer = types.StringValue(itemTrim)
+			case itemTrim == "no-split-detection":
+				rscData.NoSplitDetection = types.BoolValue(true)
+			case itemTrim == "preprovisioned":
+				rscData.Preprovisioned = types.BoolValue(true)
+			case balt.CutPrefixInString(&itemTrim, "member "):
+				itemTrimFields := strings.Split(itemTrim, " ")
+				id, err := tfdata.ConvAtoi64Value(itemTrimFields[0])
+				if err != nil {
+					return err
+				}
+				var member virtualChassisBlockMember
+				rscData.Member, member = tfdata.ExtractBlockWithTFTypesInt64(
+					rscData.Member, "ID", id.ValueInt64(),
+				)
+				member.ID = id
+				balt.CutPrefixInString(&itemTrim, itemTrimFields[0]+" ")
+				switch {
+				case balt.CutPrefixInString(&itemTrim, "location "):
+					member.Location = types.StringValue(strings.Trim(itemTrim, "\""))
+				case balt.CutPrefixInString(&itemTrim, "mastership-priority "):
+					member.MastershipPriority, err = tfdata.ConvAtoi64Value(itemTrim)
+					if err != nil {
+						return err
+					}
+				case itemTrim == "no-management-vlan":
+					member.NoManagementVlan = types.BoolValue(true)
+				case balt.CutPrefixInString(&itemTrim, "role "):
+					member.Role = types.StringValue(itemTrim)
+				case balt.CutPrefixInString(&itemTrim, "serial-number "):
+					member.SerialNumber = types.StringValue(itemTrim)
+				}
+				rscData.Member = append(rscData.Member, member)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (rscData *virtualChassisData) del(
+	_ context.Context, junSess *junos.Session,
+) error {
+	configSet := []string{
+		"delete virtual-chassis",
+	}
+
+	return junSess.ConfigSet(configSet)
+}
@@ -0,0 +1,79 @@
+package providerfwk_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJunosVirtualChassis_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosVirtualChassisConfigCreate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_virtual_chassis.testacc_vc",
+							"member.#", "2"),
+						resource.TestCheckResourceAttr("junos_virtual_chassis.testacc_vc",
+							"member.0.mastership_priority", "255"),
+					),
+				},
+				{
+					Config: testAccJunosVirtualChassisConfigUpdate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_virtual_chassis.testacc_vc",
+							"member.1.role", "line-card"),
+					),
+				},
+				{
+					ResourceName:      "junos_virtual_chassis.testacc_vc",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosVirtualChassisConfigCreate() string {
+	return `
+resource "junos_virtual_chassis" "testacc_vc" {
+  no_split_detection    = true
+  mac_persistence_timer = "20"
+  member {
+    id                  = 0
+    mastership_priority = 255
+    location            = "rack 1"
+  }
+  member {
+    id                  = 1
+    mastership_priority = 200
+    no_management_vlan  = true
+  }
+}
+`
+}
+
+func testAccJunosVirtualChassisConfigUpdate() string {
+	return `
+resource "junos_virtual_chassis" "testacc_vc" {
+  preprovisioned        = true
+  auto_sw_update        = true
+  mac_persistence_timer = "disable"
+  member {
+    id            = 0
+    role          = "routing-engine"
+    serial_number = "AB0123456789"
+  }
+  member {
+    id            = 1
+    role          = "line-card"
+    serial_number = "AB0123456790"
+  }
+}
+`
+}
@@ -34,6 +34,58 @@ func dataSourceSystemInformation() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"re_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"multi_routing_engine": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"multi_node": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"software": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"re_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"model": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"junos_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"package": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"comment": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -45,6 +97,9 @@ func dataSourceSystemInformationRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	if err := junSess.GatherSoftwareFacts(); err != nil {
+		return diag.FromErr(err)
+	}
 
 	// Catches case where hostname is not set
 	if junSess.SystemInformation.HostName != "" {
@@ -77,6 +132,35 @@ func dataSourceSystemInformationRead(ctx context.Context, d *schema.ResourceData
 			panic(tfErr)
 		}
 	}
+	if tfErr := d.Set("re_name", junSess.SystemInformation.REName); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("multi_routing_engine", junSess.SystemInformation.MultiRoutingEngine); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("multi_node", junSess.SystemInformation.MultiNode); tfErr != nil {
+		panic(tfErr)
+	}
+	software := make([]map[string]interface{}, 0, len(junSess.SystemInformation.Software))
+	for _, v := range junSess.SystemInformation.Software {
+		packages := make([]map[string]interface{}, 0, len(v.Packages))
+		for _, pkg := range v.Packages {
+			packages = append(packages, map[string]interface{}{
+				"name":    pkg.Name,
+				"comment": pkg.Comment,
+			})
+		}
+		software = append(software, map[string]interface{}{
+			"re_name":       v.REName,
+			"host_name":     v.HostName,
+			"model":         v.ProductModel,
+			"junos_version": v.JunosVersion,
+			"package":       packages,
+		})
+	}
+	if tfErr := d.Set("software", software); tfErr != nil {
+		panic(tfErr)
+	}
 
 	return nil
 }
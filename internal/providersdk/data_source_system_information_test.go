@@ -19,6 +19,9 @@ func TestAccDataSourceSystemInformation_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.junos_system_information.test", "os_version"),
 					resource.TestCheckResourceAttrSet("data.junos_system_information.test", "serial_number"),
 					resource.TestCheckResourceAttrSet("data.junos_system_information.test", "cluster_node"),
+					resource.TestCheckResourceAttrSet("data.junos_system_information.test", "multi_routing_engine"),
+					resource.TestCheckResourceAttrSet("data.junos_system_information.test", "multi_node"),
+					resource.TestCheckResourceAttrSet("data.junos_system_information.test", "software.0.junos_version"),
 				),
 			},
 		},
@@ -29,6 +29,21 @@ func appendDiagWarns(diags *diag.Diagnostics, warns []error) {
 	}
 }
 
+// resourceJournalID returns the ID of resource for the audit journal:
+// the ID if already set, otherwise the name of resource (when it's created).
+func resourceJournalID(d *schema.ResourceData) string {
+	if id := d.Id(); id != "" {
+		return id
+	}
+	if v, ok := d.GetOk("name"); ok {
+		if name, ok := v.(string); ok {
+			return name
+		}
+	}
+
+	return ""
+}
+
 func validateIPMaskFunc() schema.SchemaValidateDiagFunc {
 	return func(i interface{}, path cty.Path) diag.Diagnostics {
 		var diags diag.Diagnostics
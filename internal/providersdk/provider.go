@@ -62,12 +62,60 @@ func Provider() *schema.Provider {
 				Description: "This is the passphrase for open `sshkeyfile` or `sshkey_pem`." +
 					" May also be provided via " + junos.EnvKeyPass + " environment variable.",
 			},
+			"sshcert_pem": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "This is the OpenSSH certificate paired with the ssh key for establish ssh connection." +
+					" May also be provided via " + junos.EnvCertPem + " environment variable.",
+			},
+			"sshcertfile": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "This is the path to OpenSSH certificate paired with the ssh key for establish ssh connection." +
+					" May also be provided via " + junos.EnvCertFile + " environment variable.",
+			},
+			"credential_helper": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path to a credential helper to read username, password and ssh key for the Junos device:" +
+					" a JSON file (with `.json` extension) with an object per host (and `*` as fallback)" +
+					" or an executable called with host as argument which prints a JSON object." +
+					" May also be provided via " + junos.EnvCredentialHelper + " environment variable.",
+			},
 			"group_interface_delete": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "This is the Junos group used to remove configuration on a physical interface." +
 					" May also be provided via " + junos.EnvGroupInterfaceDelete + " environment variable.",
 			},
+			"interface_release_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					junos.InterfaceReleaseDisable,
+					junos.InterfaceReleaseDelete,
+					junos.InterfaceReleaseGroup,
+					junos.InterfaceReleaseSnapshot,
+					junos.InterfaceReleaseTemplate,
+				}, false),
+				Description: "Policy to release a physical interface when destroy a `junos_interface_physical` resource:" +
+					" `disable` (default, set `description NC` and `disable`" +
+					" or apply the group in `group_interface_delete` if set)," +
+					" `delete` (delete all configurations), `group` (apply the group in `group_interface_delete`)," +
+					" `snapshot` (restore the configuration captured when creating the resource," +
+					" configured interfaces are only taken with `adopt_configured` on the resource)" +
+					" or `template` (set the lines in `interface_release_template`)." +
+					" Interfaces with only these lines are considered as not configured." +
+					" Logical interfaces are always deleted, so `snapshot` is handled like `delete` for them." +
+					" May also be provided via " + junos.EnvInterfaceReleasePolicy + " environment variable.",
+			},
+			"interface_release_template": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Set lines relative to the interface (one per line, like `set disable`)" +
+					" to release a physical interface with the `template` policy." +
+					" May also be provided via " + junos.EnvInterfaceReleaseTemplate + " environment variable.",
+			},
 			"cmd_sleep_short": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -78,9 +126,18 @@ func Provider() *schema.Provider {
 				Type:     schema.TypeInt,
 				Optional: true,
 				Description: "Seconds of standby while waiting for Terraform provider " +
-					"to lock candidate configuration on a Junos device." +
+					"to lock candidate configuration on a Junos device" +
+					" (exponential backoff with jitter between tries if not set)." +
 					" May also be provided via " + junos.EnvSleepLock + " environment variable.",
 			},
+			"commit_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description: "Seconds to wait for the commit of candidate configuration on a Junos device " +
+					"before aborting the NETCONF session (no timeout by default)." +
+					" May also be provided via " + junos.EnvCommitTimeout + " environment variable.",
+			},
 			"ssh_sleep_closed": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -93,6 +150,19 @@ func Provider() *schema.Provider {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Ciphers used in SSH connection.",
 			},
+			"ssh_auth_methods": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						junos.SSHAuthMethodPublicKey,
+						junos.SSHAuthMethodPassword,
+						junos.SSHAuthMethodKeyboardInteractive,
+					}, false),
+				},
+				Description: "Authentication methods to try in order in SSH connection.",
+			},
 			"ssh_timeout_to_establish": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -104,9 +174,68 @@ func Provider() *schema.Provider {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(1, 10),
 				Description: "Number of retries to establish SSH connections." +
-					"The provider waits after each try, with the sleep time increasing by 1 second each time." +
+					"The provider waits after each try, with an exponential backoff and jitter between tries." +
 					" May also be provided via " + junos.EnvSSHRetryToEstablish + " environment variable.",
 			},
+			"ssh_proxy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "URL of proxy used to reach SSH target (first jump host or Junos device)" +
+					" with `socks5://`, `socks5h://` or `http://` (HTTP CONNECT) scheme." +
+					" May also be provided via " + junos.EnvSSHProxy + " environment variable.",
+			},
+			"ssh_jump_host": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "For each SSH jump host to chain (in order) before reaching Junos device.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "Address (ip or dns name) of jump host.",
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+							Description:  "TCP port of jump host (default 22).",
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Username for ssh connection on jump host (default same as `username`).",
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Password for ssh connection on jump host.",
+						},
+						"sshkey_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "SSH key in PEM format for ssh connection on jump host.",
+						},
+						"sshkeyfile": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Path to SSH key for ssh connection on jump host.",
+						},
+						"keypass": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Passphrase for open `sshkeyfile` or `sshkey_pem` of jump host.",
+						},
+						"host_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "Public key of jump host (in authorized_keys format) to verify its identity." +
+								" Without it, the key of jump host is not verified.",
+						},
+					},
+				},
+			},
 			"file_permission": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -119,6 +248,23 @@ func Provider() *schema.Provider {
 				Description: "More detailed log (netconf) in the specified file." +
 					" May also be provided via " + junos.EnvLogPath + " environment variable.",
 			},
+			"metrics_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Write metrics of netconf exchanges (session establishment, rpc latency, lock wait, commit duration" +
+					" and retries) in the specified file when the provider shuts down," +
+					" in JSON format if the file has the `.json` extension, otherwise in OpenMetrics text format." +
+					" The metrics are added to those already in the file." +
+					" May also be provided via " + junos.EnvMetricsPath + " environment variable.",
+			},
+			"audit_journal_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Append a JSON line for each commit in the specified file (audit journal) with" +
+					" the device, the resource type and ID, the operation, the set/delete lines (with secrets masked)," +
+					" the commit result, warnings and revision." +
+					" May also be provided via " + junos.EnvAuditJournalPath + " environment variable.",
+			},
 			"fake_create_with_setfile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -221,7 +367,6 @@ func Provider() *schema.Provider {
 			"junos_vstp_vlan_group":                                      resourceVstpVlanGroup(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"junos_routes":             dataSourceRoutes(),
 			"junos_system_information": dataSourceSystemInformation(),
 		},
 		ConfigureContextFunc: configureProvider,
@@ -313,13 +458,112 @@ func configureProvider(_ context.Context, d *schema.ResourceData) (interface{},
 		client.WithSSHKeyPassphrase(ev)
 	}
 
+	if v, ok := d.GetOk("sshcert_pem"); ok {
+		client.WithSSHCertificatePEM(v.(string))
+	} else if ev := os.Getenv(junos.EnvCertPem); ev != "" {
+		client.WithSSHCertificatePEM(ev)
+	}
+
+	if v, ok := d.GetOk("sshcertfile"); ok {
+		certFile := v.(string)
+		if err := utils.ReplaceTildeToHomeDir(&certFile); err != nil {
+			diagWarns = append(diagWarns, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Bad value in sshcertfile",
+				Detail: fmt.Sprintf("Error to use value in sshcertfile attribute: %s\n"+
+					"So the attribute is not used", err),
+			})
+		} else {
+			client.WithSSHCertificateFile(certFile)
+		}
+	} else if ev := os.Getenv(junos.EnvCertFile); ev != "" {
+		if err := utils.ReplaceTildeToHomeDir(&ev); err != nil {
+			diagWarns = append(diagWarns, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Bad value in " + junos.EnvCertFile,
+				Detail: fmt.Sprintf("Error to use value in "+junos.EnvCertFile+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			})
+		} else {
+			client.WithSSHCertificateFile(ev)
+		}
+	}
+
+	if v, ok := d.GetOk("credential_helper"); ok {
+		credentialHelper := v.(string)
+		if err := utils.ReplaceTildeToHomeDir(&credentialHelper); err != nil {
+			diagWarns = append(diagWarns, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Bad value in credential_helper",
+				Detail: fmt.Sprintf("Error to use value in credential_helper attribute: %s\n"+
+					"So the attribute is not used", err),
+			})
+		} else {
+			client.WithCredentialHelper(credentialHelper)
+		}
+	} else if ev := os.Getenv(junos.EnvCredentialHelper); ev != "" {
+		if err := utils.ReplaceTildeToHomeDir(&ev); err != nil {
+			diagWarns = append(diagWarns, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Bad value in " + junos.EnvCredentialHelper,
+				Detail: fmt.Sprintf("Error to use value in "+junos.EnvCredentialHelper+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			})
+		} else {
+			client.WithCredentialHelper(ev)
+		}
+	}
+
 	if v, ok := d.GetOk("group_interface_delete"); ok {
 		client.WithGroupInterfaceDelete(v.(string))
 	} else if ev := os.Getenv(junos.EnvGroupInterfaceDelete); ev != "" {
 		client.WithGroupInterfaceDelete(ev)
 	}
 
-	client.WithSleepShort(100) // default value for cmd_sleep_short
+	if v, ok := d.GetOk("interface_release_policy"); ok {
+		if _, err := client.WithInterfaceReleasePolicy(v.(string)); err != nil {
+			return nil, append(diagWarns, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Bad value in interface_release_policy",
+				Detail:   fmt.Sprintf("Error to use value in 'interface_release_policy' attribute: %s", err),
+			})
+		}
+	} else if ev := os.Getenv(junos.EnvInterfaceReleasePolicy); ev != "" {
+		if _, err := client.WithInterfaceReleasePolicy(ev); err != nil {
+			diagWarns = append(diagWarns, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Bad value in " + junos.EnvInterfaceReleasePolicy,
+				Detail: fmt.Sprintf("Error to use value in "+junos.EnvInterfaceReleasePolicy+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			})
+		}
+	}
+	if v, ok := d.GetOk("interface_release_template"); ok {
+		if _, err := client.WithInterfaceReleaseTemplate(v.(string)); err != nil {
+			return nil, append(diagWarns, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Bad value in interface_release_template",
+				Detail:   fmt.Sprintf("Error to use value in 'interface_release_template' attribute: %s", err),
+			})
+		}
+	} else if ev := os.Getenv(junos.EnvInterfaceReleaseTemplate); ev != "" {
+		if _, err := client.WithInterfaceReleaseTemplate(ev); err != nil {
+			diagWarns = append(diagWarns, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Bad value in " + junos.EnvInterfaceReleaseTemplate,
+				Detail: fmt.Sprintf("Error to use value in "+junos.EnvInterfaceReleaseTemplate+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			})
+		}
+	}
+	if err := client.CheckInterfaceRelease(); err != nil {
+		return nil, append(diagWarns, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Bad value in interface_release_policy",
+			Detail:   fmt.Sprintf("Error to use value in 'interface_release_policy' attribute: %s", err),
+		})
+	}
+
 	if v, ok := d.GetOk("cmd_sleep_short"); ok {
 		client.WithSleepShort(v.(int))
 	} else if ev := os.Getenv(junos.EnvSleepShort); ev != "" {
@@ -335,7 +579,6 @@ func configureProvider(_ context.Context, d *schema.ResourceData) (interface{},
 		}
 	}
 
-	client.WithSleepLock(10) // default value for cmd_sleep_lock
 	if v, ok := d.GetOk("cmd_sleep_lock"); ok {
 		client.WithSleepLock(v.(int))
 	} else if v := os.Getenv(junos.EnvSleepLock); v != "" {
@@ -352,6 +595,30 @@ func configureProvider(_ context.Context, d *schema.ResourceData) (interface{},
 		}
 	}
 
+	if v, ok := d.GetOk("commit_timeout"); ok {
+		client.WithCommitTimeout(v.(int))
+	} else if v := os.Getenv(junos.EnvCommitTimeout); v != "" {
+		d, err := strconv.Atoi(v)
+		switch {
+		case err != nil:
+			diagWarns = append(diagWarns, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Error to parse " + junos.EnvCommitTimeout,
+				Detail: fmt.Sprintf("Error to parse value in "+junos.EnvCommitTimeout+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			})
+		case d < 1:
+			diagWarns = append(diagWarns, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Bad value in " + junos.EnvCommitTimeout,
+				Detail: fmt.Sprintf("Bad value in "+junos.EnvCommitTimeout+" environment variable: %d must be at least 1\n"+
+					"So the variable is not used", d),
+			})
+		default:
+			client.WithCommitTimeout(d)
+		}
+	}
+
 	if v, ok := d.GetOk("ssh_sleep_closed"); ok {
 		client.WithSleepSSHClosed(v.(int))
 	} else if v := os.Getenv(junos.EnvSleepSSHClosed); v != "" {
@@ -378,6 +645,20 @@ func configureProvider(_ context.Context, d *schema.ResourceData) (interface{},
 		client.WithSSHCiphers(sshCiphers)
 	}
 
+	sshAuthMethods := make([]string, len(d.Get("ssh_auth_methods").([]interface{})))
+	for i, v := range d.Get("ssh_auth_methods").([]interface{}) {
+		sshAuthMethods[i] = v.(string)
+	}
+	if len(sshAuthMethods) == 0 {
+		_, _ = client.WithSSHAuthMethods(junos.DefaultSSHAuthMethods())
+	} else if _, err := client.WithSSHAuthMethods(sshAuthMethods); err != nil {
+		return nil, append(diagWarns, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Bad value in ssh_auth_methods",
+			Detail:   fmt.Sprintf("Error to use value in 'ssh_auth_methods' attribute: %s", err),
+		})
+	}
+
 	if v, ok := d.GetOk("ssh_timeout_to_establish"); ok {
 		client.WithSSHTimeoutToEstablish(v.(int))
 	} else if v := os.Getenv(junos.EnvSSHTimeoutToEstablish); v != "" {
@@ -425,6 +706,46 @@ func configureProvider(_ context.Context, d *schema.ResourceData) (interface{},
 		}
 	}
 
+	if v, ok := d.GetOk("ssh_proxy"); ok {
+		if _, err := client.WithSSHProxy(v.(string)); err != nil {
+			return nil, append(diagWarns, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Bad value in ssh_proxy",
+				Detail:   fmt.Sprintf("Error to use value in 'ssh_proxy' attribute: %s", err),
+			})
+		}
+	} else if v := os.Getenv(junos.EnvSSHProxy); v != "" {
+		if _, err := client.WithSSHProxy(v); err != nil {
+			return nil, append(diagWarns, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Bad value in " + junos.EnvSSHProxy,
+				Detail:   fmt.Sprintf("Error to use value in "+junos.EnvSSHProxy+" environment variable: %s", err),
+			})
+		}
+	}
+
+	for _, v := range d.Get("ssh_jump_host").([]interface{}) {
+		jumpHost := v.(map[string]interface{})
+		keyFile := jumpHost["sshkeyfile"].(string)
+		if err := utils.ReplaceTildeToHomeDir(&keyFile); err != nil {
+			return nil, append(diagWarns, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Bad value in ssh_jump_host",
+				Detail:   fmt.Sprintf("Error to use value in 'sshkeyfile' attribute of ssh_jump_host: %s", err),
+			})
+		}
+		client.WithSSHJumpHost(junos.SSHJumpHost{
+			Address:    jumpHost["address"].(string),
+			Port:       jumpHost["port"].(int),
+			Username:   jumpHost["username"].(string),
+			Password:   jumpHost["password"].(string),
+			SSHKeyPEM:  jumpHost["sshkey_pem"].(string),
+			SSHKeyFile: keyFile,
+			SSHKeyPass: jumpHost["keypass"].(string),
+			HostKey:    jumpHost["host_key"].(string),
+		})
+	}
+
 	_, _ = client.WithFilePermission(0o644) // default value for file_permission
 	if v, ok := d.GetOk("file_permission"); ok {
 		filePerm, err := strconv.ParseInt(v.(string), 8, 64)
@@ -491,6 +812,56 @@ func configureProvider(_ context.Context, d *schema.ResourceData) (interface{},
 		}
 	}
 
+	if v, ok := d.GetOk("metrics_path"); ok {
+		metricsPath := v.(string)
+		if err := utils.ReplaceTildeToHomeDir(&metricsPath); err != nil {
+			diagWarns = append(diagWarns, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Bad value in metrics_path",
+				Detail: fmt.Sprintf("Error to use value in metrics_path attribute: %s\n"+
+					"So the attribute is not used", err),
+			})
+		} else {
+			client.WithMetricsFile(metricsPath)
+		}
+	} else if v := os.Getenv(junos.EnvMetricsPath); v != "" {
+		if err := utils.ReplaceTildeToHomeDir(&v); err != nil {
+			diagWarns = append(diagWarns, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Bad value in " + junos.EnvMetricsPath,
+				Detail: fmt.Sprintf("Error to use value in "+junos.EnvMetricsPath+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			})
+		} else {
+			client.WithMetricsFile(v)
+		}
+	}
+
+	if v, ok := d.GetOk("audit_journal_path"); ok {
+		journalPath := v.(string)
+		if err := utils.ReplaceTildeToHomeDir(&journalPath); err != nil {
+			diagWarns = append(diagWarns, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Bad value in audit_journal_path",
+				Detail: fmt.Sprintf("Error to use value in audit_journal_path attribute: %s\n"+
+					"So the attribute is not used", err),
+			})
+		} else {
+			client.WithAuditJournalFile(journalPath)
+		}
+	} else if v := os.Getenv(junos.EnvAuditJournalPath); v != "" {
+		if err := utils.ReplaceTildeToHomeDir(&v); err != nil {
+			diagWarns = append(diagWarns, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Bad value in " + junos.EnvAuditJournalPath,
+				Detail: fmt.Sprintf("Error to use value in "+junos.EnvAuditJournalPath+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			})
+		} else {
+			client.WithAuditJournalFile(v)
+		}
+	}
+
 	if v, ok := d.GetOk("fake_create_with_setfile"); ok {
 		setFile := v.(string)
 		if err := utils.ReplaceTildeToHomeDir(&setFile); err != nil {
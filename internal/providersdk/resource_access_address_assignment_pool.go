@@ -493,6 +493,7 @@ func resourceAccessAddressAssignPoolCreate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -616,6 +617,7 @@ func resourceAccessAddressAssignPoolUpdate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -666,6 +668,7 @@ func resourceAccessAddressAssignPoolDelete(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -145,6 +145,7 @@ func resourceAggregateRouteCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -266,6 +267,7 @@ func resourceAggregateRouteUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -315,6 +317,7 @@ func resourceAggregateRouteDelete(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
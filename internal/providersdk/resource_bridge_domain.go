@@ -168,6 +168,7 @@ func resourceBridgeDomainCreate(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if !junSess.CheckCompatibilityRouter() {
 		return diag.FromErr(fmt.Errorf("bridge domain "+
 			"not compatible with Junos device %s", junSess.SystemInformation.HardwareModel))
@@ -304,6 +305,7 @@ func resourceBridgeDomainUpdate(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -367,6 +369,7 @@ func resourceBridgeDomainDelete(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
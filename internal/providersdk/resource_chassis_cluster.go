@@ -238,6 +238,7 @@ func resourceChassisClusterCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if !checkCompatibilityChassisCluster(junSess) {
 		return diag.FromErr(fmt.Errorf("chassis cluster "+
 			"not compatible with Junos device %s", junSess.SystemInformation.HardwareModel))
@@ -307,6 +308,7 @@ func resourceChassisClusterUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -333,7 +335,7 @@ func resourceChassisClusterUpdate(ctx context.Context, d *schema.ResourceData, m
 	return append(diagWarns, resourceChassisClusterReadWJunSess(d, junSess)...)
 }
 
-func resourceChassisClusterDelete(ctx context.Context, _ *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceChassisClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clt := m.(*junos.Client)
 	if clt.FakeDeleteAlso() {
 		junSess := clt.NewSessionWithoutNetconf(ctx)
@@ -348,6 +350,7 @@ func resourceChassisClusterDelete(ctx context.Context, _ *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
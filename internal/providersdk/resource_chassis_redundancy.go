@@ -106,6 +106,7 @@ func resourceChassisRedundancyCreate(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -171,6 +172,7 @@ func resourceChassisRedundancyUpdate(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -197,7 +199,7 @@ func resourceChassisRedundancyUpdate(ctx context.Context, d *schema.ResourceData
 	return append(diagWarns, resourceChassisRedundancyReadWJunSess(d, junSess)...)
 }
 
-func resourceChassisRedundancyDelete(ctx context.Context, _ *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceChassisRedundancyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clt := m.(*junos.Client)
 	if clt.FakeDeleteAlso() {
 		junSess := clt.NewSessionWithoutNetconf(ctx)
@@ -212,6 +214,7 @@ func resourceChassisRedundancyDelete(ctx context.Context, _ *schema.ResourceData
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -82,6 +82,7 @@ func resourceEventoptionsDestinationCreate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -174,6 +175,7 @@ func resourceEventoptionsDestinationUpdate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -216,6 +218,7 @@ func resourceEventoptionsDestinationDelete(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
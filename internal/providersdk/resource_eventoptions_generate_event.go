@@ -75,6 +75,7 @@ func resourceEventoptionsGenerateEventCreate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -168,6 +169,7 @@ func resourceEventoptionsGenerateEventUpdate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -210,6 +212,7 @@ func resourceEventoptionsGenerateEventDelete(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
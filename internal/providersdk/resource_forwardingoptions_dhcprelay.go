@@ -574,6 +574,7 @@ func resourceForwardingOptionsDhcpRelayCreate(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -677,6 +678,7 @@ func resourceForwardingOptionsDhcpRelayUpdate(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -722,6 +724,7 @@ func resourceForwardingOptionsDhcpRelayDelete(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -596,6 +596,7 @@ func resourceForwardingOptionsDhcpRelayGroupCreate(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -736,6 +737,7 @@ func resourceForwardingOptionsDhcpRelayGroupUpdate(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -782,6 +784,7 @@ func resourceForwardingOptionsDhcpRelayGroupDelete(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -81,6 +81,7 @@ func resourceForwardingOptionsDhcpRelayServerGroupCreate(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -221,6 +222,7 @@ func resourceForwardingOptionsDhcpRelayServerGroupUpdate(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -267,6 +269,7 @@ func resourceForwardingOptionsDhcpRelayServerGroupDelete(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -131,6 +131,7 @@ func resourceLayer2ControlCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -196,6 +197,7 @@ func resourceLayer2ControlUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -222,7 +224,7 @@ func resourceLayer2ControlUpdate(ctx context.Context, d *schema.ResourceData, m
 	return append(diagWarns, resourceLayer2ControlReadWJunSess(d, junSess)...)
 }
 
-func resourceLayer2ControlDelete(ctx context.Context, _ *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceLayer2ControlDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clt := m.(*junos.Client)
 	if clt.FakeDeleteAlso() {
 		junSess := clt.NewSessionWithoutNetconf(ctx)
@@ -237,6 +239,7 @@ func resourceLayer2ControlDelete(ctx context.Context, _ *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -206,6 +206,7 @@ func resourceRoutingOptionsCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -294,6 +295,7 @@ func resourceRoutingOptionsUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -335,6 +337,7 @@ func resourceRoutingOptionsDelete(ctx context.Context, d *schema.ResourceData, m
 			return diag.FromErr(err)
 		}
 		defer junSess.Close()
+		junSess.SetResourceID(resourceJournalID(d))
 		if err := junSess.ConfigLock(ctx); err != nil {
 			return diag.FromErr(err)
 		}
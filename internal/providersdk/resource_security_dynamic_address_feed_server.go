@@ -132,6 +132,7 @@ func resourceSecurityDynamicAddressFeedServerCreate(ctx context.Context, d *sche
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if !junSess.CheckCompatibilitySecurity() {
 		return diag.FromErr(fmt.Errorf("security dynamic-address feed-server "+
 			"not compatible with Junos device %s", junSess.SystemInformation.HardwareModel))
@@ -236,6 +237,7 @@ func resourceSecurityDynamicAddressFeedServerUpdate(ctx context.Context, d *sche
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -278,6 +280,7 @@ func resourceSecurityDynamicAddressFeedServerDelete(ctx context.Context, d *sche
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
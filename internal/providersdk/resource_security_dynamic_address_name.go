@@ -108,6 +108,7 @@ func resourceSecurityDynamicAddressNameCreate(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if !junSess.CheckCompatibilitySecurity() {
 		return diag.FromErr(fmt.Errorf("security dynamic-address address-name "+
 			"not compatible with Junos device %s", junSess.SystemInformation.HardwareModel))
@@ -205,6 +206,7 @@ func resourceSecurityDynamicAddressNameUpdate(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -247,6 +249,7 @@ func resourceSecurityDynamicAddressNameDelete(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -835,6 +835,7 @@ func resourceSecurityIdpCustomAttackCreate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if !junSess.CheckCompatibilitySecurity() {
 		return diag.FromErr(fmt.Errorf("security idp custom-attack not compatible with Junos device %s",
 			junSess.SystemInformation.HardwareModel))
@@ -930,6 +931,7 @@ func resourceSecurityIdpCustomAttackUpdate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -972,6 +974,7 @@ func resourceSecurityIdpCustomAttackDelete(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -63,6 +63,7 @@ func resourceSecurityScreenWhiteListCreate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if !junSess.CheckCompatibilitySecurity() {
 		return diag.FromErr(fmt.Errorf("security screen white-list not compatible with Junos device %s",
 			junSess.SystemInformation.HardwareModel))
@@ -160,6 +161,7 @@ func resourceSecurityScreenWhiteListUpdate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -202,6 +204,7 @@ func resourceSecurityScreenWhiteListDelete(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -633,6 +633,7 @@ func resourceServicesCreate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -697,6 +698,7 @@ func resourceServicesUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -739,6 +741,7 @@ func resourceServicesDelete(ctx context.Context, d *schema.ResourceData, m inter
 			return diag.FromErr(err)
 		}
 		defer junSess.Close()
+		junSess.SetResourceID(resourceJournalID(d))
 		if err := junSess.ConfigLock(ctx); err != nil {
 			return diag.FromErr(err)
 		}
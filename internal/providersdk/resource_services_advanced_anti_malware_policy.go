@@ -156,6 +156,7 @@ func resourceServicesAdvancedAntiMalwarePolicyCreate(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -261,6 +262,7 @@ func resourceServicesAdvancedAntiMalwarePolicyUpdate(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -303,6 +305,7 @@ func resourceServicesAdvancedAntiMalwarePolicyDelete(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
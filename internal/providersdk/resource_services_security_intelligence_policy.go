@@ -77,6 +77,7 @@ func resourceServicesSecurityIntellPolicyCreate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -170,6 +171,7 @@ func resourceServicesSecurityIntellPolicyUpdate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -212,6 +214,7 @@ func resourceServicesSecurityIntellPolicyDelete(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -138,6 +138,7 @@ func resourceServicesSecurityIntellProfileCreate(ctx context.Context, d *schema.
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -232,6 +233,7 @@ func resourceServicesSecurityIntellProfileUpdate(ctx context.Context, d *schema.
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -274,6 +276,7 @@ func resourceServicesSecurityIntellProfileDelete(ctx context.Context, d *schema.
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -120,6 +120,7 @@ func resourceServicesSSLInitiationProfileCreate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -215,6 +216,7 @@ func resourceServicesSSLInitiationProfileUpdate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -257,6 +259,7 @@ func resourceServicesSSLInitiationProfileDelete(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -145,6 +145,7 @@ func resourceServicesUserIdentAdAccessDomainCreate(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -247,6 +248,7 @@ func resourceServicesUserIdentAdAccessDomainUpdate(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -289,6 +291,7 @@ func resourceServicesUserIdentAdAccessDomainDelete(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -81,6 +81,7 @@ func resourceServicesUserIdentDeviceIdentityProfileCreate(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -186,6 +187,7 @@ func resourceServicesUserIdentDeviceIdentityProfileUpdate(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -229,6 +231,7 @@ func resourceServicesUserIdentDeviceIdentityProfileDelete(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -175,6 +175,7 @@ func resourceSnmpCreate(ctx context.Context, d *schema.ResourceData, m interface
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -239,6 +240,7 @@ func resourceSnmpUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -281,6 +283,7 @@ func resourceSnmpDelete(ctx context.Context, d *schema.ResourceData, m interface
 			return diag.FromErr(err)
 		}
 		defer junSess.Close()
+		junSess.SetResourceID(resourceJournalID(d))
 		if err := junSess.ConfigLock(ctx); err != nil {
 			return diag.FromErr(err)
 		}
@@ -63,6 +63,7 @@ func resourceSwitchOptionsCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -128,6 +129,7 @@ func resourceSwitchOptionsUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -170,6 +172,7 @@ func resourceSwitchOptionsDelete(ctx context.Context, d *schema.ResourceData, m
 			return diag.FromErr(err)
 		}
 		defer junSess.Close()
+		junSess.SetResourceID(resourceJournalID(d))
 		if err := junSess.ConfigLock(ctx); err != nil {
 			return diag.FromErr(err)
 		}
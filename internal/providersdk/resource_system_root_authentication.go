@@ -83,6 +83,7 @@ func resourceSystemRootAuthenticationCreate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -157,6 +158,7 @@ func resourceSystemRootAuthenticationUpdate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 	defer junSess.Close()
+	junSess.SetResourceID(resourceJournalID(d))
 	if err := junSess.ConfigLock(ctx); err != nil {
 		return diag.FromErr(err)
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
 	"github.com/jeremmfr/terraform-provider-junos/internal/providerfwk"
 	"github.com/jeremmfr/terraform-provider-junos/internal/providersdk"
 
@@ -36,4 +37,9 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	// provider shuts down, write metrics files if configured
+	if err := junos.WriteMetricsFiles(); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
 }